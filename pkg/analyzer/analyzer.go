@@ -20,6 +20,11 @@ type Analyzer struct {
 	Sessions     Sessions
 	Options      FilterOptions
 	Funnel       Funnel
+	SiteSearch   SiteSearch
+	Errors       Errors
+	Heatmap      Heatmap
+	Users        Users
+	Privacy      Privacy
 }
 
 // NewAnalyzer returns a new Analyzer for given Store.
@@ -69,6 +74,26 @@ func NewAnalyzer(store db.Store) *Analyzer {
 		analyzer: analyzer,
 		store:    store,
 	}
+	analyzer.SiteSearch = SiteSearch{
+		analyzer: analyzer,
+		store:    store,
+	}
+	analyzer.Errors = Errors{
+		analyzer: analyzer,
+		store:    store,
+	}
+	analyzer.Heatmap = Heatmap{
+		analyzer: analyzer,
+		store:    store,
+	}
+	analyzer.Users = Users{
+		analyzer: analyzer,
+		store:    store,
+	}
+	analyzer.Privacy = Privacy{
+		analyzer: analyzer,
+		store:    store,
+	}
 	return analyzer
 }
 
@@ -104,6 +104,7 @@ func getMaxFilter(eventName string) *Filter {
 		UTMContent:     []string{"content"},
 		UTMTerm:        []string{"term"},
 		Tags:           map[string]string{"key": "value"},
+		Properties:     map[string]string{"key": "value"},
 		EventName:      events,
 		Limit:          42,
 		IncludeCR:      true,
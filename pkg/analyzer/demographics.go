@@ -17,6 +17,19 @@ func (demographics *Demographics) Languages(filter *Filter) ([]model.LanguageSta
 	return demographics.store.SelectLanguageStats(ctx, q, args...)
 }
 
+// Locales returns the visitor count grouped by locale (language and region).
+func (demographics *Demographics) Locales(filter *Filter) ([]model.LocaleStats, error) {
+	ctx, q, args := demographics.analyzer.selectByAttribute(filter, "", FieldLocale)
+	return demographics.store.SelectLocaleStats(ctx, q, args...)
+}
+
+// SecondaryLanguages returns the visitor count grouped by the languages visitors prefer in addition to their primary language.
+// This requires the tracker to store the preferred languages (see tracker.Config.MaxLanguages).
+func (demographics *Demographics) SecondaryLanguages(filter *Filter) ([]model.LanguageStats, error) {
+	ctx, q, args := demographics.analyzer.selectByAttribute(filter, "", FieldSecondaryLanguage)
+	return demographics.store.SelectLanguageStats(ctx, q, args...)
+}
+
 // Countries returns the visitor count grouped by country.
 func (demographics *Demographics) Countries(filter *Filter) ([]model.CountryStats, error) {
 	ctx, q, args := demographics.analyzer.selectByAttribute(filter, "imported_country", FieldCountry)
@@ -96,6 +96,64 @@ func TestAnalyzer_Languages(t *testing.T) {
 	assert.InDelta(t, 0.4444, visitors[0].RelativeVisitors, 0.01)
 }
 
+func TestAnalyzer_Locales(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), Language: "en", Locale: "en-US"},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), Language: "en", Locale: "en-GB"},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), Language: "en", Locale: "en-US"},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), Language: "de", Locale: "de"},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Demographics.Locales(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 3)
+	assert.Equal(t, "en-US", visitors[0].Locale)
+	assert.Equal(t, "de", visitors[1].Locale)
+	assert.Equal(t, "en-GB", visitors[2].Locale)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	assert.Equal(t, 1, visitors[2].Visitors)
+	assert.InDelta(t, 0.5, visitors[0].RelativeVisitors, 0.01)
+	visitors, err = analyzer.Demographics.Locales(&Filter{Locale: []string{"en-GB"}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 1)
+	_, err = analyzer.Demographics.Locales(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Demographics.Locales(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_SecondaryLanguages(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), Language: "de", Languages: []string{"de", "en"}},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), Language: "fr", Languages: []string{"fr", "en", "de"}},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), Language: "en", Languages: []string{"en"}},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), Language: "en"},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Demographics.SecondaryLanguages(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 2)
+	assert.Equal(t, "en", visitors[0].Language)
+	assert.Equal(t, "de", visitors[1].Language)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	visitors, err = analyzer.Demographics.SecondaryLanguages(&Filter{Language: []string{"de"}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 1)
+	assert.Equal(t, "en", visitors[0].Language)
+	_, err = analyzer.Demographics.SecondaryLanguages(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Demographics.SecondaryLanguages(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
 func TestAnalyzer_Countries(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	saveSessions(t, [][]model.Session{
@@ -108,6 +108,52 @@ func (device *Device) BrowserVersion(filter *Filter) ([]model.BrowserVersionStat
 	return stats, nil
 }
 
+// Webview returns the visitor count for in-app browsers and webviews grouped by the host app.
+// Webviews of unknown apps are grouped under an empty name.
+func (device *Device) Webview(filter *Filter) ([]model.WebviewStats, error) {
+	filter = device.analyzer.getFilter(filter)
+	filter.Webview = true
+	ctx, q, args := device.analyzer.selectByAttribute(filter, "", FieldWebviewApp)
+	return device.store.SelectWebviewStats(ctx, q, args...)
+}
+
+// Type returns the visitor count grouped by device type.
+func (device *Device) Type(filter *Filter) ([]model.DeviceTypeStats, error) {
+	ctx, q, args := device.analyzer.selectByAttribute(filter, "", FieldDeviceType)
+	return device.store.SelectDeviceTypeStats(ctx, q, args...)
+}
+
+// Brand returns the visitor count grouped by device brand.
+func (device *Device) Brand(filter *Filter) ([]model.DeviceBrandStats, error) {
+	ctx, q, args := device.analyzer.selectByAttribute(filter, "", FieldDeviceBrand)
+	return device.store.SelectDeviceBrandStats(ctx, q, args...)
+}
+
+// Model returns the visitor count grouped by device brand and model.
+func (device *Device) Model(filter *Filter) ([]model.DeviceModelStats, error) {
+	filter = device.analyzer.getFilter(filter)
+	q, args := filter.buildQuery([]Field{
+		FieldDeviceBrand,
+		FieldDeviceModel,
+		FieldVisitors,
+		FieldRelativeVisitors,
+	}, []Field{
+		FieldDeviceBrand,
+		FieldDeviceModel,
+	}, []Field{
+		FieldVisitors,
+		FieldDeviceBrand,
+		FieldDeviceModel,
+	}, nil, "")
+	stats, err := device.store.SelectDeviceModelStats(filter.Ctx, q, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // ScreenClass returns the visitor count grouped by screen class.
 func (device *Device) ScreenClass(filter *Filter) ([]model.ScreenClassStats, error) {
 	ctx, q, args := device.analyzer.selectByAttribute(filter, "", FieldScreenClass)
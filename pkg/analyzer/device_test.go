@@ -543,6 +543,151 @@ func TestAnalyzer_OSVersionSearchSort(t *testing.T) {
 	assert.Equal(t, "14.0.0", visitors[0].OSVersion)
 }
 
+func TestAnalyzer_Webview(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserChrome},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserChrome, Webview: true, WebviewApp: "Instagram"},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserChrome, Webview: true, WebviewApp: "Instagram"},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserSafari, Webview: true, WebviewApp: "TikTok"},
+			{Sign: 1, VisitorID: 5, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserChrome, Webview: true},
+			{Sign: 1, VisitorID: 6, Time: time.Now(), Start: time.Now(), Browser: pkg.BrowserSamsung},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Device.Webview(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 3)
+	assert.Equal(t, "Instagram", visitors[0].WebviewApp)
+	assert.Empty(t, visitors[1].WebviewApp)
+	assert.Equal(t, "TikTok", visitors[2].WebviewApp)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	assert.Equal(t, 1, visitors[2].Visitors)
+	assert.InDelta(t, 0.3333, visitors[0].RelativeVisitors, 0.01)
+	browser, err := analyzer.Device.Browser(&Filter{Webview: true})
+	assert.NoError(t, err)
+	assert.Len(t, browser, 2)
+	assert.Equal(t, pkg.BrowserChrome, browser[0].Browser)
+	assert.Equal(t, 3, browser[0].Visitors)
+	browser, err = analyzer.Device.Browser(&Filter{WebviewApp: []string{"TikTok"}})
+	assert.NoError(t, err)
+	assert.Len(t, browser, 1)
+	assert.Equal(t, pkg.BrowserSafari, browser[0].Browser)
+	browser, err = analyzer.Device.Browser(&Filter{Browser: []string{pkg.BrowserSamsung}})
+	assert.NoError(t, err)
+	assert.Len(t, browser, 1)
+	assert.Equal(t, pkg.BrowserSamsung, browser[0].Browser)
+	_, err = analyzer.Device.Webview(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Device.Webview(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_DeviceType(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypeDesktop},
+		},
+		{
+			{Sign: -1, VisitorID: 1, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypeDesktop},
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypePhone},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypePhone},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypeTablet},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), DeviceType: pkg.DeviceTypeTV},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Device.Type(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 3)
+	assert.Equal(t, pkg.DeviceTypePhone, visitors[0].DeviceType)
+	assert.Equal(t, pkg.DeviceTypeTV, visitors[1].DeviceType)
+	assert.Equal(t, pkg.DeviceTypeTablet, visitors[2].DeviceType)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	assert.Equal(t, 1, visitors[2].Visitors)
+	assert.InDelta(t, 0.5, visitors[0].RelativeVisitors, 0.01)
+	assert.InDelta(t, 0.25, visitors[1].RelativeVisitors, 0.01)
+	assert.InDelta(t, 0.25, visitors[2].RelativeVisitors, 0.01)
+	visitors, err = analyzer.Device.Type(&Filter{DeviceType: []string{pkg.DeviceTypeTablet}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 1)
+	assert.Equal(t, pkg.DeviceTypeTablet, visitors[0].DeviceType)
+	_, err = analyzer.Device.Type(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Device.Type(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_DeviceBrand(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), DeviceBrand: "Apple"},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), DeviceBrand: "Samsung"},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), DeviceBrand: "Samsung"},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), DeviceBrand: "Google"},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Device.Brand(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 3)
+	assert.Equal(t, "Samsung", visitors[0].DeviceBrand)
+	assert.Equal(t, "Apple", visitors[1].DeviceBrand)
+	assert.Equal(t, "Google", visitors[2].DeviceBrand)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	assert.Equal(t, 1, visitors[2].Visitors)
+	visitors, err = analyzer.Device.Brand(&Filter{DeviceBrand: []string{"!Samsung"}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 2)
+	_, err = analyzer.Device.Brand(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Device.Brand(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_DeviceModel(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), DeviceBrand: "Apple", DeviceModel: "iPhone"},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), DeviceBrand: "Samsung", DeviceModel: "SM-S918B"},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), DeviceBrand: "Samsung", DeviceModel: "SM-S918B"},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), DeviceBrand: "Samsung", DeviceModel: "SM-X710"},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Device.Model(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 3)
+	assert.Equal(t, "Samsung", visitors[0].DeviceBrand)
+	assert.Equal(t, "SM-S918B", visitors[0].DeviceModel)
+	assert.Equal(t, "Apple", visitors[1].DeviceBrand)
+	assert.Equal(t, "iPhone", visitors[1].DeviceModel)
+	assert.Equal(t, "Samsung", visitors[2].DeviceBrand)
+	assert.Equal(t, "SM-X710", visitors[2].DeviceModel)
+	assert.Equal(t, 2, visitors[0].Visitors)
+	assert.Equal(t, 1, visitors[1].Visitors)
+	assert.Equal(t, 1, visitors[2].Visitors)
+	assert.InDelta(t, 0.5, visitors[0].RelativeVisitors, 0.01)
+	visitors, err = analyzer.Device.Model(&Filter{DeviceBrand: []string{"Samsung"}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 2)
+	visitors, err = analyzer.Device.Model(&Filter{DeviceModel: []string{"SM-X710"}})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 1)
+	assert.Equal(t, "SM-X710", visitors[0].DeviceModel)
+	_, err = analyzer.Device.Model(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Device.Model(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
 func TestAnalyzer_ScreenClass(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	saveSessions(t, [][]model.Session{
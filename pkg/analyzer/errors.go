@@ -0,0 +1,76 @@
+package analyzer
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"strings"
+)
+
+// Errors aggregates statistics regarding JavaScript errors.
+// Only the time range and the Hostname, Path, OS, OSVersion, Browser, BrowserVersion, DeviceType, and ErrorFingerprint filters are applied.
+type Errors struct {
+	analyzer *Analyzer
+	store    db.Store
+}
+
+// Groups returns the visitor count, session count, number of errors, and when they were first and last seen grouped by fingerprint.
+// Use Filter.Offset and Filter.Limit for pagination.
+func (clientErrors *Errors) Groups(filter *Filter) ([]model.ErrorGroupStats, error) {
+	filter = clientErrors.analyzer.getFilter(filter)
+	query, args := clientErrors.selectByField(filter, `fingerprint,
+		argMax(message, time) message,
+		argMax(source, time) source,
+		argMax(line, time) line,
+		argMax("column", time) "column"`, "fingerprint", `uniq(visitor_id, session_id) sessions,
+		count(*) count,
+		min(time) first_seen,
+		max(time) last_seen`, "count DESC, visitors DESC, fingerprint ASC")
+	return clientErrors.store.SelectErrorGroupStats(filter.Ctx, query, args...)
+}
+
+// Browser returns the visitor count, session count, and number of errors grouped by browser.
+func (clientErrors *Errors) Browser(filter *Filter) ([]model.ErrorBrowserStats, error) {
+	filter = clientErrors.analyzer.getFilter(filter)
+	query, args := clientErrors.selectByField(filter, "browser", "browser", `uniq(visitor_id, session_id) sessions,
+		count(*) count`, "count DESC, browser ASC")
+	return clientErrors.store.SelectErrorBrowserStats(filter.Ctx, query, args...)
+}
+
+// OS returns the visitor count, session count, and number of errors grouped by operating system.
+func (clientErrors *Errors) OS(filter *Filter) ([]model.ErrorOSStats, error) {
+	filter = clientErrors.analyzer.getFilter(filter)
+	query, args := clientErrors.selectByField(filter, "os", "os", `uniq(visitor_id, session_id) sessions,
+		count(*) count`, "count DESC, os ASC")
+	return clientErrors.store.SelectErrorOSStats(filter.Ctx, query, args...)
+}
+
+func (clientErrors *Errors) selectByField(filter *Filter, fields, groupBy, aggregations, orderBy string) (string, []any) {
+	q := queryBuilder{
+		filter: filter,
+		offset: filter.Offset,
+		limit:  filter.Limit,
+	}
+	var query strings.Builder
+	query.WriteString(fmt.Sprintf(`SELECT %s,
+		uniq(visitor_id) visitors,
+		%s
+		FROM "client_error"
+		%s`, fields, aggregations, q.whereTime()))
+	q.whereField(FieldHostname.Name, filter.Hostname)
+	q.whereField(FieldPath.Name, filter.Path)
+	q.whereField(FieldOS.Name, filter.OS)
+	q.whereField(FieldOSVersion.Name, filter.OSVersion)
+	q.whereField(FieldBrowser.Name, filter.Browser)
+	q.whereField(FieldBrowserVersion.Name, filter.BrowserVersion)
+	q.whereField(FieldDeviceType.Name, filter.DeviceType)
+	q.whereField("fingerprint", filter.ErrorFingerprint)
+	q.whereWrite()
+	query.WriteString(q.q.String())
+	q.q.Reset()
+	q.withLimit()
+	query.WriteString(fmt.Sprintf(`GROUP BY %s
+		ORDER BY %s
+		%s`, groupBy, orderBy, q.q.String()))
+	return query.String(), q.args
+}
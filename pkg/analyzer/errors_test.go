@@ -0,0 +1,58 @@
+package analyzer
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestErrors(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveClientErrors([]model.ClientError{
+		{VisitorID: 1, SessionID: 1, Time: util.Today(), Path: "/", Fingerprint: "a", Message: "TypeError: old", Line: 1, Browser: pkg.BrowserChrome, OS: pkg.OSWindows},
+		{VisitorID: 1, SessionID: 1, Time: util.Today().Add(time.Second), Path: "/", Fingerprint: "a", Message: "TypeError: new", Line: 2, Browser: pkg.BrowserChrome, OS: pkg.OSWindows},
+		{VisitorID: 1, SessionID: 2, Time: util.Today().Add(time.Second * 2), Path: "/checkout", Fingerprint: "a", Message: "TypeError: new", Line: 2, Browser: pkg.BrowserChrome, OS: pkg.OSWindows},
+		{VisitorID: 2, SessionID: 1, Time: util.Today().Add(time.Second * 3), Path: "/checkout", Fingerprint: "b", Message: "ReferenceError: x", Browser: pkg.BrowserFirefox, OS: pkg.OSLinux},
+	}))
+	analyzer := NewAnalyzer(dbClient)
+	groups, err := analyzer.Errors.Groups(nil)
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "a", groups[0].Fingerprint)
+	assert.Equal(t, "TypeError: new", groups[0].Message)
+	assert.Equal(t, uint32(2), groups[0].Line)
+	assert.Equal(t, 1, groups[0].Visitors)
+	assert.Equal(t, 2, groups[0].Sessions)
+	assert.Equal(t, 3, groups[0].Count)
+	assert.Equal(t, util.Today(), groups[0].FirstSeen.UTC())
+	assert.Equal(t, util.Today().Add(time.Second*2), groups[0].LastSeen.UTC())
+	assert.Equal(t, "b", groups[1].Fingerprint)
+	assert.Equal(t, 1, groups[1].Count)
+	groups, err = analyzer.Errors.Groups(&Filter{Path: []string{"/checkout"}})
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, 1, groups[0].Count)
+	assert.Equal(t, 1, groups[1].Count)
+	groups, err = analyzer.Errors.Groups(&Filter{Limit: 1, Offset: 1})
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "b", groups[0].Fingerprint)
+	browser, err := analyzer.Errors.Browser(nil)
+	assert.NoError(t, err)
+	assert.Len(t, browser, 2)
+	assert.Equal(t, pkg.BrowserChrome, browser[0].Browser)
+	assert.Equal(t, 3, browser[0].Count)
+	assert.Equal(t, 2, browser[0].Sessions)
+	assert.Equal(t, pkg.BrowserFirefox, browser[1].Browser)
+	os, err := analyzer.Errors.OS(&Filter{ErrorFingerprint: []string{"b"}})
+	assert.NoError(t, err)
+	assert.Len(t, os, 1)
+	assert.Equal(t, pkg.OSLinux, os[0].OS)
+	assert.Equal(t, 1, os[0].Visitors)
+	_, err = analyzer.Errors.Groups(getMaxFilter(""))
+	assert.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
 )
@@ -12,8 +13,9 @@ type Events struct {
 }
 
 // Events returns the visitor count, views, and conversion rate for custom events.
+// Outbound links and downloads are excluded, unless Filter.EventKind is set.
 func (events *Events) Events(filter *Filter) ([]model.EventStats, error) {
-	filter = events.analyzer.getFilter(filter)
+	filter = events.getFilter(filter)
 	q, args := filter.buildQuery([]Field{
 		FieldEventName,
 		FieldCount,
@@ -39,8 +41,9 @@ func (events *Events) Events(filter *Filter) ([]model.EventStats, error) {
 
 // Breakdown returns the visitor count, views, and conversion rate for a custom event grouping them by a meta value for given key.
 // The Filter.EventName and Filter.EventMetaKey must be set, or otherwise the result set will be empty.
+// Outbound links and downloads are excluded, unless Filter.EventKind is set.
 func (events *Events) Breakdown(filter *Filter) ([]model.EventStats, error) {
-	filter = events.analyzer.getFilter(filter)
+	filter = events.getFilter(filter)
 
 	if len(filter.EventName) == 0 || len(filter.EventMetaKey) == 0 {
 		return []model.EventStats{}, nil
@@ -71,8 +74,9 @@ func (events *Events) Breakdown(filter *Filter) ([]model.EventStats, error) {
 }
 
 // List returns events as a list. The metadata is grouped as key-value pairs.
+// Outbound links and downloads are excluded, unless Filter.EventKind is set.
 func (events *Events) List(filter *Filter) ([]model.EventListStats, error) {
-	filter = events.analyzer.getFilter(filter)
+	filter = events.getFilter(filter)
 	q, args := filter.buildQuery([]Field{
 		FieldEventName,
 		FieldEventMeta,
@@ -93,3 +97,61 @@ func (events *Events) List(filter *Filter) ([]model.EventListStats, error) {
 
 	return stats, nil
 }
+
+// OutboundHosts returns the visitor count and number of clicks for outbound links grouped by target hostname.
+func (events *Events) OutboundHosts(filter *Filter) ([]model.EventTargetStats, error) {
+	return events.byTarget(filter, pkg.EventKindOutboundLink, FieldEventTargetHost, false)
+}
+
+// OutboundURLs returns the visitor count and number of clicks for outbound links grouped by target URL.
+func (events *Events) OutboundURLs(filter *Filter) ([]model.EventTargetStats, error) {
+	return events.byTarget(filter, pkg.EventKindOutboundLink, FieldEventTarget, false)
+}
+
+// Downloads returns the visitor count and number of downloads grouped by file path.
+func (events *Events) Downloads(filter *Filter) ([]model.EventTargetStats, error) {
+	return events.byTarget(filter, pkg.EventKindDownload, FieldEventTarget, false)
+}
+
+// DownloadExtensions returns the visitor count and number of downloads grouped by file extension.
+func (events *Events) DownloadExtensions(filter *Filter) ([]model.EventTargetStats, error) {
+	return events.byTarget(filter, pkg.EventKindDownload, FieldEventTargetExtension, false)
+}
+
+// DownloadPages returns the visitor count and number of downloads grouped by the page the download was started on and the file path.
+func (events *Events) DownloadPages(filter *Filter) ([]model.EventTargetStats, error) {
+	return events.byTarget(filter, pkg.EventKindDownload, FieldEventTarget, true)
+}
+
+func (events *Events) byTarget(filter *Filter, kind string, target Field, includePath bool) ([]model.EventTargetStats, error) {
+	filter = events.analyzer.getFilter(filter)
+	filter.EventKind = []string{kind}
+	fields := []Field{target, FieldCount, FieldVisitors, FieldRelativeVisitors}
+	groupBy := []Field{target}
+	orderBy := []Field{FieldVisitors, target}
+
+	if includePath {
+		fields = append([]Field{FieldEventPath}, fields...)
+		groupBy = append([]Field{FieldEventPath}, groupBy...)
+		orderBy = append(orderBy, FieldEventPath)
+	}
+
+	q, args := filter.buildQuery(fields, groupBy, orderBy, nil, "")
+	stats, err := events.store.SelectEventTargetStats(filter.Ctx, includePath, q, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (events *Events) getFilter(filter *Filter) *Filter {
+	filter = events.analyzer.getFilter(filter)
+
+	if len(filter.EventKind) == 0 {
+		filter.EventKind = []string{pkg.Unknown}
+	}
+
+	return filter
+}
@@ -492,3 +492,76 @@ func TestAnalyzer_EventFilter(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, eventList)
 }
+
+func TestAnalyzer_EventTargets(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/"},
+			{Sign: 1, VisitorID: 2, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/docs"},
+			{Sign: 1, VisitorID: 3, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/"},
+		},
+	})
+	assert.NoError(t, dbClient.SaveEvents([]model.Event{
+		{Name: "signup", VisitorID: 1, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameOutboundLink, Kind: pkg.EventKindOutboundLink, Target: "https://github.com/pirsch-analytics", TargetHost: "github.com", VisitorID: 1, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameOutboundLink, Kind: pkg.EventKindOutboundLink, Target: "https://github.com/pirsch-analytics", TargetHost: "github.com", VisitorID: 1, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameOutboundLink, Kind: pkg.EventKindOutboundLink, Target: "https://github.com/", TargetHost: "github.com", VisitorID: 2, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameOutboundLink, Kind: pkg.EventKindOutboundLink, Target: "https://example.com/", TargetHost: "example.com", VisitorID: 3, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameDownload, Kind: pkg.EventKindDownload, Target: "/files/guide.pdf", TargetExtension: "pdf", VisitorID: 1, Time: util.Today(), Path: "/docs"},
+		{Name: pkg.EventNameDownload, Kind: pkg.EventKindDownload, Target: "/files/guide.pdf", TargetExtension: "pdf", VisitorID: 2, Time: util.Today(), Path: "/"},
+		{Name: pkg.EventNameDownload, Kind: pkg.EventKindDownload, Target: "/files/data.zip", TargetExtension: "zip", VisitorID: 2, Time: util.Today(), Path: "/docs"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	hosts, err := analyzer.Events.OutboundHosts(nil)
+	assert.NoError(t, err)
+	assert.Len(t, hosts, 2)
+	assert.Equal(t, "github.com", hosts[0].Target)
+	assert.Equal(t, "example.com", hosts[1].Target)
+	assert.Equal(t, 2, hosts[0].Visitors)
+	assert.Equal(t, 3, hosts[0].Count)
+	assert.InDelta(t, 0.6666, hosts[0].RelativeVisitors, 0.01)
+	urls, err := analyzer.Events.OutboundURLs(nil)
+	assert.NoError(t, err)
+	assert.Len(t, urls, 3)
+	assert.Equal(t, "https://example.com/", urls[0].Target)
+	assert.Equal(t, "https://github.com/", urls[1].Target)
+	assert.Equal(t, "https://github.com/pirsch-analytics", urls[2].Target)
+	assert.Equal(t, 2, urls[2].Count)
+	downloads, err := analyzer.Events.Downloads(nil)
+	assert.NoError(t, err)
+	assert.Len(t, downloads, 2)
+	assert.Equal(t, "/files/guide.pdf", downloads[0].Target)
+	assert.Equal(t, 2, downloads[0].Visitors)
+	assert.Equal(t, "/files/data.zip", downloads[1].Target)
+	extensions, err := analyzer.Events.DownloadExtensions(nil)
+	assert.NoError(t, err)
+	assert.Len(t, extensions, 2)
+	assert.Equal(t, "pdf", extensions[0].Target)
+	assert.Equal(t, "zip", extensions[1].Target)
+	pages, err := analyzer.Events.DownloadPages(nil)
+	assert.NoError(t, err)
+	assert.Len(t, pages, 3)
+	assert.Equal(t, "/docs", pages[0].Path)
+	assert.Equal(t, "/files/data.zip", pages[0].Target)
+	assert.Equal(t, "/", pages[1].Path)
+	assert.Equal(t, "/files/guide.pdf", pages[1].Target)
+	assert.Equal(t, "/docs", pages[2].Path)
+	assert.Equal(t, "/files/guide.pdf", pages[2].Target)
+	events, err := analyzer.Events.Events(nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "signup", events[0].Name)
+	events, err = analyzer.Events.Events(&Filter{EventKind: []string{pkg.EventKindDownload}})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, pkg.EventNameDownload, events[0].Name)
+	visitors, err := analyzer.Visitors.Total(&Filter{EventKind: []string{pkg.EventKindDownload}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, visitors.Visitors)
+	_, err = analyzer.Events.OutboundHosts(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Events.DownloadPages(getMaxFilter(""))
+	assert.NoError(t, err)
+}
@@ -63,6 +63,9 @@ type Filter struct {
 	// Language filters for the ISO language code.
 	Language []string
 
+	// Locale filters for the locale (ISO language code and region, like "en-US").
+	Locale []string
+
 	// Country filters for the ISO country code.
 	Country []string
 
@@ -78,6 +81,12 @@ type Filter struct {
 	// ReferrerName filters for the referrer name.
 	ReferrerName []string
 
+	// ReferrerCategory filters for the referrer category (see pkg.ReferrerCategorySearch and following).
+	ReferrerCategory []string
+
+	// ReferrerKeyword filters for the search term passed by search engines.
+	ReferrerKeyword []string
+
 	// OS filters for the operating system.
 	OS []string
 
@@ -93,6 +102,21 @@ type Filter struct {
 	// Platform filters for the platform (desktop, mobile, unknown).
 	Platform string
 
+	// Webview filters for in-app browsers and webviews only.
+	Webview bool
+
+	// WebviewApp filters for the app hosting an in-app browser (like "Instagram").
+	WebviewApp []string
+
+	// DeviceType filters for the device type (see pkg.DeviceTypeDesktop and following).
+	DeviceType []string
+
+	// DeviceBrand filters for the device brand.
+	DeviceBrand []string
+
+	// DeviceModel filters for the device model.
+	DeviceModel []string
+
 	// ScreenClass filters for the screen class.
 	ScreenClass []string
 
@@ -117,9 +141,29 @@ type Filter struct {
 	// Tag filters for tags by their keys.
 	Tag []string
 
+	// Properties filters for session property key-value pairs.
+	Properties map[string]string
+
+	// Property filters for session properties by their keys.
+	Property []string
+
+	// SearchTerm filters page views for the site search term.
+	SearchTerm []string
+
+	// SearchCategory filters page views for the site search category.
+	SearchCategory []string
+
+	// StatusCode filters page views for the HTTP status code, like "404".
+	// A status class can be used as well, like "4xx" for all client errors.
+	StatusCode []string
+
 	// EventName filters for events by their name.
 	EventName []string
 
+	// EventKind filters for events by their kind, like pkg.EventKindOutboundLink or pkg.EventKindDownload.
+	// Use pkg.Unknown to filter for custom events.
+	EventKind []string
+
 	// EventMetaKey filters for an event meta key.
 	// This must be used together with an EventName.
 	EventMetaKey []string
@@ -127,6 +171,10 @@ type Filter struct {
 	// EventMeta filters for event metadata.
 	EventMeta map[string]string
 
+	// ErrorFingerprint filters JavaScript errors by their fingerprint.
+	// It's only used for Analyzer.Errors.
+	ErrorFingerprint []string
+
 	// VisitorID filters for a visitor.
 	// Must be used together with SessionID.
 	VisitorID uint64
@@ -293,15 +341,22 @@ func (filter *Filter) validate() {
 	filter.ExitPath = filter.removeDuplicates(filter.ExitPath)
 	filter.PathPattern = filter.removeDuplicates(filter.PathPattern)
 	filter.Language = filter.removeDuplicates(filter.Language)
+	filter.Locale = filter.removeDuplicates(filter.Locale)
 	filter.Country = countries
 	filter.Region = filter.removeDuplicates(filter.Region)
 	filter.City = filter.removeDuplicates(filter.City)
 	filter.Referrer = filter.removeDuplicates(filter.Referrer)
 	filter.ReferrerName = filter.removeDuplicates(filter.ReferrerName)
+	filter.ReferrerCategory = filter.removeDuplicates(filter.ReferrerCategory)
+	filter.ReferrerKeyword = filter.removeDuplicates(filter.ReferrerKeyword)
 	filter.OS = filter.removeDuplicates(filter.OS)
 	filter.OSVersion = filter.removeDuplicates(filter.OSVersion)
 	filter.Browser = filter.removeDuplicates(filter.Browser)
 	filter.BrowserVersion = filter.removeDuplicates(filter.BrowserVersion)
+	filter.WebviewApp = filter.removeDuplicates(filter.WebviewApp)
+	filter.DeviceType = filter.removeDuplicates(filter.DeviceType)
+	filter.DeviceBrand = filter.removeDuplicates(filter.DeviceBrand)
+	filter.DeviceModel = filter.removeDuplicates(filter.DeviceModel)
 	filter.ScreenClass = filter.removeDuplicates(filter.ScreenClass)
 	filter.UTMSource = filter.removeDuplicates(filter.UTMSource)
 	filter.UTMMedium = filter.removeDuplicates(filter.UTMMedium)
@@ -309,7 +364,13 @@ func (filter *Filter) validate() {
 	filter.UTMContent = filter.removeDuplicates(filter.UTMContent)
 	filter.UTMTerm = filter.removeDuplicates(filter.UTMTerm)
 	filter.Tag = filter.removeDuplicates(filter.Tag)
+	filter.Property = filter.removeDuplicates(filter.Property)
+	filter.SearchTerm = filter.removeDuplicates(filter.SearchTerm)
+	filter.SearchCategory = filter.removeDuplicates(filter.SearchCategory)
+	filter.StatusCode = filter.removeDuplicates(filter.StatusCode)
 	filter.EventName = filter.removeDuplicates(filter.EventName)
+	filter.EventKind = filter.removeDuplicates(filter.EventKind)
+	filter.ErrorFingerprint = filter.removeDuplicates(filter.ErrorFingerprint)
 	filter.EventMetaKey = filter.removeDuplicates(filter.EventMetaKey)
 }
 
@@ -351,14 +412,17 @@ func (filter *Filter) buildQuery(fields, groupBy, orderBy, fieldsImported []Fiel
 		final:          filter.fieldsContain(fields, FieldSessionsAll),
 	}
 	returnEventName := filter.fieldsContain(fields, FieldEventName)
+	returnEventTarget := filter.fieldsContain(fields, FieldEventTarget) ||
+		filter.fieldsContain(fields, FieldEventTargetHost) ||
+		filter.fieldsContain(fields, FieldEventTargetExtension)
 	customMetric := filter.CustomMetricKey != "" || filter.CustomMetricType != ""
 
-	if q.from == events && !returnEventName && !customMetric && !filter.fieldsContain(fields, FieldEventsAll) {
+	if q.from == events && !returnEventName && !returnEventTarget && !customMetric && !filter.fieldsContain(fields, FieldEventsAll) {
 		q.from = sessions
 		q.fields = filter.excludeFields(fields, FieldPath)
 		q.includeEventFilter = true
 		q.leftJoin = filter.leftJoinEvents(fields)
-	} else if q.from == pageViews || returnEventName || customMetric {
+	} else if q.from == pageViews || returnEventName || returnEventTarget || customMetric {
 		q.fields = fields
 
 		if q.from != sessions {
@@ -395,14 +459,21 @@ func (filter *Filter) table(fields []Field) table {
 		len(filter.PathPattern) > 0 ||
 		len(filter.Tags) > 0 ||
 		len(filter.Tag) > 0 ||
+		len(filter.SearchTerm) > 0 ||
+		len(filter.SearchCategory) > 0 ||
+		len(filter.StatusCode) > 0 ||
 		filter.fieldsContain(fields, FieldPageViewsAll) ||
 		filter.fieldsContain(fields, FieldPath) ||
 		filter.fieldsContain(fields, FieldEntries) ||
 		filter.fieldsContain(fields, FieldExits) ||
 		filter.fieldsContain(fields, FieldHour) ||
+		filter.fieldsContain(fields, FieldLocalHour) ||
 		filter.fieldsContain(fields, FieldMinute) ||
 		filter.fieldsContain(fields, FieldTagKeysRaw) ||
 		filter.fieldsContain(fields, FieldTagValuesRaw) ||
+		filter.fieldsContain(fields, FieldSearchTerm) ||
+		filter.fieldsContain(fields, FieldSearchCategory) ||
+		filter.fieldsContain(fields, FieldStatusCode) ||
 		tagKeyFilter ||
 		tagValueFilter ||
 		filter.searchContains(FieldPath)) &&
@@ -419,7 +490,11 @@ func (filter *Filter) table(fields []Field) table {
 	}
 
 	eventFilter := (len(filter.EventName) > 0 ||
+		len(filter.EventKind) > 0 ||
 		filter.fieldsContain(fields, FieldEventName) ||
+		filter.fieldsContain(fields, FieldEventTarget) ||
+		filter.fieldsContain(fields, FieldEventTargetHost) ||
+		filter.fieldsContain(fields, FieldEventTargetExtension) ||
 		filter.fieldsContain(fields, FieldEventsAll) ||
 		filter.CustomMetricType != "" && filter.CustomMetricKey != "") &&
 		!allSessionFilter
@@ -434,14 +509,18 @@ func (filter *Filter) table(fields []Field) table {
 func (filter *Filter) joinSessions(table table, fields []Field) *queryBuilder {
 	if len(filter.EntryPath) > 0 ||
 		len(filter.ExitPath) > 0 ||
+		len(filter.Properties) > 0 ||
+		len(filter.Property) > 0 ||
 		filter.fieldsContain(fields, FieldBounces) ||
 		(table == events && filter.fieldsContain(fields, FieldViews)) ||
+		filter.fieldsContain(fields, FieldAverageSessionDuration) ||
 		filter.fieldsContain(fields, FieldEntryPath) ||
+		filter.fieldsContain(fields, FieldTopEntryPaths) ||
 		filter.fieldsContain(fields, FieldExitPath) {
 		sessionFields := []Field{FieldVisitorID, FieldSessionID}
 		groupBy := []Field{FieldVisitorID, FieldSessionID}
 
-		if len(filter.EntryPath) > 0 || filter.fieldsContain(fields, FieldEntryPath) || filter.searchContains(FieldEntryPath) {
+		if len(filter.EntryPath) > 0 || filter.fieldsContain(fields, FieldEntryPath) || filter.fieldsContain(fields, FieldTopEntryPaths) || filter.searchContains(FieldEntryPath) {
 			sessionFields = append(sessionFields, FieldEntryPath)
 			groupBy = append(groupBy, FieldEntryPath)
 
@@ -469,6 +548,10 @@ func (filter *Filter) joinSessions(table table, fields []Field) *queryBuilder {
 			sessionFields = append(sessionFields, FieldViews)
 		}
 
+		if filter.fieldsContain(fields, FieldAverageSessionDuration) {
+			sessionFields = append(sessionFields, FieldSessionDurationSeconds)
+		}
+
 		filterCopy := *filter
 		filterCopy.Sort = nil
 		return &queryBuilder{
@@ -486,6 +569,7 @@ func (filter *Filter) joinSessions(table table, fields []Field) *queryBuilder {
 
 func (filter *Filter) joinPageViews(fields []Field) *queryBuilder {
 	if len(filter.Path) > 0 || len(filter.PathPattern) > 0 || len(filter.Tag) > 0 || len(filter.Tags) > 0 || filter.searchContains(FieldPath) ||
+		len(filter.SearchTerm) > 0 || len(filter.SearchCategory) > 0 || len(filter.StatusCode) > 0 ||
 		filter.fieldsContain(fields, FieldTagKey) || filter.fieldsContain(fields, FieldTagValue) ||
 		filter.fieldsContain(fields, FieldTagKeysRaw) || filter.fieldsContain(fields, FieldTagValuesRaw) {
 		pageViewFields := []Field{FieldVisitorID, FieldSessionID}
@@ -527,13 +611,17 @@ func (filter *Filter) joinOrLeftJoinEvents(q *queryBuilder, fields []Field) {
 }
 
 func (filter *Filter) joinEvents(fields []Field) *queryBuilder {
-	if len(filter.EventName) > 0 || filter.fieldsContain(fields, FieldEventName) {
+	if len(filter.EventName) > 0 || len(filter.EventKind) > 0 || filter.fieldsContain(fields, FieldEventName) {
 		eventFields := []Field{FieldVisitorID, FieldSessionID}
 
 		if filter.fieldsContain(fields, FieldHour) {
 			eventFields = append(eventFields, FieldHour)
 		}
 
+		if filter.fieldsContain(fields, FieldLocalHour) {
+			eventFields = append(eventFields, FieldLocalHour)
+		}
+
 		if filter.fieldsContain(fields, FieldMinute) {
 			eventFields = append(eventFields, FieldMinute)
 		}
@@ -593,6 +681,10 @@ func (filter *Filter) leftJoinEvents(fields []Field) *queryBuilder {
 		eventFields = append(eventFields, FieldEventMetaKeysRaw)
 	}
 
+	if len(filter.EventKind) > 0 {
+		eventFields = append(eventFields, FieldEventKind)
+	}
+
 	if filter.fieldsContain(fields, FieldEventPath) {
 		eventFields = append(eventFields, FieldEventPath)
 	}
@@ -603,6 +695,7 @@ func (filter *Filter) leftJoinEvents(fields []Field) *queryBuilder {
 
 	filterCopy := *filter
 	filterCopy.EventName = nil
+	filterCopy.EventKind = nil
 	filterCopy.EventMetaKey = nil
 	filterCopy.EventMeta = nil
 	filterCopy.Sort = nil
@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"fmt"
 	"github.com/pirsch-analytics/pirsch/v6/pkg"
 )
 
@@ -291,6 +292,37 @@ var (
 		Name:             "referrer_name",
 	}
 
+	// FieldReferrerCategory is a query result column.
+	FieldReferrerCategory = Field{
+		querySessions:  "referrer_category",
+		queryPageViews: "referrer_category",
+		queryDirection: "ASC",
+		Name:           "referrer_category",
+	}
+
+	// FieldReferrerKeyword is a query result column.
+	FieldReferrerKeyword = Field{
+		querySessions:  "referrer_keyword",
+		queryPageViews: "referrer_keyword",
+		queryDirection: "ASC",
+		Name:           "referrer_keyword",
+	}
+
+	// FieldAverageSessionDuration is a query result column.
+	FieldAverageSessionDuration = Field{
+		querySessions:  "toUInt64(greatest(sum(duration_seconds*sign), 0) / greatest(sum(sign), 1))",
+		queryPageViews: "toUInt64(arrayAvg(arrayMap(s -> s.3, groupUniqArray((t.visitor_id, t.session_id, session_duration_seconds)))))",
+		queryDirection: "DESC",
+		Name:           "average_session_duration_seconds",
+	}
+
+	// FieldSessionDurationSeconds is a query result column.
+	FieldSessionDurationSeconds = Field{
+		querySessions:  "sum(duration_seconds*sign)",
+		queryPageViews: "sum(duration_seconds*sign)",
+		Name:           "session_duration_seconds",
+	}
+
 	// FieldReferrerIcon is a query result column.
 	FieldReferrerIcon = Field{
 		querySessions:  "any(referrer_icon)",
@@ -308,6 +340,44 @@ var (
 		Name:           "referrer_icon",
 	}
 
+	// FieldSearchTerm is a query result column.
+	FieldSearchTerm = Field{
+		querySessions:  "search_term",
+		queryPageViews: "search_term",
+		queryDirection: "ASC",
+		Name:           "search_term",
+	}
+
+	// FieldSearchCategory is a query result column.
+	FieldSearchCategory = Field{
+		querySessions:  "search_category",
+		queryPageViews: "search_category",
+		queryDirection: "ASC",
+		Name:           "search_category",
+	}
+
+	// FieldStatusCode is a query result column.
+	FieldStatusCode = Field{
+		querySessions:  "status_code",
+		queryPageViews: "status_code",
+		queryDirection: "ASC",
+		Name:           "status_code",
+	}
+
+	// FieldTopReferrers is a query result column.
+	FieldTopReferrers = Field{
+		querySessions:  "arrayFilter(r -> r != '', topK(10)(referrer))",
+		queryPageViews: "arrayFilter(r -> r != '', topK(10)(referrer))",
+		Name:           "referrers",
+	}
+
+	// FieldTopEntryPaths is a query result column.
+	FieldTopEntryPaths = Field{
+		querySessions:  "topK(10)(entry_path)",
+		queryPageViews: "topK(10)(entry_path)",
+		Name:           "entry_paths",
+	}
+
 	// FieldLanguage is a query result column.
 	FieldLanguage = Field{
 		querySessions:  "language",
@@ -317,6 +387,23 @@ var (
 		Name:           "language",
 	}
 
+	// FieldLocale is a query result column.
+	FieldLocale = Field{
+		querySessions:  "locale",
+		queryPageViews: "locale",
+		queryDirection: "ASC",
+		Name:           "locale",
+	}
+
+	// FieldSecondaryLanguage is a query result column.
+	// It lists all preferred languages of a visitor except for the primary language.
+	FieldSecondaryLanguage = Field{
+		querySessions:  "arrayJoin(arrayFilter(l -> l != language, languages))",
+		queryPageViews: "arrayJoin(arrayFilter(l -> l != language, languages))",
+		queryDirection: "ASC",
+		Name:           "secondary_language",
+	}
+
 	// FieldCountryCity is a query result column.
 	// This field can only be used in combination with the FieldCity.
 	FieldCountryCity = Field{
@@ -408,6 +495,38 @@ var (
 		Name:           "os_version",
 	}
 
+	// FieldWebviewApp is a query result column.
+	FieldWebviewApp = Field{
+		querySessions:  "webview_app",
+		queryPageViews: "webview_app",
+		queryDirection: "ASC",
+		Name:           "webview_app",
+	}
+
+	// FieldDeviceType is a query result column.
+	FieldDeviceType = Field{
+		querySessions:  "device_type",
+		queryPageViews: "device_type",
+		queryDirection: "ASC",
+		Name:           "device_type",
+	}
+
+	// FieldDeviceBrand is a query result column.
+	FieldDeviceBrand = Field{
+		querySessions:  "device_brand",
+		queryPageViews: "device_brand",
+		queryDirection: "ASC",
+		Name:           "device_brand",
+	}
+
+	// FieldDeviceModel is a query result column.
+	FieldDeviceModel = Field{
+		querySessions:  "device_model",
+		queryPageViews: "device_model",
+		queryDirection: "ASC",
+		Name:           "device_model",
+	}
+
 	// FieldScreenClass is a query result column.
 	FieldScreenClass = Field{
 		querySessions:  "screen_class",
@@ -487,6 +606,34 @@ var (
 		Name:           "value",
 	}
 
+	// FieldPropertyKeysRaw is a query result column.
+	FieldPropertyKeysRaw = Field{
+		querySessions:  "property_keys",
+		queryPageViews: "property_keys",
+		Name:           "property_keys",
+	}
+
+	// FieldPropertyValuesRaw is a query result column.
+	FieldPropertyValuesRaw = Field{
+		querySessions:  "property_values",
+		queryPageViews: "property_values",
+		Name:           "property_values",
+	}
+
+	// FieldPropertyKey is a query result column.
+	FieldPropertyKey = Field{
+		querySessions:  "arrayJoin(property_keys)",
+		queryPageViews: "arrayJoin(property_keys)",
+		Name:           "key",
+	}
+
+	// FieldPropertyValue is a query result column.
+	FieldPropertyValue = Field{
+		querySessions:  "property_values[indexOf(property_keys, ?)]",
+		queryPageViews: "property_values[indexOf(property_keys, ?)]",
+		Name:           "value",
+	}
+
 	// FieldTitle is a query result column.
 	FieldTitle = Field{
 		querySessions:  "title",
@@ -587,6 +734,26 @@ var (
 		Name:           "weekday",
 	}
 
+	// FieldLocalHour is a query result column.
+	// It's the hour of day in the visitor's time zone.
+	FieldLocalHour = Field{
+		querySessions:  "toHour(addSeconds(time, timezone_offset), 'UTC')",
+		queryPageViews: "toHour(addSeconds(time, timezone_offset), 'UTC')",
+		queryDirection: "ASC",
+		queryWithFill:  "WITH FILL FROM 0 TO 24",
+		Name:           "hour",
+	}
+
+	// FieldLocalWeekday is a query result column.
+	// It's the weekday in the visitor's time zone.
+	FieldLocalWeekday = Field{
+		querySessions:  "toDayOfWeek(addSeconds(time, timezone_offset), 1, 'UTC')",
+		queryPageViews: "toDayOfWeek(addSeconds(time, timezone_offset), 1, 'UTC')",
+		queryDirection: "ASC",
+		queryWithFill:  "WITH FILL FROM 0 TO 7",
+		Name:           "weekday",
+	}
+
 	// FieldEventName is a query result column.
 	FieldEventName = Field{
 		querySessions:  "event_name",
@@ -595,6 +762,38 @@ var (
 		queryDirection: "ASC",
 	}
 
+	// FieldEventKind is a query result column.
+	FieldEventKind = Field{
+		querySessions:  "event_kind",
+		queryPageViews: "event_kind",
+		Name:           "event_kind",
+		queryDirection: "ASC",
+	}
+
+	// FieldEventTarget is a query result column.
+	FieldEventTarget = Field{
+		querySessions:  "event_target",
+		queryPageViews: "event_target",
+		Name:           "event_target",
+		queryDirection: "ASC",
+	}
+
+	// FieldEventTargetHost is a query result column.
+	FieldEventTargetHost = Field{
+		querySessions:  "event_target_host",
+		queryPageViews: "event_target_host",
+		Name:           "event_target_host",
+		queryDirection: "ASC",
+	}
+
+	// FieldEventTargetExtension is a query result column.
+	FieldEventTargetExtension = Field{
+		querySessions:  "event_target_extension",
+		queryPageViews: "event_target_extension",
+		Name:           "event_target_extension",
+		queryDirection: "ASC",
+	}
+
 	// FieldEventMeta is a query result column.
 	FieldEventMeta = Field{
 		querySessions:  "cast(arraySort(arrayZip(event_meta_keys, event_meta_values)), 'Map(String, String)')",
@@ -722,6 +921,18 @@ var (
 	}
 )
 
+// fieldReferrerPathPrefix returns a query result column for the referrer URL cut after given number of path segments.
+// For a depth of 2, "https://reddit.com/r/golang/comments/abc" becomes "https://reddit.com/r/golang".
+func fieldReferrerPathPrefix(depth int) Field {
+	q := fmt.Sprintf("concat(protocol(referrer), '://', domain(referrer), arrayStringConcat(arraySlice(splitByChar('/', path(referrer)), 1, %d), '/'))", depth+1)
+	return Field{
+		querySessions:  q,
+		queryPageViews: q,
+		queryDirection: "ASC",
+		Name:           "referrer_path",
+	}
+}
+
 const (
 	sampleTypeInt   = sampleType(1)
 	sampleTypeFloat = sampleType(2)
@@ -757,7 +968,10 @@ const (
 		any(t.utm_campaign) session_utm_campaign,
 		any(t.utm_content) session_utm_content,
 		any(t.utm_term) session_utm_term,
-		max(t.extended) session_extended`
+		max(t.extended) session_extended,
+		argMax(t.property_keys, t.time) session_property_keys,
+		argMax(t.property_values, t.time) session_property_values,
+		max(t.user_id) session_user_id`
 )
 
 type sampleType int
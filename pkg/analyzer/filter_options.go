@@ -88,6 +88,21 @@ func (options *FilterOptions) Languages(filter *Filter) ([]string, error) {
 	return options.selectFilterOptions(filter, "language", "session")
 }
 
+// Locales returns all locales.
+func (options *FilterOptions) Locales(filter *Filter) ([]string, error) {
+	return options.selectFilterOptions(filter, "locale", "session")
+}
+
+// DeviceBrands returns all device brands.
+func (options *FilterOptions) DeviceBrands(filter *Filter) ([]string, error) {
+	return options.selectFilterOptions(filter, "device_brand", "session")
+}
+
+// DeviceModels returns all device models.
+func (options *FilterOptions) DeviceModels(filter *Filter) ([]string, error) {
+	return options.selectFilterOptions(filter, "device_model", "session")
+}
+
 // EventMetadataValues returns all metadata values.
 func (options *FilterOptions) EventMetadataValues(filter *Filter) ([]string, error) {
 	if filter == nil || len(filter.EventName) == 0 {
@@ -271,6 +271,48 @@ func TestFilterOptions_Languages(t *testing.T) {
 	assert.Equal(t, "ja", utmSource[1])
 }
 
+func TestFilterOptions_Locales(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveSessions([]model.Session{
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(4), Start: util.PastDay(4), Locale: "en-US"},
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(2), Start: util.PastDay(2), Locale: "en-GB"},
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(1), Start: util.PastDay(1), Locale: "de-DE"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	options, err := analyzer.Options.Locales(nil)
+	assert.NoError(t, err)
+	assert.Len(t, options, 3)
+	assert.Equal(t, "de-DE", options[0])
+	assert.Equal(t, "en-GB", options[1])
+	assert.Equal(t, "en-US", options[2])
+	options, err = analyzer.Options.Locales(&Filter{From: util.PastDay(3), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, options, 2)
+}
+
+func TestFilterOptions_DeviceBrands(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveSessions([]model.Session{
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(4), Start: util.PastDay(4), DeviceBrand: "Samsung", DeviceModel: "SM-S918B"},
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(2), Start: util.PastDay(2), DeviceBrand: "Apple", DeviceModel: "iPhone"},
+		{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(1), Start: util.PastDay(1), DeviceBrand: "Google", DeviceModel: "Pixel 7"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	options, err := analyzer.Options.DeviceBrands(nil)
+	assert.NoError(t, err)
+	assert.Len(t, options, 3)
+	assert.Equal(t, "Apple", options[0])
+	assert.Equal(t, "Google", options[1])
+	assert.Equal(t, "Samsung", options[2])
+	options, err = analyzer.Options.DeviceModels(&Filter{From: util.PastDay(3), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, options, 2)
+	assert.Equal(t, "Pixel 7", options[0])
+	assert.Equal(t, "iPhone", options[1])
+}
+
 func TestFilterOptions_EventMetadataValues(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	assert.NoError(t, dbClient.SaveEvents([]model.Event{
@@ -0,0 +1,84 @@
+package analyzer
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+)
+
+const (
+	defaultHeatmapCells = 20
+	maxHeatmapCells     = 100
+)
+
+// Heatmap aggregates clicks for heatmaps grouped by path and screen class.
+// Only the time range and the Hostname, Path, and ScreenClass filters are applied.
+type Heatmap struct {
+	analyzer *Analyzer
+	store    db.Store
+}
+
+// Grid returns the number of clicks and their density in a grid of cells x cells over the page, grouped by path and screen class.
+// The number of cells defaults to 20 and is limited to 100. Cells without clicks are not returned.
+func (heatmap *Heatmap) Grid(filter *Filter, cells int) ([]model.ClickGridStats, error) {
+	filter = heatmap.analyzer.getFilter(filter)
+
+	if cells <= 0 {
+		cells = defaultHeatmapCells
+	} else if cells > maxHeatmapCells {
+		cells = maxHeatmapCells
+	}
+
+	where, args := heatmap.where(filter)
+	query := fmt.Sprintf(`SELECT path, screen_class, cell_x x, cell_y y, clicks,
+		clicks / sum(clicks) OVER (PARTITION BY path, screen_class) density
+		FROM (
+			SELECT path, screen_class,
+			least(intDiv(x * %d, 10000), %d) cell_x,
+			least(intDiv(y * %d, 10000), %d) cell_y,
+			count(*) clicks
+			FROM "click"
+			%s
+			GROUP BY path, screen_class, cell_x, cell_y
+		)
+		ORDER BY path, screen_class, y, x`, cells, cells-1, cells, cells-1, where)
+	return heatmap.store.SelectClickGridStats(filter.Ctx, query, args...)
+}
+
+// Selectors returns the visitor count and number of clicks grouped by path, screen class, and CSS selector.
+// The relative clicks are the share of all clicks on the page and screen class, including clicks without a selector.
+// Use Filter.Limit to get the top selectors only.
+func (heatmap *Heatmap) Selectors(filter *Filter) ([]model.ClickSelectorStats, error) {
+	filter = heatmap.analyzer.getFilter(filter)
+	where, args := heatmap.where(filter)
+	q := queryBuilder{
+		filter: filter,
+		offset: filter.Offset,
+		limit:  filter.Limit,
+	}
+	q.withLimit()
+	query := fmt.Sprintf(`SELECT path, screen_class, selector, visitors, clicks, relative_clicks
+		FROM (
+			SELECT path, screen_class, selector,
+			uniq(visitor_id) visitors,
+			count(*) clicks,
+			clicks / sum(clicks) OVER (PARTITION BY path, screen_class) relative_clicks
+			FROM "click"
+			%s
+			GROUP BY path, screen_class, selector
+		)
+		WHERE selector != ''
+		ORDER BY clicks DESC, path ASC, screen_class ASC, selector ASC
+		%s`, where, q.q.String())
+	return heatmap.store.SelectClickSelectorStats(filter.Ctx, query, args...)
+}
+
+func (heatmap *Heatmap) where(filter *Filter) (string, []any) {
+	q := queryBuilder{filter: filter}
+	where := q.whereTime()
+	q.whereField(FieldHostname.Name, filter.Hostname)
+	q.whereField(FieldPath.Name, filter.Path)
+	q.whereField(FieldScreenClass.Name, filter.ScreenClass)
+	q.whereWrite()
+	return where + q.q.String(), q.args
+}
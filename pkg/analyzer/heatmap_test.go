@@ -0,0 +1,60 @@
+package analyzer
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestHeatmap(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveClicks([]model.Click{
+		{VisitorID: 1, Time: util.Today(), Path: "/", X: 100, Y: 100, ScreenClass: "XL", Selector: "#signup"},
+		{VisitorID: 1, Time: util.Today().Add(time.Second), Path: "/", X: 400, Y: 200, ScreenClass: "XL", Selector: "#signup"},
+		{VisitorID: 2, Time: util.Today().Add(time.Second * 2), Path: "/", X: 9999, Y: 10000, ScreenClass: "XL"},
+		{VisitorID: 2, Time: util.Today().Add(time.Second * 3), Path: "/", X: 5000, Y: 5000, ScreenClass: "S", Selector: "nav > a"},
+		{VisitorID: 3, Time: util.Today().Add(time.Second * 4), Path: "/pricing", X: 5000, Y: 5000, ScreenClass: "XL", Selector: "#buy"},
+	}))
+	analyzer := NewAnalyzer(dbClient)
+	grid, err := analyzer.Heatmap.Grid(&Filter{Path: []string{"/"}}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, grid, 3)
+	assert.Equal(t, "S", grid[0].ScreenClass)
+	assert.Equal(t, 10, grid[0].X)
+	assert.Equal(t, 10, grid[0].Y)
+	assert.InDelta(t, 1, grid[0].Density, 0.01)
+	assert.Equal(t, "XL", grid[1].ScreenClass)
+	assert.Equal(t, 0, grid[1].X)
+	assert.Equal(t, 0, grid[1].Y)
+	assert.Equal(t, 2, grid[1].Clicks)
+	assert.InDelta(t, 0.6666, grid[1].Density, 0.01)
+	assert.Equal(t, 19, grid[2].X)
+	assert.Equal(t, 19, grid[2].Y)
+	grid, err = analyzer.Heatmap.Grid(&Filter{Path: []string{"/"}, ScreenClass: []string{"XL"}}, 200)
+	assert.NoError(t, err)
+	assert.Len(t, grid, 3)
+	assert.Equal(t, 1, grid[0].X)
+	assert.Equal(t, 1, grid[0].Y)
+	assert.Equal(t, 99, grid[2].X)
+	selectors, err := analyzer.Heatmap.Selectors(nil)
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 3)
+	assert.Equal(t, "/", selectors[0].Path)
+	assert.Equal(t, "XL", selectors[0].ScreenClass)
+	assert.Equal(t, "#signup", selectors[0].Selector)
+	assert.Equal(t, 1, selectors[0].Visitors)
+	assert.Equal(t, 2, selectors[0].Clicks)
+	assert.InDelta(t, 0.6666, selectors[0].RelativeClicks, 0.01)
+	assert.Equal(t, "nav > a", selectors[1].Selector)
+	assert.Equal(t, "#buy", selectors[2].Selector)
+	selectors, err = analyzer.Heatmap.Selectors(&Filter{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 1)
+	_, err = analyzer.Heatmap.Grid(getMaxFilter(""), 10)
+	assert.NoError(t, err)
+	_, err = analyzer.Heatmap.Selectors(getMaxFilter(""))
+	assert.NoError(t, err)
+}
@@ -331,6 +331,40 @@ func (pages *Pages) Conversions(filter *Filter) (*model.ConversionsStats, error)
 	return stats, nil
 }
 
+// BrokenLinks returns the visitor count and views grouped by hostname, path, and status code for error pages,
+// together with the most common referrers and entry pages leading to them.
+// Unless Filter.StatusCode is set, all page views with a 4xx or 5xx status code are returned.
+func (pages *Pages) BrokenLinks(filter *Filter) ([]model.BrokenLinkStats, error) {
+	filter = pages.analyzer.getFilter(filter)
+
+	if len(filter.StatusCode) == 0 {
+		filter.StatusCode = []string{"4xx", "5xx"}
+	}
+
+	fields := []Field{
+		FieldHostname,
+		FieldPath,
+		FieldStatusCode,
+		FieldVisitors,
+		FieldViews,
+		FieldTopReferrers,
+		FieldTopEntryPaths,
+	}
+	groupBy := []Field{
+		FieldHostname,
+		FieldPath,
+		FieldStatusCode,
+	}
+	orderBy := []Field{
+		FieldVisitors,
+		FieldHostname,
+		FieldPath,
+		FieldStatusCode,
+	}
+	q, args := filter.buildQuery(fields, groupBy, orderBy, nil, "")
+	return pages.store.SelectBrokenLinkStats(filter.Ctx, q, args...)
+}
+
 func (pages *Pages) totalVisitorsSessions(filter *Filter, paths []string) ([]model.TotalVisitorSessionStats, error) {
 	if len(paths) == 0 {
 		return []model.TotalVisitorSessionStats{}, nil
@@ -1680,6 +1680,53 @@ func TestAnalyzer_Conversions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAnalyzer_BrokenLinks(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: util.Today(), Start: time.Now(), EntryPath: "/old-post", ExitPath: "/old-post", Referrer: "https://partner.com/article", PageViews: 1},
+			{Sign: 1, VisitorID: 2, Time: util.Today(), Start: time.Now(), EntryPath: "/old-post", ExitPath: "/old-post", Referrer: "https://partner.com/article", PageViews: 1},
+			{Sign: 1, VisitorID: 3, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/api", PageViews: 2},
+		},
+	})
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, Time: util.Today(), Path: "/old-post", Referrer: "https://partner.com/article", StatusCode: 404},
+		{VisitorID: 2, Time: util.Today(), Path: "/old-post", Referrer: "https://partner.com/article", StatusCode: 404},
+		{VisitorID: 3, Time: util.Today(), Path: "/", StatusCode: 200},
+		{VisitorID: 3, Time: util.Today().Add(time.Second), Path: "/api", StatusCode: 500},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	stats, err := analyzer.Pages.BrokenLinks(nil)
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, "/old-post", stats[0].Path)
+	assert.Equal(t, uint16(404), stats[0].StatusCode)
+	assert.Equal(t, 2, stats[0].Visitors)
+	assert.Equal(t, 2, stats[0].Views)
+	assert.Equal(t, []string{"https://partner.com/article"}, stats[0].Referrers)
+	assert.Equal(t, []string{"/old-post"}, stats[0].EntryPaths)
+	assert.Equal(t, "/api", stats[1].Path)
+	assert.Equal(t, uint16(500), stats[1].StatusCode)
+	assert.Empty(t, stats[1].Referrers)
+	assert.Equal(t, []string{"/"}, stats[1].EntryPaths)
+	stats, err = analyzer.Pages.BrokenLinks(&Filter{StatusCode: []string{"5xx"}})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, "/api", stats[0].Path)
+	pages, err := analyzer.Pages.ByPath(&Filter{StatusCode: []string{"200"}})
+	assert.NoError(t, err)
+	assert.Len(t, pages, 1)
+	assert.Equal(t, "/", pages[0].Path)
+	visitors, err := analyzer.Visitors.Total(&Filter{StatusCode: []string{"!200"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, visitors.Visitors)
+	_, err = analyzer.Pages.BrokenLinks(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Pages.BrokenLinks(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
 func TestAnalyzer_avgTimeOnPage(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	saveSessions(t, [][]model.Session{
@@ -0,0 +1,69 @@
+package analyzer
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"strings"
+)
+
+// Privacy aggregates statistics for requests that opted out of tracking using Global Privacy Control or Do-Not-Track.
+// Opted-out requests are stored for each page view and event, so they are compared against tracked page views and events.
+// Only the time range and the Hostname and Path filters are applied. Bots are excluded.
+type Privacy struct {
+	analyzer *Analyzer
+	store    db.Store
+}
+
+// OptOut returns the number of hits (page views and events) and how many of them opted out of tracking.
+func (privacy *Privacy) OptOut(filter *Filter) (*model.OptOutStats, error) {
+	filter = privacy.analyzer.getFilter(filter)
+	where, args := privacy.where(filter, false)
+	whereOptOut, argsOptOut := privacy.where(filter, true)
+	query := fmt.Sprintf(`SELECT (SELECT count(*) FROM "page_view" %s) +
+		(SELECT count(*) FROM "event" %s) +
+		opted_out requests,
+		(SELECT count(*) FROM "request" %s AND opt_out = 1) opted_out,
+		opted_out / greatest(requests, 1) relative_opted_out`, where, where, whereOptOut)
+	args = append(append(args, args...), argsOptOut...)
+	return privacy.store.GetOptOutStats(filter.Ctx, query, args...)
+}
+
+// Pages returns the number of opted-out requests grouped by path.
+// The path is only stored for requests recorded using tracker.PrivacyModeAggregate.
+// Use Filter.Offset and Filter.Limit for pagination.
+func (privacy *Privacy) Pages(filter *Filter) ([]model.OptOutPathStats, error) {
+	filter = privacy.analyzer.getFilter(filter)
+	where, args := privacy.where(filter, true)
+	q := queryBuilder{
+		filter: filter,
+		offset: filter.Offset,
+		limit:  filter.Limit,
+	}
+	q.withLimit()
+	query := fmt.Sprintf(`SELECT path, count(*) requests
+		FROM "request"
+		%s
+		AND opt_out = 1
+		AND path != ''
+		GROUP BY path
+		ORDER BY requests DESC, path ASC
+		%s`, where, q.q.String())
+	return privacy.store.SelectOptOutPathStats(filter.Ctx, query, args...)
+}
+
+func (privacy *Privacy) where(filter *Filter, requests bool) (string, []any) {
+	q := queryBuilder{filter: filter}
+	var where strings.Builder
+	where.WriteString(q.whereTime())
+
+	if requests {
+		where.WriteString("AND bot = 0 ")
+	}
+
+	q.whereField(FieldHostname.Name, filter.Hostname)
+	q.whereField(FieldPath.Name, filter.Path)
+	q.whereWrite()
+	where.WriteString(q.q.String())
+	return where.String(), q.args
+}
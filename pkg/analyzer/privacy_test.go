@@ -0,0 +1,56 @@
+package analyzer
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPrivacy(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, Time: util.Today(), Hostname: "example.com", Path: "/"},
+		{VisitorID: 1, Time: util.Today(), Hostname: "example.com", Path: "/pricing"},
+	}))
+	assert.NoError(t, dbClient.SaveEvents([]model.Event{
+		{Name: "event", VisitorID: 1, Time: util.Today(), Hostname: "example.com", Path: "/pricing"},
+	}))
+	assert.NoError(t, dbClient.SaveRequests([]model.Request{
+		{VisitorID: 1, Time: util.Today(), Hostname: "example.com", Path: "/"},
+		{Time: util.Today(), Hostname: "example.com", Path: "/pricing", OptOut: true},
+		{Time: util.Today(), Hostname: "example.com", Path: "/pricing", OptOut: true},
+		{Time: util.Today(), Hostname: "example.com", Path: "/pricing", OptOut: true},
+		{Time: util.Today(), Hostname: "example.com", Path: "/", OptOut: true},
+		{Time: util.Today(), Hostname: "example.com", OptOut: true},
+		{Time: util.Today(), Hostname: "example.com", Path: "/", Bot: true, BotReason: "ua"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	stats, err := analyzer.Privacy.OptOut(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, stats.Requests)
+	assert.Equal(t, 5, stats.OptedOut)
+	assert.InDelta(t, 0.625, stats.RelativeOptedOut, 0.001)
+	stats, err = analyzer.Privacy.OptOut(&Filter{Path: []string{"/"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stats.Requests)
+	assert.Equal(t, 1, stats.OptedOut)
+	pages, err := analyzer.Privacy.Pages(nil)
+	assert.NoError(t, err)
+	assert.Len(t, pages, 2)
+	assert.Equal(t, "/pricing", pages[0].Path)
+	assert.Equal(t, 3, pages[0].Requests)
+	assert.Equal(t, "/", pages[1].Path)
+	assert.Equal(t, 1, pages[1].Requests)
+	pages, err = analyzer.Privacy.Pages(&Filter{Offset: 1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, pages, 1)
+	assert.Equal(t, "/", pages[0].Path)
+	_, err = analyzer.Privacy.OptOut(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Privacy.Pages(getMaxFilter(""))
+	assert.NoError(t, err)
+}
@@ -103,6 +103,10 @@ func (query *queryBuilder) getFields() []string {
 		} else if len(query.filter.Tag) > 0 {
 			fields = append(fields, FieldTagKeysRaw.Name)
 		}
+
+		query.appendField(&fields, FieldSearchTerm.Name, query.filter.SearchTerm)
+		query.appendField(&fields, FieldSearchCategory.Name, query.filter.SearchCategory)
+		query.appendField(&fields, FieldStatusCode.Name, query.filter.StatusCode)
 	}
 
 	if query.from == events {
@@ -117,15 +121,22 @@ func (query *queryBuilder) getFields() []string {
 
 	query.appendField(&fields, FieldHostname.Name, query.filter.Hostname)
 	query.appendField(&fields, FieldLanguage.Name, query.filter.Language)
+	query.appendField(&fields, FieldLocale.Name, query.filter.Locale)
 	query.appendField(&fields, FieldCountry.Name, query.filter.Country)
 	query.appendField(&fields, FieldRegion.Name, query.filter.Region)
 	query.appendField(&fields, FieldCity.Name, query.filter.City)
 	query.appendField(&fields, FieldReferrer.Name, query.filter.Referrer)
 	query.appendField(&fields, FieldReferrerName.Name, query.filter.ReferrerName)
+	query.appendField(&fields, FieldReferrerCategory.Name, query.filter.ReferrerCategory)
+	query.appendField(&fields, FieldReferrerKeyword.Name, query.filter.ReferrerKeyword)
 	query.appendField(&fields, FieldOS.Name, query.filter.OS)
 	query.appendField(&fields, FieldOSVersion.Name, query.filter.OSVersion)
 	query.appendField(&fields, FieldBrowser.Name, query.filter.Browser)
 	query.appendField(&fields, FieldBrowserVersion.Name, query.filter.BrowserVersion)
+	query.appendField(&fields, FieldWebviewApp.Name, query.filter.WebviewApp)
+	query.appendField(&fields, FieldDeviceType.Name, query.filter.DeviceType)
+	query.appendField(&fields, FieldDeviceBrand.Name, query.filter.DeviceBrand)
+	query.appendField(&fields, FieldDeviceModel.Name, query.filter.DeviceModel)
 	query.appendField(&fields, FieldScreenClass.Name, query.filter.ScreenClass)
 	query.appendField(&fields, FieldUTMSource.Name, query.filter.UTMSource)
 	query.appendField(&fields, FieldUTMMedium.Name, query.filter.UTMMedium)
@@ -133,6 +144,10 @@ func (query *queryBuilder) getFields() []string {
 	query.appendField(&fields, FieldUTMContent.Name, query.filter.UTMContent)
 	query.appendField(&fields, FieldUTMTerm.Name, query.filter.UTMTerm)
 
+	if query.filter.Webview {
+		fields = append(fields, "webview")
+	}
+
 	if query.filter.Platform != "" {
 		platform := query.filter.Platform
 
@@ -233,6 +248,11 @@ func (query *queryBuilder) selectFields() bool {
 					query.args = append(query.args, query.filter.Tag[0])
 					q.WriteString(fmt.Sprintf("%s %s,", query.selectField(query.fields[i]), query.fields[i].Name))
 				}
+			} else if query.fields[i] == FieldPropertyValue {
+				if len(query.filter.Property) > 0 {
+					query.args = append(query.args, query.filter.Property[0])
+					q.WriteString(fmt.Sprintf("%s %s,", query.selectField(query.fields[i]), query.fields[i].Name))
+				}
 			} else if !includeImported && (query.fields[i] == FieldEventMetaCustomMetricAvg || query.fields[i] == FieldEventMetaCustomMetricTotal) {
 				query.args = append(query.args, query.filter.CustomMetricKey)
 				fieldCopy := query.fields[i]
@@ -341,7 +361,7 @@ func (query *queryBuilder) joinQuery() {
 		query.args = append(query.args, args...)
 		query.q.WriteString(fmt.Sprintf("JOIN (%s) j ON j.visitor_id = t.visitor_id AND j.session_id = t.session_id ", q))
 
-		if query.filter.fieldsContain(query.join.groupBy, FieldHour) {
+		if query.filter.fieldsContain(query.join.groupBy, FieldHour) || query.filter.fieldsContain(query.join.groupBy, FieldLocalHour) {
 			query.q.WriteString("AND j.hour = hour ")
 		} else if query.filter.fieldsContain(query.join.groupBy, FieldMinute) {
 			query.q.WriteString("AND j.minute = minute ")
@@ -353,7 +373,7 @@ func (query *queryBuilder) joinQuery() {
 		query.args = append(query.args, args...)
 		query.q.WriteString(fmt.Sprintf("JOIN (%s) k ON k.visitor_id = t.visitor_id AND k.session_id = t.session_id ", q))
 
-		if query.filter.fieldsContain(query.joinSecond.groupBy, FieldHour) {
+		if query.filter.fieldsContain(query.joinSecond.groupBy, FieldHour) || query.filter.fieldsContain(query.joinSecond.groupBy, FieldLocalHour) {
 			query.q.WriteString("AND k.hour = hour ")
 		} else if query.filter.fieldsContain(query.joinSecond.groupBy, FieldMinute) {
 			query.q.WriteString("AND k.minute = minute ")
@@ -579,32 +599,48 @@ func (query *queryBuilder) whereFields() {
 	if query.from == sessions {
 		query.whereField(FieldEntryPath.Name, query.filter.EntryPath)
 		query.whereField(FieldExitPath.Name, query.filter.ExitPath)
+		query.whereField(FieldPropertyKeysRaw.Name, query.filter.Property)
+		query.whereFieldProperties()
 	} else {
 		query.whereField(FieldPath.Name, query.filter.Path)
 		query.whereField(FieldTagKeysRaw.Name, query.filter.Tag)
 		query.whereFieldPathPattern()
 		query.whereFieldPathIn()
 		query.whereFieldTag()
+
+		if query.from == pageViews {
+			query.whereField(FieldSearchTerm.Name, query.filter.SearchTerm)
+			query.whereField(FieldSearchCategory.Name, query.filter.SearchCategory)
+			query.whereFieldStatusCode()
+		}
 	}
 
 	if query.from == events || query.includeEventFilter {
 		query.whereField(FieldPath.Name, query.filter.Path)
 		query.whereField(FieldEventName.Name, query.filter.EventName)
+		query.whereField(FieldEventKind.Name, query.filter.EventKind)
 		query.whereField(FieldEventMetaKeysRaw.Name, query.filter.EventMetaKey)
 		query.whereFieldMeta()
 	}
 
 	query.whereField(FieldHostname.Name, query.filter.Hostname)
 	query.whereField(FieldLanguage.Name, query.filter.Language)
+	query.whereField(FieldLocale.Name, query.filter.Locale)
 	query.whereField(FieldCountry.Name, query.filter.Country)
 	query.whereField(FieldRegion.Name, query.filter.Region)
 	query.whereField(FieldCity.Name, query.filter.City)
 	query.whereField(FieldReferrer.Name, query.filter.Referrer)
 	query.whereField(FieldReferrerName.Name, query.filter.ReferrerName)
+	query.whereField(FieldReferrerCategory.Name, query.filter.ReferrerCategory)
+	query.whereField(FieldReferrerKeyword.Name, query.filter.ReferrerKeyword)
 	query.whereField(FieldOS.Name, query.filter.OS)
 	query.whereField(FieldOSVersion.Name, query.filter.OSVersion)
 	query.whereField(FieldBrowser.Name, query.filter.Browser)
 	query.whereField(FieldBrowserVersion.Name, query.filter.BrowserVersion)
+	query.whereField(FieldWebviewApp.Name, query.filter.WebviewApp)
+	query.whereField(FieldDeviceType.Name, query.filter.DeviceType)
+	query.whereField(FieldDeviceBrand.Name, query.filter.DeviceBrand)
+	query.whereField(FieldDeviceModel.Name, query.filter.DeviceModel)
 	query.whereField(FieldScreenClass.Name, query.filter.ScreenClass)
 	query.whereField(FieldUTMSource.Name, query.filter.UTMSource)
 	query.whereField(FieldUTMMedium.Name, query.filter.UTMMedium)
@@ -612,7 +648,9 @@ func (query *queryBuilder) whereFields() {
 	query.whereField(FieldUTMContent.Name, query.filter.UTMContent)
 	query.whereField(FieldUTMTerm.Name, query.filter.UTMTerm)
 	query.whereFieldPlatform()
+	query.whereFieldWebview()
 	query.whereFieldVisitorSessionID()
+	query.whereFieldTimezone()
 
 	for i := range query.search {
 		query.whereFieldSearch(query.search[i].Field.Name, query.search[i].Input)
@@ -658,7 +696,7 @@ func (query *queryBuilder) whereField(field string, value []string) {
 			comparator := "%s = ? "
 			not := strings.HasPrefix(v, "!")
 
-			if field == FieldEventMetaKeysRaw.Name || field == FieldTagKeysRaw.Name {
+			if field == FieldEventMetaKeysRaw.Name || field == FieldTagKeysRaw.Name || field == FieldPropertyKeysRaw.Name {
 				if not {
 					v = v[1:]
 					comparator = "has(%s, ?) = 0 "
@@ -742,6 +780,51 @@ func (query *queryBuilder) whereFieldSearch(field, value string) {
 	}
 }
 
+func (query *queryBuilder) whereFieldStatusCode() {
+	if len(query.filter.StatusCode) > 0 {
+		var group where
+		eqContainsArgs := make([]any, 0, len(query.filter.StatusCode))
+		notEqArgs := make([]any, 0, len(query.filter.StatusCode))
+
+		for _, v := range query.filter.StatusCode {
+			field := FieldStatusCode.Name
+			comparator := "%s = ? "
+			not := strings.HasPrefix(v, "!")
+
+			if not {
+				v = v[1:]
+				comparator = "%s != ? "
+			}
+
+			// status classes like "4xx" compare the first digit
+			if len(v) == 3 && strings.ToLower(v[1:]) == "xx" {
+				field = fmt.Sprintf("intDiv(%s, 100)", field)
+				v = v[:1]
+			}
+
+			i, err := strconv.ParseUint(v, 10, 16)
+
+			if err != nil {
+				continue
+			}
+
+			if not {
+				notEqArgs = append(notEqArgs, uint16(i))
+				group.notEq = append(group.notEq, fmt.Sprintf(comparator, field))
+			} else {
+				eqContainsArgs = append(eqContainsArgs, uint16(i))
+				group.eqContains = append(group.eqContains, fmt.Sprintf(comparator, field))
+			}
+		}
+
+		if len(eqContainsArgs) > 0 || len(notEqArgs) > 0 {
+			query.args = append(query.args, eqContainsArgs...)
+			query.args = append(query.args, notEqArgs...)
+			query.where = append(query.where, group)
+		}
+	}
+}
+
 func (query *queryBuilder) whereFieldUInt16(field string, value []string) {
 	if len(value) > 0 {
 		var group where
@@ -821,6 +904,33 @@ func (query *queryBuilder) whereFieldTag() {
 	}
 }
 
+func (query *queryBuilder) whereFieldProperties() {
+	if len(query.filter.Properties) > 0 {
+		var group where
+
+		for k, v := range query.filter.Properties {
+			comparator := "property_values[indexOf(property_keys, ?)] = ? "
+
+			if strings.HasPrefix(v, "!") {
+				v = v[1:]
+				comparator = "property_values[indexOf(property_keys, ?)] != ? "
+			} else if strings.HasPrefix(v, "~") {
+				v = fmt.Sprintf("%%%s%%", v[1:])
+				comparator = "ilike(property_values[indexOf(property_keys, ?)], ?) = 1 "
+			} else if strings.HasPrefix(v, "^") {
+				v = fmt.Sprintf("%%%s%%", v[1:])
+				comparator = "ilike(property_values[indexOf(property_keys, ?)], ?) != 1 "
+			}
+
+			// use notEq because they will all be joined using AND
+			query.args = append(query.args, k, query.nullValue(v))
+			group.notEq = append(group.notEq, comparator)
+		}
+
+		query.where = append(query.where, group)
+	}
+}
+
 func (query *queryBuilder) whereFieldMeta() {
 	if len(query.filter.EventMeta) > 0 {
 		var group where
@@ -872,6 +982,12 @@ func (query *queryBuilder) whereFieldPlatform() {
 	}
 }
 
+func (query *queryBuilder) whereFieldWebview() {
+	if query.filter.Webview {
+		query.where = append(query.where, where{eqContains: []string{"webview = 1 "}})
+	}
+}
+
 func (query *queryBuilder) whereFieldPlatformImported() {
 	if query.filter.Platform != "" {
 		if strings.HasPrefix(query.filter.Platform, "!") {
@@ -918,6 +1034,14 @@ func (query *queryBuilder) whereFieldVisitorSessionID() {
 	}
 }
 
+// whereFieldTimezone excludes sessions without a known time zone when grouping by local time,
+// as they would otherwise be counted as if the visitor was in UTC.
+func (query *queryBuilder) whereFieldTimezone() {
+	if query.filter.fieldsContain(query.groupBy, FieldLocalHour) || query.filter.fieldsContain(query.groupBy, FieldLocalWeekday) {
+		query.where = append(query.where, where{eqContains: []string{"timezone != '' "}})
+	}
+}
+
 func (query *queryBuilder) whereFieldPathPattern() {
 	if len(query.filter.PathPattern) > 0 {
 		var group where
@@ -123,6 +123,70 @@ func TestQueryAnyPath(t *testing.T) {
 	assert.Equal(t, `SELECT path path,uniq(t.visitor_id) visitors FROM "page_view" t WHERE client_id = ? AND toDate(time, 'UTC') >= toDate(?) AND toDate(time, 'UTC') <= toDate(?) AND path IN (?,?) GROUP BY path `, queryStr)
 }
 
+func TestQueryStatusCode(t *testing.T) {
+	q := queryBuilder{
+		filter: &Filter{
+			ClientID:   42,
+			From:       util.PastDay(7),
+			To:         util.Today(),
+			StatusCode: []string{"404", "5xx", "abc", "4x", "!200"},
+		},
+		fields: []Field{
+			FieldPath,
+			FieldVisitors,
+		},
+		from: pageViews,
+		groupBy: []Field{
+			FieldPath,
+		},
+	}
+	queryStr, args := q.query()
+	assert.Len(t, args, 6)
+	assert.Equal(t, uint16(404), args[3])
+	assert.Equal(t, uint16(5), args[4])
+	assert.Equal(t, uint16(200), args[5])
+	assert.Equal(t, `SELECT path path,uniq(t.visitor_id) visitors FROM "page_view" t WHERE client_id = ? AND toDate(time, 'UTC') >= toDate(?) AND toDate(time, 'UTC') <= toDate(?) AND (status_code = ? OR intDiv(status_code, 100) = ? ) AND status_code != ? GROUP BY path `, queryStr)
+	q = queryBuilder{
+		filter: &Filter{
+			ClientID:   42,
+			From:       util.PastDay(7),
+			To:         util.Today(),
+			StatusCode: []string{"abc"},
+		},
+		fields: []Field{
+			FieldPath,
+			FieldVisitors,
+		},
+		from: pageViews,
+		groupBy: []Field{
+			FieldPath,
+		},
+	}
+	queryStr, args = q.query()
+	assert.Len(t, args, 3)
+	assert.Equal(t, `SELECT path path,uniq(t.visitor_id) visitors FROM "page_view" t WHERE client_id = ? AND toDate(time, 'UTC') >= toDate(?) AND toDate(time, 'UTC') <= toDate(?) GROUP BY path `, queryStr)
+}
+
+func TestQueryLocalHour(t *testing.T) {
+	q := queryBuilder{
+		filter: &Filter{
+			ClientID: 42,
+			From:     util.PastDay(7),
+			To:       util.Today(),
+		},
+		fields: []Field{
+			FieldLocalHour,
+			FieldVisitors,
+		},
+		from: sessions,
+		groupBy: []Field{
+			FieldLocalHour,
+		},
+	}
+	queryStr, _ := q.query()
+	assert.Contains(t, queryStr, "AND timezone != '' GROUP BY hour ")
+}
+
 func TestQueryPlatformSession(t *testing.T) {
 	q := queryBuilder{
 		filter: &Filter{
@@ -63,7 +63,10 @@ func (sessions *Sessions) List(filter *Filter) ([]model.Session, error) {
 				any(t.session_utm_campaign),
 				any(t.session_utm_content),
 				any(t.session_utm_term),
-		        max(t.session_extended)
+		        max(t.session_extended),
+				argMax(t.session_property_keys, t.session_time),
+				argMax(t.session_property_values, t.session_time),
+				max(t.session_user_id)
 		    FROM (%s) t
 			GROUP BY visitor_id, session_id
 			ORDER BY max(session_time)
@@ -77,6 +80,58 @@ func (sessions *Sessions) List(filter *Filter) ([]model.Session, error) {
 	return stats, nil
 }
 
+// PropertyKeys returns the visitor and session count grouped by session property keys.
+func (sessions *Sessions) PropertyKeys(filter *Filter) ([]model.SessionPropertyStats, error) {
+	filter = sessions.analyzer.getFilter(filter)
+	q, args := filter.buildQuery([]Field{
+		FieldPropertyKey,
+		FieldVisitors,
+		FieldSessions,
+		FieldRelativeVisitors,
+	}, []Field{
+		FieldPropertyKey,
+	}, []Field{
+		FieldVisitors,
+		FieldPropertyKey,
+	}, nil, "")
+	stats, err := sessions.store.SelectSessionPropertyStats(filter.Ctx, false, q, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// PropertyBreakdown returns the visitor and session count for session properties grouping them by given key.
+// The Filter.Property must be set, or otherwise the result set will be empty.
+func (sessions *Sessions) PropertyBreakdown(filter *Filter) ([]model.SessionPropertyStats, error) {
+	filter = sessions.analyzer.getFilter(filter)
+
+	if len(filter.Property) == 0 {
+		return []model.SessionPropertyStats{}, nil
+	}
+
+	q, args := filter.buildQuery([]Field{
+		FieldPropertyValue,
+		FieldVisitors,
+		FieldSessions,
+		FieldRelativeVisitors,
+	}, []Field{
+		FieldPropertyValue,
+	}, []Field{
+		FieldVisitors,
+		FieldPropertyValue,
+	}, nil, "")
+	stats, err := sessions.store.SelectSessionPropertyStats(filter.Ctx, true, q, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // Breakdown returns the page views and events for a single session in chronological order.
 func (sessions *Sessions) Breakdown(filter *Filter) ([]model.SessionStep, error) {
 	filter = sessions.analyzer.getFilter(filter)
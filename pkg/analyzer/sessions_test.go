@@ -195,3 +195,57 @@ func TestSessions_Breakdown(t *testing.T) {
 	_, err = analyzer.Sessions.Breakdown(getMaxFilter("event"))
 	assert.NoError(t, err)
 }
+
+func TestSessions_Properties(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.Today(), Start: util.Today(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, PropertyKeys: []string{"plan"}, PropertyValues: []string{"free"}},
+			{Sign: 1, VisitorID: 2, SessionID: 2, Time: util.Today(), Start: util.Today(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, PropertyKeys: []string{"plan", "role"}, PropertyValues: []string{"pro", "admin"}},
+		},
+		{
+			{Sign: -1, VisitorID: 1, SessionID: 1, Time: util.Today(), Start: util.Today(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, PropertyKeys: []string{"plan"}, PropertyValues: []string{"free"}},
+			{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.Today().Add(time.Second * 10), Start: util.Today(), EntryPath: "/", ExitPath: "/pricing", PageViews: 2, PropertyKeys: []string{"plan"}, PropertyValues: []string{"pro"}},
+			{Sign: 1, VisitorID: 3, SessionID: 3, Time: util.Today(), Start: util.Today(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true},
+		},
+	})
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, SessionID: 1, Time: util.Today(), Path: "/"},
+		{VisitorID: 1, SessionID: 1, Time: util.Today().Add(time.Second * 10), Path: "/pricing"},
+		{VisitorID: 2, SessionID: 2, Time: util.Today(), Path: "/"},
+		{VisitorID: 3, SessionID: 3, Time: util.Today(), Path: "/"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	keys, err := analyzer.Sessions.PropertyKeys(nil)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "plan", keys[0].Key)
+	assert.Equal(t, "role", keys[1].Key)
+	assert.Equal(t, 2, keys[0].Visitors)
+	assert.Equal(t, 2, keys[0].Sessions)
+	assert.Equal(t, 1, keys[1].Visitors)
+	assert.InDelta(t, 0.6666, keys[0].RelativeVisitors, 0.001)
+	assert.InDelta(t, 0.3333, keys[1].RelativeVisitors, 0.001)
+	values, err := analyzer.Sessions.PropertyBreakdown(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+	values, err = analyzer.Sessions.PropertyBreakdown(&Filter{Property: []string{"plan"}})
+	assert.NoError(t, err)
+	assert.Len(t, values, 1)
+	assert.Equal(t, "pro", values[0].Value)
+	assert.Equal(t, 2, values[0].Visitors)
+	visitors, err := analyzer.Visitors.Total(&Filter{Properties: map[string]string{"role": "admin"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, visitors.Visitors)
+	pages, err := analyzer.Pages.ByPath(&Filter{Properties: map[string]string{"plan": "pro"}})
+	assert.NoError(t, err)
+	assert.Len(t, pages, 2)
+	list, err := analyzer.Sessions.List(&Filter{Properties: map[string]string{"plan": "pro"}})
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	_, err = analyzer.Sessions.PropertyKeys(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Sessions.PropertyBreakdown(getMaxFilter(""))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,113 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"strings"
+)
+
+var (
+	// ErrNoConversionGoal is returned in case no path, path pattern, or event name was specified as the conversion goal.
+	ErrNoConversionGoal = errors.New("no conversion goal specified")
+)
+
+// SiteSearch aggregates statistics regarding the internal search of a site.
+type SiteSearch struct {
+	analyzer *Analyzer
+	store    db.Store
+}
+
+// Terms returns the visitor count, number of searches, exits, and refinements grouped by search term.
+// A search is counted as an exit if there is no page view following it in the same session
+// and as a refinement if the following page view is a search for a different term.
+// Use Filter.Search on FieldSearchTerm to search the terms and Filter.Offset and Filter.Limit for pagination.
+func (search *SiteSearch) Terms(filter *Filter) ([]model.SiteSearchStats, error) {
+	filter = search.analyzer.getFilter(filter)
+	q := queryBuilder{
+		filter: filter,
+		from:   pageViews,
+		search: filter.Search,
+		offset: filter.Offset,
+		limit:  filter.Limit,
+	}
+	var query strings.Builder
+	query.WriteString(fmt.Sprintf(`SELECT search_term,
+		uniq(visitor_id) visitors,
+		count(*) searches,
+		countIf(next_path = '') exits,
+		countIf(next_search_term != '' AND next_search_term != search_term) refinements,
+		exits / searches exit_rate,
+		refinements / searches refinement_rate
+		FROM (
+			SELECT *,
+			leadInFrame(path) OVER w next_path,
+			leadInFrame(search_term) OVER w next_search_term
+			FROM "page_view"
+			%s
+			WINDOW w AS (PARTITION BY visitor_id, session_id ORDER BY time ROWS BETWEEN CURRENT ROW AND 1 FOLLOWING)
+		) t
+		WHERE search_term != '' `, q.whereTime()))
+	q.whereFields()
+	query.WriteString(q.q.String())
+	q.q.Reset()
+	q.withLimit()
+	query.WriteString(fmt.Sprintf(`GROUP BY search_term
+		ORDER BY searches DESC, search_term ASC
+		%s`, q.q.String()))
+	return search.store.SelectSiteSearchStats(filter.Ctx, query.String(), q.args...)
+}
+
+// Categories returns the visitor count grouped by search category.
+// Page views without a search category are excluded.
+func (search *SiteSearch) Categories(filter *Filter) ([]model.SiteSearchCategoryStats, error) {
+	filter = search.analyzer.getFilter(filter)
+	categories := make([]string, 0, len(filter.SearchCategory)+1)
+	categories = append(categories, filter.SearchCategory...)
+	filter.SearchCategory = append(categories, "!"+pkg.Unknown)
+	ctx, q, args := search.analyzer.selectByAttribute(filter, "", FieldSearchCategory)
+	return search.store.SelectSiteSearchCategoryStats(ctx, q, args...)
+}
+
+// Conversions returns the number of sessions with a site search and how many of them reached the conversion goal.
+// The goal is set using the Path, PathPattern, or EventName of the filter, like for Pages.Conversions.
+// SearchTerm and SearchCategory only filter the searches, all other fields apply to both.
+func (search *SiteSearch) Conversions(filter *Filter) (*model.SiteSearchConversionStats, error) {
+	filter = search.analyzer.getFilter(filter)
+
+	if len(filter.Path) == 0 && len(filter.PathPattern) == 0 && len(filter.EventName) == 0 {
+		return nil, ErrNoConversionGoal
+	}
+
+	searchFilter := *filter
+	searchFilter.Path = nil
+	searchFilter.PathPattern = nil
+	searchFilter.EventName = nil
+	searchFilter.EventMeta = nil
+	searchFilter.EventMetaKey = nil
+	searchFilter.Offset = 0
+	searchFilter.Limit = 0
+	terms := make([]string, 0, len(filter.SearchTerm)+1)
+	terms = append(terms, filter.SearchTerm...)
+	searchFilter.SearchTerm = append(terms, "!"+pkg.Unknown)
+	goalFilter := *filter
+	goalFilter.SearchTerm = nil
+	goalFilter.SearchCategory = nil
+	goalFilter.Search = nil
+	goalFilter.Offset = 0
+	goalFilter.Limit = 0
+	fields := []Field{FieldVisitorID, FieldSessionID}
+	searchQuery, args := searchFilter.buildQuery(fields, fields, nil, nil, "")
+	goalQuery, goalArgs := goalFilter.buildQuery(fields, fields, nil, nil, "")
+	args = append(args, goalArgs...)
+	query := fmt.Sprintf(`SELECT uniq(s.visitor_id) visitors,
+		uniq(s.visitor_id, s.session_id) sessions,
+		uniqIf((s.visitor_id, s.session_id), g.converted = 1) conversions,
+		conversions / IF(sessions = 0, 1, sessions) cr
+		FROM (%s) s
+		LEFT JOIN (SELECT visitor_id, session_id, 1 converted FROM (%s)) g
+		ON g.visitor_id = s.visitor_id AND g.session_id = s.session_id`, searchQuery, goalQuery)
+	return search.store.GetSiteSearchConversionStats(filter.Ctx, query, args...)
+}
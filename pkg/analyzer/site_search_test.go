@@ -0,0 +1,80 @@
+package analyzer
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSiteSearch(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: util.Today(), Start: time.Now(), EntryPath: "/search", ExitPath: "/product", PageViews: 3},
+			{Sign: 1, VisitorID: 2, Time: util.Today(), Start: time.Now(), EntryPath: "/search", ExitPath: "/search", PageViews: 1, IsBounce: true},
+			{Sign: 1, VisitorID: 3, Time: util.Today(), Start: time.Now(), EntryPath: "/search", ExitPath: "/checkout", PageViews: 2},
+		},
+	})
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, Time: util.Today(), Path: "/search", SearchTerm: "shoes"},
+		{VisitorID: 1, Time: util.Today().Add(time.Second), Path: "/search", SearchTerm: "red shoes"},
+		{VisitorID: 1, Time: util.Today().Add(time.Second * 2), Path: "/product"},
+		{VisitorID: 2, Time: util.Today(), Path: "/search", SearchTerm: "shoes"},
+		{VisitorID: 3, Time: util.Today(), Path: "/search", SearchTerm: "shoes", SearchCategory: "men"},
+		{VisitorID: 3, Time: util.Today().Add(time.Second), Path: "/checkout"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	terms, err := analyzer.SiteSearch.Terms(nil)
+	assert.NoError(t, err)
+	assert.Len(t, terms, 2)
+	assert.Equal(t, "shoes", terms[0].SearchTerm)
+	assert.Equal(t, "red shoes", terms[1].SearchTerm)
+	assert.Equal(t, 3, terms[0].Visitors)
+	assert.Equal(t, 3, terms[0].Searches)
+	assert.Equal(t, 1, terms[0].Exits)
+	assert.Equal(t, 1, terms[0].Refinements)
+	assert.InDelta(t, 0.3333, terms[0].ExitRate, 0.01)
+	assert.InDelta(t, 0.3333, terms[0].RefinementRate, 0.01)
+	assert.Equal(t, 1, terms[1].Searches)
+	assert.Equal(t, 0, terms[1].Exits)
+	assert.Equal(t, 0, terms[1].Refinements)
+	terms, err = analyzer.SiteSearch.Terms(&Filter{Search: []Search{{Field: FieldSearchTerm, Input: "red"}}})
+	assert.NoError(t, err)
+	assert.Len(t, terms, 1)
+	assert.Equal(t, "red shoes", terms[0].SearchTerm)
+	terms, err = analyzer.SiteSearch.Terms(&Filter{Offset: 1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, terms, 1)
+	assert.Equal(t, "red shoes", terms[0].SearchTerm)
+	categories, err := analyzer.SiteSearch.Categories(nil)
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+	assert.Equal(t, "men", categories[0].SearchCategory)
+	assert.Equal(t, 1, categories[0].Visitors)
+	assert.InDelta(t, 0.3333, categories[0].RelativeVisitors, 0.01)
+	_, err = analyzer.SiteSearch.Conversions(nil)
+	assert.ErrorIs(t, err, ErrNoConversionGoal)
+	conversions, err := analyzer.SiteSearch.Conversions(&Filter{Path: []string{"/checkout"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, conversions.Visitors)
+	assert.Equal(t, 3, conversions.Sessions)
+	assert.Equal(t, 1, conversions.Conversions)
+	assert.InDelta(t, 0.3333, conversions.CR, 0.01)
+	conversions, err = analyzer.SiteSearch.Conversions(&Filter{Path: []string{"/checkout"}, SearchTerm: []string{"red shoes"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, conversions.Sessions)
+	assert.Equal(t, 0, conversions.Conversions)
+	visitors, err := analyzer.Visitors.Total(&Filter{SearchTerm: []string{"shoes"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, visitors.Visitors)
+	_, err = analyzer.SiteSearch.Terms(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.SiteSearch.Categories(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.SiteSearch.Conversions(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+package analyzer
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"strings"
+)
+
+// Users aggregates statistics for visitors identified by a user ID (see tracker.Options.UserID).
+// Sessions without a user ID are ignored. Filters apply to sessions, except for the first time a user has been seen,
+// which always takes all sessions of the user into account.
+type Users struct {
+	analyzer *Analyzer
+	store    db.Store
+}
+
+// ByPeriod returns the number of users, new users, and returning users grouped by day, week, month, or year (Filter.Period).
+// A user is counted as new in the period they were first seen.
+func (users *Users) ByPeriod(filter *Filter) ([]model.UserStats, error) {
+	filter = users.analyzer.getFilter(filter)
+	where, args := users.where(filter)
+	query := fmt.Sprintf(`SELECT %s day,
+		uniq(user_id) users,
+		uniqIf(user_id, %s = day) new_users,
+		users - new_users returning_users
+		FROM "session" s
+		JOIN (%s) f ON f.uid = s.user_id
+		%s
+		GROUP BY day
+		ORDER BY day`, users.period(filter, users.day(filter)), users.period(filter, "f.first_seen"), users.firstSeen(filter), where)
+	return users.store.SelectUserStats(filter.Ctx, query, append([]any{filter.ClientID}, args...)...)
+}
+
+// Retention returns the number of active users for each cohort and period after the users were first seen.
+// Cohorts are grouped by day, week, month, or year (Filter.Period) and only include users first seen within the time range.
+// The relative users are the share of the cohort still active in the period.
+func (users *Users) Retention(filter *Filter) ([]model.UserRetentionStats, error) {
+	filter = users.analyzer.getFilter(filter)
+	where, args := users.where(filter)
+	var cohortFilter string
+
+	if !filter.From.IsZero() {
+		cohortFilter = "AND f.first_seen >= toDate(?) "
+		args = append(args, filter.From.Format(dateFormat))
+	}
+
+	cohort := users.period(filter, "f.first_seen")
+	query := fmt.Sprintf(`SELECT cohort, period, users,
+		users / greatest(sumIf(users, period = 0) OVER (PARTITION BY cohort), 1) relative_users
+		FROM (
+			SELECT %s cohort,
+			dateDiff('%s', cohort, %s) period,
+			uniq(user_id) users
+			FROM "session" s
+			JOIN (%s) f ON f.uid = s.user_id
+			%s
+			%s
+			GROUP BY cohort, period
+		)
+		ORDER BY cohort, period`, cohort, users.unit(filter), users.period(filter, users.day(filter)), users.firstSeen(filter), where, cohortFilter)
+	return users.store.SelectUserRetentionStats(filter.Ctx, query, append([]any{filter.ClientID}, args...)...)
+}
+
+func (users *Users) where(filter *Filter) (string, []any) {
+	q := queryBuilder{
+		filter: filter,
+		from:   sessions,
+		search: filter.Search,
+	}
+	var where strings.Builder
+	where.WriteString(q.whereTime())
+	where.WriteString("AND user_id != 0 ")
+	q.whereFields()
+	where.WriteString(q.q.String())
+	return where.String(), q.args
+}
+
+// firstSeen returns the subquery for the day each user has been seen for the first time.
+// It requires the client ID as the first argument.
+func (users *Users) firstSeen(filter *Filter) string {
+	return fmt.Sprintf(`SELECT user_id uid, min(%s) first_seen
+		FROM "session"
+		WHERE client_id = ?
+		AND user_id != 0
+		GROUP BY user_id`, users.day(filter))
+}
+
+func (users *Users) day(filter *Filter) string {
+	return fmt.Sprintf("toDate(time, '%s')", filter.Timezone.String())
+}
+
+func (users *Users) period(filter *Filter, date string) string {
+	switch filter.Period {
+	case pkg.PeriodWeek:
+		return fmt.Sprintf("toStartOfWeek(%s, 1)", date)
+	case pkg.PeriodMonth:
+		return fmt.Sprintf("toStartOfMonth(%s)", date)
+	case pkg.PeriodYear:
+		return fmt.Sprintf("toStartOfYear(%s)", date)
+	default:
+		return date
+	}
+}
+
+func (users *Users) unit(filter *Filter) string {
+	switch filter.Period {
+	case pkg.PeriodWeek:
+		return "week"
+	case pkg.PeriodMonth:
+		return "month"
+	case pkg.PeriodYear:
+		return "year"
+	default:
+		return "day"
+	}
+}
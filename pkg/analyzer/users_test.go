@@ -0,0 +1,95 @@
+package analyzer
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestUsers_ByPeriod(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(2), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 100},
+			{Sign: 1, VisitorID: 2, SessionID: 2, Time: util.PastDay(2), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true},
+			{Sign: 1, VisitorID: 3, SessionID: 3, Time: util.PastDay(1), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 100},
+			{Sign: 1, VisitorID: 4, SessionID: 4, Time: util.PastDay(1), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 200},
+			{Sign: 1, VisitorID: 5, SessionID: 5, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 200, CountryCode: "de"},
+		},
+	})
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	stats, err := analyzer.Users.ByPeriod(&Filter{From: util.PastDay(2), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 3)
+	assert.Equal(t, 1, stats[0].Users)
+	assert.Equal(t, 1, stats[0].NewUsers)
+	assert.Equal(t, 0, stats[0].ReturningUsers)
+	assert.Equal(t, 2, stats[1].Users)
+	assert.Equal(t, 1, stats[1].NewUsers)
+	assert.Equal(t, 1, stats[1].ReturningUsers)
+	assert.Equal(t, 1, stats[2].Users)
+	assert.Equal(t, 0, stats[2].NewUsers)
+	assert.Equal(t, 1, stats[2].ReturningUsers)
+	stats, err = analyzer.Users.ByPeriod(&Filter{From: util.Today(), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, 0, stats[0].NewUsers)
+	assert.Equal(t, 1, stats[0].ReturningUsers)
+	stats, err = analyzer.Users.ByPeriod(&Filter{From: util.PastDay(2), To: util.Today(), Country: []string{"de"}})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, 1, stats[0].ReturningUsers)
+	_, err = analyzer.Users.ByPeriod(getMaxFilter(""))
+	assert.NoError(t, err)
+
+	for _, period := range []pkg.Period{pkg.PeriodWeek, pkg.PeriodMonth, pkg.PeriodYear} {
+		_, err = analyzer.Users.ByPeriod(&Filter{From: util.PastDay(2), To: util.Today(), Period: period})
+		assert.NoError(t, err)
+	}
+}
+
+func TestUsers_Retention(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, SessionID: 1, Time: util.PastDay(2), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 100},
+			{Sign: 1, VisitorID: 2, SessionID: 2, Time: util.PastDay(2), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 200},
+			{Sign: 1, VisitorID: 3, SessionID: 3, Time: util.PastDay(1), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 100},
+			{Sign: 1, VisitorID: 4, SessionID: 4, Time: util.PastDay(1), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 300},
+			{Sign: 1, VisitorID: 5, SessionID: 5, Time: util.Today(), Start: time.Now(), EntryPath: "/", ExitPath: "/", PageViews: 1, IsBounce: true, UserID: 200},
+		},
+	})
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	stats, err := analyzer.Users.Retention(&Filter{From: util.PastDay(2), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 4)
+	assert.Equal(t, util.PastDay(2), stats[0].Cohort)
+	assert.Equal(t, 0, stats[0].Period)
+	assert.Equal(t, 2, stats[0].Users)
+	assert.InDelta(t, 1, stats[0].RelativeUsers, 0.001)
+	assert.Equal(t, 1, stats[1].Period)
+	assert.Equal(t, 1, stats[1].Users)
+	assert.InDelta(t, 0.5, stats[1].RelativeUsers, 0.001)
+	assert.Equal(t, 2, stats[2].Period)
+	assert.Equal(t, 1, stats[2].Users)
+	assert.Equal(t, util.PastDay(1), stats[3].Cohort)
+	assert.Equal(t, 0, stats[3].Period)
+	assert.Equal(t, 1, stats[3].Users)
+	stats, err = analyzer.Users.Retention(&Filter{From: util.PastDay(1), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, util.PastDay(1), stats[0].Cohort)
+	_, err = analyzer.Users.Retention(getMaxFilter(""))
+	assert.NoError(t, err)
+
+	for _, period := range []pkg.Period{pkg.PeriodWeek, pkg.PeriodMonth, pkg.PeriodYear} {
+		_, err = analyzer.Users.Retention(&Filter{From: util.PastDay(2), To: util.Today(), Period: period})
+		assert.NoError(t, err)
+	}
+}
@@ -3,6 +3,7 @@ package analyzer
 import (
 	"errors"
 	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
@@ -13,6 +14,9 @@ import (
 var (
 	// ErrNoPeriodOrDay is returned in case no period or day was specified to calculate the growth rate.
 	ErrNoPeriodOrDay = errors.New("no period or day specified")
+
+	// ErrNoReferrerName is returned in case no referrer name was specified to break down the referrer URLs.
+	ErrNoReferrerName = errors.New("no referrer name specified")
 )
 
 // Visitors aggregates statistics regarding visitors.
@@ -352,6 +356,59 @@ func (visitors *Visitors) ByWeekdayAndHour(filter *Filter) ([]model.VisitorWeekd
 	return stats, nil
 }
 
+// ByLocalHour returns the visitor count grouped by time of day in the visitor's time zone.
+// Imported statistics and sessions without a known time zone are not included.
+func (visitors *Visitors) ByLocalHour(filter *Filter) ([]model.VisitorHourStats, error) {
+	filter = visitors.analyzer.getFilter(filter)
+	q, args := filter.buildQuery([]Field{
+		FieldLocalHour,
+		FieldVisitors,
+		FieldSessions,
+		FieldViews,
+		FieldBounces,
+		FieldBounceRate,
+	}, []Field{
+		FieldLocalHour,
+	}, []Field{
+		FieldLocalHour,
+		FieldVisitors,
+	}, nil, "")
+	stats, err := visitors.store.SelectVisitorHourStats(filter.Ctx, q, false, false, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ByLocalWeekdayAndHour returns the visitor count grouped by time of day and weekday in the visitor's time zone.
+// Imported statistics and sessions without a known time zone are not included.
+func (visitors *Visitors) ByLocalWeekdayAndHour(filter *Filter) ([]model.VisitorWeekdayHourStats, error) {
+	filter = visitors.analyzer.getFilter(filter)
+	q, args := filter.buildQuery([]Field{
+		FieldLocalWeekday,
+		FieldLocalHour,
+		FieldVisitors,
+		FieldSessions,
+		FieldViews,
+		FieldBounces,
+	}, []Field{
+		FieldLocalWeekday,
+		FieldLocalHour,
+	}, []Field{
+		FieldLocalWeekday,
+		FieldLocalHour,
+	}, nil, "")
+	stats, err := visitors.store.SelectVisitorWeekdayHourStats(filter.Ctx, q, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // Growth returns the growth rate for visitor count, session count, bounces, views, and average session duration or average time on page (if path is set).
 // The growth rate is relative to the previous time range or day.
 // The period or day for the filter must be set, else an error is returned.
@@ -527,6 +584,64 @@ func (visitors *Visitors) Referrer(filter *Filter) ([]model.ReferrerStats, error
 	return stats, nil
 }
 
+// ReferrerCategory returns the visitor count grouped by referrer category.
+func (visitors *Visitors) ReferrerCategory(filter *Filter) ([]model.ReferrerCategoryStats, error) {
+	ctx, q, args := visitors.analyzer.selectByAttribute(filter, "", FieldReferrerCategory)
+	return visitors.store.SelectReferrerCategoryStats(ctx, q, args...)
+}
+
+// ReferrerKeyword returns the visitor count grouped by the search term passed by search engines.
+// Visitors without a search term are excluded.
+func (visitors *Visitors) ReferrerKeyword(filter *Filter) ([]model.ReferrerKeywordStats, error) {
+	filter = visitors.analyzer.getFilter(filter)
+	keywords := make([]string, 0, len(filter.ReferrerKeyword)+1)
+	keywords = append(keywords, filter.ReferrerKeyword...)
+	filter.ReferrerKeyword = append(keywords, "!"+pkg.Unknown)
+	ctx, q, args := visitors.analyzer.selectByAttribute(filter, "", FieldReferrerKeyword)
+	return visitors.store.SelectReferrerKeywordStats(ctx, q, args...)
+}
+
+// ReferrerURL returns the visitor count, bounce rate, and average session duration grouped by the full referrer URL
+// for the referrer name set in the filter, like the threads on Reddit linking to the site.
+// Use Filter.Search on FieldReferrer to search the URLs and Filter.Offset and Filter.Limit for pagination.
+// Imported statistics are not included, as they don't contain the full referrer URL.
+func (visitors *Visitors) ReferrerURL(filter *Filter) ([]model.ReferrerURLStats, error) {
+	return visitors.referrerURL(filter, FieldReferrer)
+}
+
+// ReferrerPathPrefix is the same as ReferrerURL, but groups the referrer URLs by the first path segments up to given depth.
+// For a depth of 2, "https://reddit.com/r/golang/comments/abc" and "https://reddit.com/r/golang/comments/xyz"
+// are both counted for "https://reddit.com/r/golang". A depth of 0 groups by hostname.
+func (visitors *Visitors) ReferrerPathPrefix(filter *Filter, depth int) ([]model.ReferrerURLStats, error) {
+	return visitors.referrerURL(filter, fieldReferrerPathPrefix(max(depth, 0)))
+}
+
+func (visitors *Visitors) referrerURL(filter *Filter, referrer Field) ([]model.ReferrerURLStats, error) {
+	filter = visitors.analyzer.getFilter(filter)
+
+	if len(filter.ReferrerName) == 0 {
+		return nil, ErrNoReferrerName
+	}
+
+	fields := []Field{
+		referrer,
+		FieldVisitors,
+		FieldSessions,
+		FieldBounces,
+		FieldBounceRate,
+		FieldAverageSessionDuration,
+	}
+	groupBy := []Field{
+		referrer,
+	}
+	orderBy := []Field{
+		FieldVisitors,
+		referrer,
+	}
+	q, args := filter.buildQuery(fields, groupBy, orderBy, nil, "")
+	return visitors.store.SelectReferrerURLStats(filter.Ctx, q, args...)
+}
+
 func (visitors *Visitors) getPreviousPeriod(filter *Filter) {
 	from := filter.From
 	to := filter.To
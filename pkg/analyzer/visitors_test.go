@@ -2303,6 +2303,69 @@ func TestAnalyzer_ByWeekdayAndHour(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAnalyzer_ByLocalHour(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: util.Today().Add(time.Hour * 2), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true, Timezone: "Europe/Berlin", TimezoneOffset: 3600},
+			{Sign: 1, VisitorID: 2, Time: util.Today().Add(time.Hour * 8), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true, Timezone: "America/New_York", TimezoneOffset: -18000},
+			{Sign: 1, VisitorID: 3, Time: util.Today().Add(time.Hour * 3), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true},
+			{Sign: 1, VisitorID: 4, Time: util.Today().Add(time.Hour * 3), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true, Timezone: "UTC"},
+		},
+	})
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, Time: util.Today().Add(time.Hour * 2), Path: "/", Timezone: "Europe/Berlin", TimezoneOffset: 3600},
+		{VisitorID: 2, Time: util.Today().Add(time.Hour * 8), Path: "/", Timezone: "America/New_York", TimezoneOffset: -18000},
+		{VisitorID: 3, Time: util.Today().Add(time.Hour * 3), Path: "/"},
+		{VisitorID: 4, Time: util.Today().Add(time.Hour * 3), Path: "/", Timezone: "UTC"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Visitors.ByLocalHour(&Filter{From: util.Today(), To: util.Today()})
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 24)
+	assert.Equal(t, 3, visitors[3].Hour)
+	assert.Equal(t, 3, visitors[3].Visitors)
+	assert.Equal(t, 0, visitors[2].Visitors)
+	assert.Equal(t, 0, visitors[8].Visitors)
+	_, err = analyzer.Visitors.ByLocalHour(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Visitors.ByLocalHour(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_ByLocalWeekdayAndHour(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: util.Today().Add(time.Hour * 2), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true, Timezone: "Europe/Berlin", TimezoneOffset: 3600},
+			{Sign: 1, VisitorID: 2, Time: util.Today().Add(time.Hour * 8), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true, Timezone: "America/New_York", TimezoneOffset: -18000},
+			{Sign: 1, VisitorID: 3, Time: util.Today().Add(time.Hour * 3), Start: time.Now(), ExitPath: "/", PageViews: 1, IsBounce: true},
+		},
+	})
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{VisitorID: 1, Time: util.Today().Add(time.Hour * 2), Path: "/", Timezone: "Europe/Berlin", TimezoneOffset: 3600},
+		{VisitorID: 2, Time: util.Today().Add(time.Hour * 8), Path: "/", Timezone: "America/New_York", TimezoneOffset: -18000},
+		{VisitorID: 3, Time: util.Today().Add(time.Hour * 3), Path: "/"},
+	}))
+	time.Sleep(time.Millisecond * 100)
+	analyzer := NewAnalyzer(dbClient)
+	visitors, err := analyzer.Visitors.ByLocalWeekdayAndHour(nil)
+	assert.NoError(t, err)
+	assert.Len(t, visitors, 168)
+	sum := 0
+
+	for _, v := range visitors {
+		sum += v.Visitors
+	}
+
+	assert.Equal(t, 2, sum)
+	_, err = analyzer.Visitors.ByLocalWeekdayAndHour(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Visitors.ByLocalWeekdayAndHour(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
 func TestAnalyzer_Growth(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	saveSessions(t, [][]model.Session{
@@ -3115,6 +3178,110 @@ func TestAnalyzer_ReferrerUnknown(t *testing.T) {
 	assert.InDelta(t, 0.5, visitors[0].BounceRate, 0.01)
 }
 
+func TestAnalyzer_ReferrerCategory(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), ReferrerName: "Google", ReferrerCategory: pkg.ReferrerCategorySearch},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), ReferrerName: "DuckDuckGo", ReferrerCategory: pkg.ReferrerCategorySearch, ReferrerKeyword: "web analytics"},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), ReferrerName: "DuckDuckGo", ReferrerCategory: pkg.ReferrerCategorySearch, ReferrerKeyword: "web analytics"},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), ReferrerName: "Yandex", ReferrerCategory: pkg.ReferrerCategorySearch, ReferrerKeyword: "pirsch"},
+			{Sign: 1, VisitorID: 5, Time: time.Now(), Start: time.Now(), ReferrerName: "Facebook", ReferrerCategory: pkg.ReferrerCategorySocial},
+			{Sign: 1, VisitorID: 6, Time: time.Now(), Start: time.Now()},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	categories, err := analyzer.Visitors.ReferrerCategory(nil)
+	assert.NoError(t, err)
+	assert.Len(t, categories, 3)
+	assert.Equal(t, pkg.ReferrerCategorySearch, categories[0].ReferrerCategory)
+	assert.Empty(t, categories[1].ReferrerCategory)
+	assert.Equal(t, pkg.ReferrerCategorySocial, categories[2].ReferrerCategory)
+	assert.Equal(t, 4, categories[0].Visitors)
+	assert.Equal(t, 1, categories[1].Visitors)
+	assert.Equal(t, 1, categories[2].Visitors)
+	assert.InDelta(t, 0.6666, categories[0].RelativeVisitors, 0.01)
+	keywords, err := analyzer.Visitors.ReferrerKeyword(nil)
+	assert.NoError(t, err)
+	assert.Len(t, keywords, 2)
+	assert.Equal(t, "web analytics", keywords[0].ReferrerKeyword)
+	assert.Equal(t, "pirsch", keywords[1].ReferrerKeyword)
+	assert.Equal(t, 2, keywords[0].Visitors)
+	assert.Equal(t, 1, keywords[1].Visitors)
+	keywords, err = analyzer.Visitors.ReferrerKeyword(&Filter{ReferrerName: []string{"Yandex"}})
+	assert.NoError(t, err)
+	assert.Len(t, keywords, 1)
+	assert.Equal(t, "pirsch", keywords[0].ReferrerKeyword)
+	referrer, err := analyzer.Visitors.Referrer(&Filter{ReferrerCategory: []string{pkg.ReferrerCategorySearch}})
+	assert.NoError(t, err)
+	assert.Len(t, referrer, 3)
+	referrer, err = analyzer.Visitors.Referrer(&Filter{ReferrerKeyword: []string{"~analytics"}})
+	assert.NoError(t, err)
+	assert.Len(t, referrer, 1)
+	assert.Equal(t, "DuckDuckGo", referrer[0].ReferrerName)
+	_, err = analyzer.Visitors.ReferrerCategory(getMaxFilter(""))
+	assert.NoError(t, err)
+	_, err = analyzer.Visitors.ReferrerKeyword(getMaxFilter("event"))
+	assert.NoError(t, err)
+}
+
+func TestAnalyzer_ReferrerURL(t *testing.T) {
+	db.CleanupDB(t, dbClient)
+	saveSessions(t, [][]model.Session{
+		{
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/golang/comments/abc", DurationSeconds: 10, PageViews: 2},
+		},
+		{
+			{Sign: -1, VisitorID: 1, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/golang/comments/abc", DurationSeconds: 10, PageViews: 2},
+			{Sign: 1, VisitorID: 1, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/golang/comments/abc", DurationSeconds: 30, PageViews: 3},
+			{Sign: 1, VisitorID: 2, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/golang/comments/xyz", DurationSeconds: 10, PageViews: 2},
+			{Sign: 1, VisitorID: 3, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/golang/comments/xyz", PageViews: 1, IsBounce: true},
+			{Sign: 1, VisitorID: 4, Time: time.Now(), Start: time.Now(), ReferrerName: "Reddit", Referrer: "https://reddit.com/r/analytics", PageViews: 1, IsBounce: true},
+			{Sign: 1, VisitorID: 5, Time: time.Now(), Start: time.Now(), ReferrerName: "Google", Referrer: "https://google.com"},
+		},
+	})
+	analyzer := NewAnalyzer(dbClient)
+	_, err := analyzer.Visitors.ReferrerURL(nil)
+	assert.ErrorIs(t, err, ErrNoReferrerName)
+	urls, err := analyzer.Visitors.ReferrerURL(&Filter{ReferrerName: []string{"Reddit"}})
+	assert.NoError(t, err)
+	assert.Len(t, urls, 3)
+	assert.Equal(t, "https://reddit.com/r/golang/comments/xyz", urls[0].Referrer)
+	assert.Equal(t, "https://reddit.com/r/analytics", urls[1].Referrer)
+	assert.Equal(t, "https://reddit.com/r/golang/comments/abc", urls[2].Referrer)
+	assert.Equal(t, 2, urls[0].Visitors)
+	assert.Equal(t, 2, urls[0].Sessions)
+	assert.Equal(t, 1, urls[0].Bounces)
+	assert.InDelta(t, 0.5, urls[0].BounceRate, 0.01)
+	assert.Equal(t, 5, urls[0].AverageSessionDurationSeconds)
+	assert.Equal(t, 30, urls[2].AverageSessionDurationSeconds)
+	urls, err = analyzer.Visitors.ReferrerURL(&Filter{ReferrerName: []string{"Reddit"}, Search: []Search{{Field: FieldReferrer, Input: "golang"}}, Offset: 1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, urls, 1)
+	assert.Equal(t, "https://reddit.com/r/golang/comments/abc", urls[0].Referrer)
+	prefixes, err := analyzer.Visitors.ReferrerPathPrefix(&Filter{ReferrerName: []string{"Reddit"}}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, prefixes, 2)
+	assert.Equal(t, "https://reddit.com/r/golang", prefixes[0].Referrer)
+	assert.Equal(t, "https://reddit.com/r/analytics", prefixes[1].Referrer)
+	assert.Equal(t, 3, prefixes[0].Visitors)
+	assert.Equal(t, 1, prefixes[0].Bounces)
+	assert.Equal(t, 13, prefixes[0].AverageSessionDurationSeconds)
+	prefixes, err = analyzer.Visitors.ReferrerPathPrefix(&Filter{ReferrerName: []string{"Reddit"}}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, prefixes, 1)
+	assert.Equal(t, "https://reddit.com", prefixes[0].Referrer)
+	assert.Equal(t, 4, prefixes[0].Visitors)
+	filter := getMaxFilter("")
+	filter.ReferrerName = []string{"Reddit"}
+	_, err = analyzer.Visitors.ReferrerURL(filter)
+	assert.NoError(t, err)
+	filter = getMaxFilter("event")
+	filter.ReferrerName = []string{"Reddit"}
+	_, err = analyzer.Visitors.ReferrerPathPrefix(filter, 1)
+	assert.NoError(t, err)
+}
+
 func TestAnalyzer_ReferrerTags(t *testing.T) {
 	db.CleanupDB(t, dbClient)
 	saveSessions(t, [][]model.Session{
@@ -22,6 +22,24 @@ const (
 	// BrowserArc represents the Arc browser.
 	BrowserArc = "Arc"
 
+	// BrowserSamsung represents the Samsung Internet browser.
+	BrowserSamsung = "Samsung Internet"
+
+	// BrowserBrave represents the Brave browser.
+	BrowserBrave = "Brave"
+
+	// BrowserVivaldi represents the Vivaldi browser.
+	BrowserVivaldi = "Vivaldi"
+
+	// BrowserYandex represents the Yandex browser.
+	BrowserYandex = "Yandex"
+
+	// BrowserDuckDuckGo represents the DuckDuckGo browser.
+	BrowserDuckDuckGo = "DuckDuckGo"
+
+	// BrowserUC represents the UC browser.
+	BrowserUC = "UC Browser"
+
 	// OSWindows represents the Windows operating system.
 	OSWindows = "Windows"
 
@@ -43,6 +61,51 @@ const (
 	// OSChrome represents the Chrome operating system.
 	OSChrome = "Chrome OS"
 
+	// DeviceTypeDesktop represents desktop and laptop computers.
+	DeviceTypeDesktop = "Desktop"
+
+	// DeviceTypePhone represents smartphones and other mobile phones.
+	DeviceTypePhone = "Phone"
+
+	// DeviceTypeTablet represents tablets.
+	DeviceTypeTablet = "Tablet"
+
+	// DeviceTypeTV represents smart TVs and streaming devices.
+	DeviceTypeTV = "TV"
+
+	// DeviceTypeConsole represents gaming consoles.
+	DeviceTypeConsole = "Console"
+
+	// DeviceTypeWearable represents smartwatches and other wearables.
+	DeviceTypeWearable = "Wearable"
+
+	// DeviceTypeBot represents crawlers and other automated clients.
+	DeviceTypeBot = "Bot"
+
+	// ReferrerCategorySearch represents search engines.
+	ReferrerCategorySearch = "search"
+
+	// ReferrerCategorySocial represents social networks.
+	ReferrerCategorySocial = "social"
+
+	// ReferrerCategoryEmail represents email providers.
+	ReferrerCategoryEmail = "email"
+
+	// ReferrerCategoryPaid represents advertising networks.
+	ReferrerCategoryPaid = "paid"
+
+	// EventKindOutboundLink represents clicks on links to other sites.
+	EventKindOutboundLink = "outbound_link"
+
+	// EventKindDownload represents file downloads.
+	EventKindDownload = "download"
+
+	// EventNameOutboundLink is the event name used for outbound link clicks.
+	EventNameOutboundLink = "Outbound Link"
+
+	// EventNameDownload is the event name used for file downloads.
+	EventNameDownload = "File Download"
+
 	// PlatformDesktop filters for everything on desktops.
 	PlatformDesktop = "desktop"
 
@@ -149,10 +149,10 @@ func NewClient(config *ClientConfig) (*Client, error) {
 // SavePageViews implements the Store interface.
 func (client *Client) SavePageViews(pageViews []model.PageView) error {
 	values := make([]string, 0, len(pageViews))
-	args := make([]any, 0, len(pageViews)*29)
+	args := make([]any, 0, len(pageViews)*43)
 
 	for _, pageView := range pageViews {
-		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 		args = append(args,
 			pageView.ClientID,
 			pageView.VisitorID,
@@ -163,18 +163,29 @@ func (client *Client) SavePageViews(pageViews []model.PageView) error {
 			pageView.Path,
 			pageView.Title,
 			pageView.Language,
+			pageView.Locale,
+			pageView.Languages,
 			pageView.CountryCode,
 			pageView.Region,
 			pageView.City,
+			pageView.Timezone,
+			pageView.TimezoneOffset,
 			pageView.Referrer,
 			pageView.ReferrerName,
 			pageView.ReferrerIcon,
+			pageView.ReferrerCategory,
+			pageView.ReferrerKeyword,
 			pageView.OS,
 			pageView.OSVersion,
 			pageView.Browser,
 			pageView.BrowserVersion,
+			client.boolean(pageView.Webview),
+			pageView.WebviewApp,
 			client.boolean(pageView.Desktop),
 			client.boolean(pageView.Mobile),
+			pageView.DeviceType,
+			pageView.DeviceBrand,
+			pageView.DeviceModel,
 			pageView.ScreenClass,
 			pageView.UTMSource,
 			pageView.UTMMedium,
@@ -182,14 +193,17 @@ func (client *Client) SavePageViews(pageViews []model.PageView) error {
 			pageView.UTMContent,
 			pageView.UTMTerm,
 			pageView.TagKeys,
-			pageView.TagValues)
+			pageView.TagValues,
+			pageView.SearchTerm,
+			pageView.SearchCategory,
+			pageView.StatusCode)
 	}
 
 	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "page_view" (client_id, visitor_id, session_id, time, duration_seconds,
-		hostname, path, title, language, country_code, region, city, referrer, referrer_name, referrer_icon, os, os_version,
-		browser, browser_version, desktop, mobile, screen_class,
+		hostname, path, title, language, locale, languages, country_code, region, city, timezone, timezone_offset, referrer, referrer_name, referrer_icon, referrer_category, referrer_keyword, os, os_version,
+		browser, browser_version, webview, webview_app, desktop, mobile, device_type, device_brand, device_model, screen_class,
 		utm_source, utm_medium, utm_campaign, utm_content, utm_term,
-		tag_keys, tag_values) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+		tag_keys, tag_values, search_term, search_category, status_code) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
 		return err
 	}
 
@@ -203,10 +217,10 @@ func (client *Client) SavePageViews(pageViews []model.PageView) error {
 // SaveSessions implements the Store interface.
 func (client *Client) SaveSessions(sessions []model.Session) error {
 	values := make([]string, 0, len(sessions))
-	args := make([]any, 0, len(sessions)*35)
+	args := make([]any, 0, len(sessions)*49)
 
 	for _, session := range sessions {
-		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 		args = append(args,
 			session.Sign,
 			session.Version,
@@ -224,31 +238,45 @@ func (client *Client) SaveSessions(sessions []model.Session) error {
 			session.EntryTitle,
 			session.ExitTitle,
 			session.Language,
+			session.Locale,
+			session.Languages,
 			session.CountryCode,
 			session.Region,
 			session.City,
+			session.Timezone,
+			session.TimezoneOffset,
 			session.Referrer,
 			session.ReferrerName,
 			session.ReferrerIcon,
+			session.ReferrerCategory,
+			session.ReferrerKeyword,
 			session.OS,
 			session.OSVersion,
 			session.Browser,
 			session.BrowserVersion,
+			client.boolean(session.Webview),
+			session.WebviewApp,
 			client.boolean(session.Desktop),
 			client.boolean(session.Mobile),
+			session.DeviceType,
+			session.DeviceBrand,
+			session.DeviceModel,
 			session.ScreenClass,
 			session.UTMSource,
 			session.UTMMedium,
 			session.UTMCampaign,
 			session.UTMContent,
 			session.UTMTerm,
-			session.Extended)
+			session.Extended,
+			session.PropertyKeys,
+			session.PropertyValues,
+			session.UserID)
 	}
 
 	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "session" (sign, version, client_id, visitor_id, session_id, time, start, duration_seconds,
-		hostname, entry_path, exit_path, page_views, is_bounce, entry_title, exit_title, language, country_code, region, city, referrer, referrer_name, referrer_icon, os, os_version,
-		browser, browser_version, desktop, mobile, screen_class,
-		utm_source, utm_medium, utm_campaign, utm_content, utm_term, extended) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+		hostname, entry_path, exit_path, page_views, is_bounce, entry_title, exit_title, language, locale, languages, country_code, region, city, timezone, timezone_offset, referrer, referrer_name, referrer_icon, referrer_category, referrer_keyword, os, os_version,
+		browser, browser_version, webview, webview_app, desktop, mobile, device_type, device_brand, device_model, screen_class,
+		utm_source, utm_medium, utm_campaign, utm_content, utm_term, extended, property_keys, property_values, user_id) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
 		return err
 	}
 
@@ -262,10 +290,10 @@ func (client *Client) SaveSessions(sessions []model.Session) error {
 // SaveEvents implements the Store interface.
 func (client *Client) SaveEvents(events []model.Event) error {
 	values := make([]string, 0, len(events))
-	args := make([]any, 0, len(events)*30)
+	args := make([]any, 0, len(events)*45)
 
 	for _, event := range events {
-		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 		args = append(args,
 			event.ClientID,
 			event.VisitorID,
@@ -279,30 +307,46 @@ func (client *Client) SaveEvents(events []model.Event) error {
 			event.Path,
 			event.Title,
 			event.Language,
+			event.Locale,
+			event.Languages,
 			event.CountryCode,
 			event.Region,
 			event.City,
+			event.Timezone,
+			event.TimezoneOffset,
 			event.Referrer,
 			event.ReferrerName,
 			event.ReferrerIcon,
+			event.ReferrerCategory,
+			event.ReferrerKeyword,
 			event.OS,
 			event.OSVersion,
 			event.Browser,
 			event.BrowserVersion,
+			client.boolean(event.Webview),
+			event.WebviewApp,
 			client.boolean(event.Desktop),
 			client.boolean(event.Mobile),
+			event.DeviceType,
+			event.DeviceBrand,
+			event.DeviceModel,
 			event.ScreenClass,
 			event.UTMSource,
 			event.UTMMedium,
 			event.UTMCampaign,
 			event.UTMContent,
-			event.UTMTerm)
+			event.UTMTerm,
+			event.Kind,
+			event.Target,
+			event.TargetHost,
+			event.TargetExtension)
 	}
 
 	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "event" (client_id, visitor_id, time, session_id, event_name, event_meta_keys, event_meta_values, duration_seconds,
-		hostname, path, title, language, country_code, region, city, referrer, referrer_name, referrer_icon, os, os_version,
-		browser, browser_version, desktop, mobile, screen_class,
-		utm_source, utm_medium, utm_campaign, utm_content, utm_term) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+		hostname, path, title, language, locale, languages, country_code, region, city, timezone, timezone_offset, referrer, referrer_name, referrer_icon, referrer_category, referrer_keyword, os, os_version,
+		browser, browser_version, webview, webview_app, desktop, mobile, device_type, device_brand, device_model, screen_class,
+		utm_source, utm_medium, utm_campaign, utm_content, utm_term,
+		event_kind, event_target, event_target_host, event_target_extension) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
 		return err
 	}
 
@@ -316,10 +360,10 @@ func (client *Client) SaveEvents(events []model.Event) error {
 // SaveRequests implements the Store interface.
 func (client *Client) SaveRequests(requests []model.Request) error {
 	values := make([]string, 0, len(requests))
-	args := make([]any, 0, len(requests)*14)
+	args := make([]any, 0, len(requests)*15)
 
 	for _, req := range requests {
-		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 		args = append(args,
 			req.ClientID,
 			req.VisitorID,
@@ -334,10 +378,11 @@ func (client *Client) SaveRequests(requests []model.Request) error {
 			req.UTMMedium,
 			req.UTMCampaign,
 			req.Bot,
-			req.BotReason)
+			req.BotReason,
+			req.OptOut)
 	}
 
-	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "request" (client_id, visitor_id, time, ip, user_agent, hostname, path, event_name, referrer, utm_source, utm_medium, utm_campaign, bot, bot_reason) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "request" (client_id, visitor_id, time, ip, user_agent, hostname, path, event_name, referrer, utm_source, utm_medium, utm_campaign, bot, bot_reason, opt_out) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
 		return err
 	}
 
@@ -348,6 +393,120 @@ func (client *Client) SaveRequests(requests []model.Request) error {
 	return nil
 }
 
+// SaveClientErrors implements the Store interface.
+func (client *Client) SaveClientErrors(clientErrors []model.ClientError) error {
+	values := make([]string, 0, len(clientErrors))
+	args := make([]any, 0, len(clientErrors)*17)
+
+	for _, clientError := range clientErrors {
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		args = append(args,
+			clientError.ClientID,
+			clientError.VisitorID,
+			clientError.SessionID,
+			clientError.Time.UnixMilli(),
+			clientError.Hostname,
+			clientError.Path,
+			clientError.Fingerprint,
+			clientError.Message,
+			clientError.Source,
+			clientError.Line,
+			clientError.Column,
+			clientError.Stack,
+			clientError.OS,
+			clientError.OSVersion,
+			clientError.Browser,
+			clientError.BrowserVersion,
+			clientError.DeviceType)
+	}
+
+	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "client_error" (client_id, visitor_id, session_id, time, hostname, path, fingerprint, message, source, line, "column", stack, os, os_version, browser, browser_version, device_type) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+		return err
+	}
+
+	if client.debug {
+		client.logger.Debug("saved client errors", "count", len(clientErrors))
+	}
+
+	return nil
+}
+
+// SaveClicks implements the Store interface.
+func (client *Client) SaveClicks(clicks []model.Click) error {
+	values := make([]string, 0, len(clicks))
+	args := make([]any, 0, len(clicks)*10)
+
+	for _, click := range clicks {
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?)")
+		args = append(args,
+			click.ClientID,
+			click.VisitorID,
+			click.SessionID,
+			click.Time.UnixMilli(),
+			click.Hostname,
+			click.Path,
+			click.X,
+			click.Y,
+			click.ScreenClass,
+			click.Selector)
+	}
+
+	if _, err := client.Exec(fmt.Sprintf(`INSERT INTO "click" (client_id, visitor_id, session_id, time, hostname, path, x, y, screen_class, selector) VALUES %s`, strings.Join(values, ",")), args...); err != nil {
+		return err
+	}
+
+	if client.debug {
+		client.logger.Debug("saved clicks", "count", len(clicks))
+	}
+
+	return nil
+}
+
+// UpdateReferrers implements the Store interface.
+// The update is executed as a mutation for the session, page_view, and event table.
+func (client *Client) UpdateReferrers(ctx context.Context, clientID uint64, resolve func(string) (string, string, string)) (int, error) {
+	distinct, err := client.SelectOptions(ctx, `SELECT DISTINCT referrer FROM "session" WHERE client_id = ? AND referrer != ''`, clientID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	referrers := make([]string, 0, len(distinct))
+	names := make([]string, 0, len(distinct))
+	icons := make([]string, 0, len(distinct))
+	categories := make([]string, 0, len(distinct))
+
+	for _, referrer := range distinct {
+		if name, icon, category := resolve(referrer); name != "" {
+			referrers = append(referrers, referrer)
+			names = append(names, name)
+			icons = append(icons, icon)
+			categories = append(categories, category)
+		}
+	}
+
+	if len(referrers) == 0 {
+		return 0, nil
+	}
+
+	for _, table := range []string{"session", "page_view", "event"} {
+		query := fmt.Sprintf(`ALTER TABLE "%s" UPDATE referrer_name = transform(referrer, ?, ?, referrer_name),
+			referrer_icon = transform(referrer, ?, ?, referrer_icon),
+			referrer_category = transform(referrer, ?, ?, referrer_category)
+			WHERE client_id = ? AND has(?, referrer)`, table)
+
+		if _, err := client.ExecContext(ctx, query, referrers, names, referrers, icons, referrers, categories, clientID, referrers); err != nil {
+			return 0, err
+		}
+	}
+
+	if client.debug {
+		client.logger.Debug("updated referrers", "client_id", clientID, "count", len(referrers))
+	}
+
+	return len(referrers), nil
+}
+
 // Session implements the Store interface.
 func (client *Client) Session(ctx context.Context, clientID, fingerprint uint64, maxAge time.Time) (*model.Session, error) {
 	query := `SELECT sign,
@@ -364,25 +523,39 @@ func (client *Client) Session(ctx context.Context, clientID, fingerprint uint64,
 		entry_title,
 		exit_title,
 		language,
+		locale,
+		languages,
 		country_code,
 		region,
 		city,
+		timezone,
+		timezone_offset,
 		referrer,
 		referrer_name,
 		referrer_icon,
+		referrer_category,
+		referrer_keyword,
 		os,
 		os_version,
 		browser,
 		browser_version,
+		webview,
+		webview_app,
 		desktop,
 		mobile,
+		device_type,
+		device_brand,
+		device_model,
 		screen_class,
 		utm_source,
 		utm_medium,
 		utm_campaign,
 		utm_content,
 		utm_term,
-		extended
+		extended,
+		property_keys,
+		property_values,
+		user_id
 		FROM session
 		WHERE client_id = ?
 		AND visitor_id = ?
@@ -404,25 +577,39 @@ func (client *Client) Session(ctx context.Context, clientID, fingerprint uint64,
 		&session.EntryTitle,
 		&session.ExitTitle,
 		&session.Language,
+		&session.Locale,
+		&session.Languages,
 		&session.CountryCode,
 		&session.Region,
 		&session.City,
+		&session.Timezone,
+		&session.TimezoneOffset,
 		&session.Referrer,
 		&session.ReferrerName,
 		&session.ReferrerIcon,
+		&session.ReferrerCategory,
+		&session.ReferrerKeyword,
 		&session.OS,
 		&session.OSVersion,
 		&session.Browser,
 		&session.BrowserVersion,
+		&session.Webview,
+		&session.WebviewApp,
 		&session.Desktop,
 		&session.Mobile,
+		&session.DeviceType,
+		&session.DeviceBrand,
+		&session.DeviceModel,
 		&session.ScreenClass,
 		&session.UTMSource,
 		&session.UTMMedium,
 		&session.UTMCampaign,
 		&session.UTMContent,
 		&session.UTMTerm,
-		&session.Extended)
+		&session.Extended,
+		&session.PropertyKeys,
+		&session.PropertyValues,
+		&session.UserID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1395,6 +1582,36 @@ func (client *Client) GetConversionsStats(ctx context.Context, query string, inc
 	return result, nil
 }
 
+// SelectBrokenLinkStats implements the Store interface.
+func (client *Client) SelectBrokenLinkStats(ctx context.Context, query string, args ...any) ([]model.BrokenLinkStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.BrokenLinkStats
+
+	for rows.Next() {
+		var result model.BrokenLinkStats
+
+		if err := rows.Scan(&result.Hostname,
+			&result.Path,
+			&result.StatusCode,
+			&result.Visitors,
+			&result.Views,
+			&result.Referrers,
+			&result.EntryPaths); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // SelectEventStats implements the Store interface.
 func (client *Client) SelectEventStats(ctx context.Context, breakdown bool, query string, args ...any) ([]model.EventStats, error) {
 	rows, err := client.QueryContext(ctx, query, args...)
@@ -1467,6 +1684,261 @@ func (client *Client) SelectEventListStats(ctx context.Context, query string, ar
 	return results, nil
 }
 
+// SelectErrorGroupStats implements the Store interface.
+func (client *Client) SelectErrorGroupStats(ctx context.Context, query string, args ...any) ([]model.ErrorGroupStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ErrorGroupStats
+
+	for rows.Next() {
+		var result model.ErrorGroupStats
+
+		if err := rows.Scan(&result.Fingerprint,
+			&result.Message,
+			&result.Source,
+			&result.Line,
+			&result.Column,
+			&result.Visitors,
+			&result.Sessions,
+			&result.Count,
+			&result.FirstSeen,
+			&result.LastSeen); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectErrorBrowserStats implements the Store interface.
+func (client *Client) SelectErrorBrowserStats(ctx context.Context, query string, args ...any) ([]model.ErrorBrowserStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ErrorBrowserStats
+
+	for rows.Next() {
+		var result model.ErrorBrowserStats
+
+		if err := rows.Scan(&result.Browser, &result.Visitors, &result.Sessions, &result.Count); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectErrorOSStats implements the Store interface.
+func (client *Client) SelectErrorOSStats(ctx context.Context, query string, args ...any) ([]model.ErrorOSStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ErrorOSStats
+
+	for rows.Next() {
+		var result model.ErrorOSStats
+
+		if err := rows.Scan(&result.OS, &result.Visitors, &result.Sessions, &result.Count); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectClickGridStats implements the Store interface.
+func (client *Client) SelectClickGridStats(ctx context.Context, query string, args ...any) ([]model.ClickGridStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ClickGridStats
+
+	for rows.Next() {
+		var result model.ClickGridStats
+
+		if err := rows.Scan(&result.Path, &result.ScreenClass, &result.X, &result.Y, &result.Clicks, &result.Density); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectClickSelectorStats implements the Store interface.
+func (client *Client) SelectClickSelectorStats(ctx context.Context, query string, args ...any) ([]model.ClickSelectorStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ClickSelectorStats
+
+	for rows.Next() {
+		var result model.ClickSelectorStats
+
+		if err := rows.Scan(&result.Path,
+			&result.ScreenClass,
+			&result.Selector,
+			&result.Visitors,
+			&result.Clicks,
+			&result.RelativeClicks); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectUserStats implements the Store interface.
+func (client *Client) SelectUserStats(ctx context.Context, query string, args ...any) ([]model.UserStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.UserStats
+
+	for rows.Next() {
+		var result model.UserStats
+
+		if err := rows.Scan(&result.Day, &result.Users, &result.NewUsers, &result.ReturningUsers); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectUserRetentionStats implements the Store interface.
+func (client *Client) SelectUserRetentionStats(ctx context.Context, query string, args ...any) ([]model.UserRetentionStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.UserRetentionStats
+
+	for rows.Next() {
+		var result model.UserRetentionStats
+
+		if err := rows.Scan(&result.Cohort, &result.Period, &result.Users, &result.RelativeUsers); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// GetOptOutStats implements the Store interface.
+func (client *Client) GetOptOutStats(ctx context.Context, query string, args ...any) (*model.OptOutStats, error) {
+	result := new(model.OptOutStats)
+
+	if err := client.QueryRowContext(ctx, query, args...).Scan(&result.Requests,
+		&result.OptedOut,
+		&result.RelativeOptedOut); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SelectOptOutPathStats implements the Store interface.
+func (client *Client) SelectOptOutPathStats(ctx context.Context, query string, args ...any) ([]model.OptOutPathStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.OptOutPathStats
+
+	for rows.Next() {
+		var result model.OptOutPathStats
+
+		if err := rows.Scan(&result.Path, &result.Requests); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectEventTargetStats implements the Store interface.
+func (client *Client) SelectEventTargetStats(ctx context.Context, includePath bool, query string, args ...any) ([]model.EventTargetStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.EventTargetStats
+
+	if includePath {
+		for rows.Next() {
+			var result model.EventTargetStats
+
+			if err := rows.Scan(&result.Path, &result.Target, &result.Count, &result.Visitors, &result.RelativeVisitors); err != nil {
+				return nil, err
+			}
+
+			results = append(results, result)
+		}
+	} else {
+		for rows.Next() {
+			var result model.EventTargetStats
+
+			if err := rows.Scan(&result.Target, &result.Count, &result.Visitors, &result.RelativeVisitors); err != nil {
+				return nil, err
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
 // SelectReferrerStats implements the Store interface.
 func (client *Client) SelectReferrerStats(ctx context.Context, query string, args ...any) ([]model.ReferrerStats, error) {
 	rows, err := client.QueryContext(ctx, query, args...)
@@ -1498,6 +1970,83 @@ func (client *Client) SelectReferrerStats(ctx context.Context, query string, arg
 	return results, nil
 }
 
+// SelectReferrerCategoryStats implements the Store interface.
+func (client *Client) SelectReferrerCategoryStats(ctx context.Context, query string, args ...any) ([]model.ReferrerCategoryStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ReferrerCategoryStats
+
+	for rows.Next() {
+		var result model.ReferrerCategoryStats
+
+		if err := rows.Scan(&result.ReferrerCategory, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectReferrerURLStats implements the Store interface.
+func (client *Client) SelectReferrerURLStats(ctx context.Context, query string, args ...any) ([]model.ReferrerURLStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ReferrerURLStats
+
+	for rows.Next() {
+		var result model.ReferrerURLStats
+
+		if err := rows.Scan(&result.Referrer,
+			&result.Visitors,
+			&result.Sessions,
+			&result.Bounces,
+			&result.BounceRate,
+			&result.AverageSessionDurationSeconds); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectReferrerKeywordStats implements the Store interface.
+func (client *Client) SelectReferrerKeywordStats(ctx context.Context, query string, args ...any) ([]model.ReferrerKeywordStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.ReferrerKeywordStats
+
+	for rows.Next() {
+		var result model.ReferrerKeywordStats
+
+		if err := rows.Scan(&result.ReferrerKeyword, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // GetPlatformStats implements the Store interface.
 func (client *Client) GetPlatformStats(ctx context.Context, query string, args ...any) (*model.PlatformStats, error) {
 	result := new(model.PlatformStats)
@@ -1538,6 +2087,30 @@ func (client *Client) SelectLanguageStats(ctx context.Context, query string, arg
 	return results, nil
 }
 
+// SelectLocaleStats implements the Store interface.
+func (client *Client) SelectLocaleStats(ctx context.Context, query string, args ...any) ([]model.LocaleStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.LocaleStats
+
+	for rows.Next() {
+		var result model.LocaleStats
+
+		if err := rows.Scan(&result.Locale, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // SelectCountryStats implements the Store interface.
 func (client *Client) SelectCountryStats(ctx context.Context, query string, args ...any) ([]model.CountryStats, error) {
 	rows, err := client.QueryContext(ctx, query, args...)
@@ -1658,6 +2231,102 @@ func (client *Client) SelectOSStats(ctx context.Context, query string, args ...a
 	return results, nil
 }
 
+// SelectWebviewStats implements the Store interface.
+func (client *Client) SelectWebviewStats(ctx context.Context, query string, args ...any) ([]model.WebviewStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.WebviewStats
+
+	for rows.Next() {
+		var result model.WebviewStats
+
+		if err := rows.Scan(&result.WebviewApp, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectDeviceTypeStats implements the Store interface.
+func (client *Client) SelectDeviceTypeStats(ctx context.Context, query string, args ...any) ([]model.DeviceTypeStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.DeviceTypeStats
+
+	for rows.Next() {
+		var result model.DeviceTypeStats
+
+		if err := rows.Scan(&result.DeviceType, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectDeviceBrandStats implements the Store interface.
+func (client *Client) SelectDeviceBrandStats(ctx context.Context, query string, args ...any) ([]model.DeviceBrandStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.DeviceBrandStats
+
+	for rows.Next() {
+		var result model.DeviceBrandStats
+
+		if err := rows.Scan(&result.DeviceBrand, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectDeviceModelStats implements the Store interface.
+func (client *Client) SelectDeviceModelStats(ctx context.Context, query string, args ...any) ([]model.DeviceModelStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.DeviceModelStats
+
+	for rows.Next() {
+		var result model.DeviceModelStats
+
+		if err := rows.Scan(&result.DeviceBrand, &result.DeviceModel, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // SelectScreenClassStats implements the Store interface.
 func (client *Client) SelectScreenClassStats(ctx context.Context, query string, args ...any) ([]model.ScreenClassStats, error) {
 	rows, err := client.QueryContext(ctx, query, args...)
@@ -1912,6 +2581,42 @@ func (client *Client) SelectTagStats(ctx context.Context, breakdown bool, query
 	return results, nil
 }
 
+// SelectSessionPropertyStats implements the Store interface.
+func (client *Client) SelectSessionPropertyStats(ctx context.Context, breakdown bool, query string, args ...any) ([]model.SessionPropertyStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.SessionPropertyStats
+
+	for rows.Next() {
+		var result model.SessionPropertyStats
+
+		if breakdown {
+			if err := rows.Scan(&result.Value,
+				&result.Visitors,
+				&result.Sessions,
+				&result.RelativeVisitors); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := rows.Scan(&result.Key,
+				&result.Visitors,
+				&result.Sessions,
+				&result.RelativeVisitors); err != nil {
+				return nil, err
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // SelectSessions implements the Store interface.
 func (client *Client) SelectSessions(ctx context.Context, query string, args ...any) ([]model.Session, error) {
 	rows, err := client.QueryContext(ctx, query, args...)
@@ -1956,7 +2661,10 @@ func (client *Client) SelectSessions(ctx context.Context, query string, args ...
 			&result.UTMCampaign,
 			&result.UTMContent,
 			&result.UTMTerm,
-			&result.Extended); err != nil {
+			&result.Extended,
+			&result.PropertyKeys,
+			&result.PropertyValues,
+			&result.UserID); err != nil {
 			return nil, err
 		}
 
@@ -2092,6 +2800,74 @@ func (client *Client) SelectFunnelSteps(ctx context.Context, query string, args
 	return results, nil
 }
 
+// SelectSiteSearchStats implements the Store interface.
+func (client *Client) SelectSiteSearchStats(ctx context.Context, query string, args ...any) ([]model.SiteSearchStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.SiteSearchStats
+
+	for rows.Next() {
+		var result model.SiteSearchStats
+
+		if err := rows.Scan(&result.SearchTerm,
+			&result.Visitors,
+			&result.Searches,
+			&result.Exits,
+			&result.Refinements,
+			&result.ExitRate,
+			&result.RefinementRate); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SelectSiteSearchCategoryStats implements the Store interface.
+func (client *Client) SelectSiteSearchCategoryStats(ctx context.Context, query string, args ...any) ([]model.SiteSearchCategoryStats, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.closeRows(rows)
+	var results []model.SiteSearchCategoryStats
+
+	for rows.Next() {
+		var result model.SiteSearchCategoryStats
+
+		if err := rows.Scan(&result.SearchCategory, &result.Visitors, &result.RelativeVisitors); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// GetSiteSearchConversionStats implements the Store interface.
+func (client *Client) GetSiteSearchConversionStats(ctx context.Context, query string, args ...any) (*model.SiteSearchConversionStats, error) {
+	result := new(model.SiteSearchConversionStats)
+
+	if err := client.QueryRowContext(ctx, query, args...).Scan(&result.Visitors,
+		&result.Sessions,
+		&result.Conversions,
+		&result.CR); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (client *Client) boolean(b bool) int8 {
 	if b {
 		return 1
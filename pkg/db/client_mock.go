@@ -15,6 +15,8 @@ type ClientMock struct {
 	sessions      []model.Session
 	events        []model.Event
 	requests      []model.Request
+	clientErrors  []model.ClientError
+	clicks        []model.Click
 	ReturnSession *model.Session
 	m             sync.Mutex
 }
@@ -22,10 +24,12 @@ type ClientMock struct {
 // NewClientMock returns a new mock client.
 func NewClientMock() *ClientMock {
 	return &ClientMock{
-		pageViews: make([]model.PageView, 0),
-		sessions:  make([]model.Session, 0),
-		events:    make([]model.Event, 0),
-		requests:  make([]model.Request, 0),
+		pageViews:    make([]model.PageView, 0),
+		sessions:     make([]model.Session, 0),
+		events:       make([]model.Event, 0),
+		requests:     make([]model.Request, 0),
+		clientErrors: make([]model.ClientError, 0),
+		clicks:       make([]model.Click, 0),
 	}
 }
 
@@ -93,6 +97,38 @@ func (client *ClientMock) GetRequests() []model.Request {
 	return data
 }
 
+// GetClientErrors returns a copy of the JavaScript errors slice.
+func (client *ClientMock) GetClientErrors() []model.ClientError {
+	client.m.Lock()
+	defer client.m.Unlock()
+	data := make([]model.ClientError, len(client.clientErrors))
+	copy(data, client.clientErrors)
+	sort.Slice(data, func(i, j int) bool {
+		if data[i].Time.Before(data[j].Time) {
+			return true
+		}
+
+		return false
+	})
+	return data
+}
+
+// GetClicks returns a copy of the clicks slice.
+func (client *ClientMock) GetClicks() []model.Click {
+	client.m.Lock()
+	defer client.m.Unlock()
+	data := make([]model.Click, len(client.clicks))
+	copy(data, client.clicks)
+	sort.Slice(data, func(i, j int) bool {
+		if data[i].Time.Before(data[j].Time) {
+			return true
+		}
+
+		return false
+	})
+	return data
+}
+
 // SavePageViews implements the Store interface.
 func (client *ClientMock) SavePageViews(pageViews []model.PageView) error {
 	client.m.Lock()
@@ -125,6 +161,60 @@ func (client *ClientMock) SaveRequests(bots []model.Request) error {
 	return nil
 }
 
+// SaveClientErrors implements the Store interface.
+func (client *ClientMock) SaveClientErrors(clientErrors []model.ClientError) error {
+	client.m.Lock()
+	defer client.m.Unlock()
+	client.clientErrors = append(client.clientErrors, clientErrors...)
+	return nil
+}
+
+// SaveClicks implements the Store interface.
+func (client *ClientMock) SaveClicks(clicks []model.Click) error {
+	client.m.Lock()
+	defer client.m.Unlock()
+	client.clicks = append(client.clicks, clicks...)
+	return nil
+}
+
+// UpdateReferrers implements the Store interface.
+func (client *ClientMock) UpdateReferrers(_ context.Context, clientID uint64, resolve func(string) (string, string, string)) (int, error) {
+	client.m.Lock()
+	defer client.m.Unlock()
+	type mapping struct {
+		name, icon, category string
+	}
+	mappings := make(map[string]mapping)
+
+	for _, session := range client.sessions {
+		if _, found := mappings[session.Referrer]; !found && session.ClientID == clientID && session.Referrer != "" {
+			if name, icon, category := resolve(session.Referrer); name != "" {
+				mappings[session.Referrer] = mapping{name, icon, category}
+			}
+		}
+	}
+
+	for i := range client.sessions {
+		if m, found := mappings[client.sessions[i].Referrer]; found && client.sessions[i].ClientID == clientID {
+			client.sessions[i].ReferrerName, client.sessions[i].ReferrerIcon, client.sessions[i].ReferrerCategory = m.name, m.icon, m.category
+		}
+	}
+
+	for i := range client.pageViews {
+		if m, found := mappings[client.pageViews[i].Referrer]; found && client.pageViews[i].ClientID == clientID {
+			client.pageViews[i].ReferrerName, client.pageViews[i].ReferrerIcon, client.pageViews[i].ReferrerCategory = m.name, m.icon, m.category
+		}
+	}
+
+	for i := range client.events {
+		if m, found := mappings[client.events[i].Referrer]; found && client.events[i].ClientID == clientID {
+			client.events[i].ReferrerName, client.events[i].ReferrerIcon, client.events[i].ReferrerCategory = m.name, m.icon, m.category
+		}
+	}
+
+	return len(mappings), nil
+}
+
 // Session implements the Store interface.
 func (client *ClientMock) Session(context.Context, uint64, uint64, time.Time) (*model.Session, error) {
 	if client.ReturnSession != nil {
@@ -239,6 +329,11 @@ func (client *ClientMock) GetConversionsStats(context.Context, string, bool, ...
 	return nil, nil
 }
 
+// SelectBrokenLinkStats implements the Store interface.
+func (client *ClientMock) SelectBrokenLinkStats(context.Context, string, ...any) ([]model.BrokenLinkStats, error) {
+	return nil, nil
+}
+
 // SelectEventStats implements the Store interface.
 func (client *ClientMock) SelectEventStats(context.Context, bool, string, ...any) ([]model.EventStats, error) {
 	return nil, nil
@@ -249,11 +344,76 @@ func (client *ClientMock) SelectEventListStats(context.Context, string, ...any)
 	return nil, nil
 }
 
+// SelectErrorGroupStats implements the Store interface.
+func (client *ClientMock) SelectErrorGroupStats(context.Context, string, ...any) ([]model.ErrorGroupStats, error) {
+	return nil, nil
+}
+
+// SelectErrorBrowserStats implements the Store interface.
+func (client *ClientMock) SelectErrorBrowserStats(context.Context, string, ...any) ([]model.ErrorBrowserStats, error) {
+	return nil, nil
+}
+
+// SelectErrorOSStats implements the Store interface.
+func (client *ClientMock) SelectErrorOSStats(context.Context, string, ...any) ([]model.ErrorOSStats, error) {
+	return nil, nil
+}
+
+// SelectClickGridStats implements the Store interface.
+func (client *ClientMock) SelectClickGridStats(context.Context, string, ...any) ([]model.ClickGridStats, error) {
+	return nil, nil
+}
+
+// SelectClickSelectorStats implements the Store interface.
+func (client *ClientMock) SelectClickSelectorStats(context.Context, string, ...any) ([]model.ClickSelectorStats, error) {
+	return nil, nil
+}
+
+// SelectUserStats implements the Store interface.
+func (client *ClientMock) SelectUserStats(context.Context, string, ...any) ([]model.UserStats, error) {
+	return nil, nil
+}
+
+// SelectUserRetentionStats implements the Store interface.
+func (client *ClientMock) SelectUserRetentionStats(context.Context, string, ...any) ([]model.UserRetentionStats, error) {
+	return nil, nil
+}
+
+// GetOptOutStats implements the Store interface.
+func (client *ClientMock) GetOptOutStats(context.Context, string, ...any) (*model.OptOutStats, error) {
+	return nil, nil
+}
+
+// SelectOptOutPathStats implements the Store interface.
+func (client *ClientMock) SelectOptOutPathStats(context.Context, string, ...any) ([]model.OptOutPathStats, error) {
+	return nil, nil
+}
+
+// SelectEventTargetStats implements the Store interface.
+func (client *ClientMock) SelectEventTargetStats(context.Context, bool, string, ...any) ([]model.EventTargetStats, error) {
+	return nil, nil
+}
+
 // SelectReferrerStats implements the Store interface.
 func (client *ClientMock) SelectReferrerStats(context.Context, string, ...any) ([]model.ReferrerStats, error) {
 	return nil, nil
 }
 
+// SelectReferrerCategoryStats implements the Store interface.
+func (client *ClientMock) SelectReferrerCategoryStats(context.Context, string, ...any) ([]model.ReferrerCategoryStats, error) {
+	return nil, nil
+}
+
+// SelectReferrerURLStats implements the Store interface.
+func (client *ClientMock) SelectReferrerURLStats(context.Context, string, ...any) ([]model.ReferrerURLStats, error) {
+	return nil, nil
+}
+
+// SelectReferrerKeywordStats implements the Store interface.
+func (client *ClientMock) SelectReferrerKeywordStats(context.Context, string, ...any) ([]model.ReferrerKeywordStats, error) {
+	return nil, nil
+}
+
 // GetPlatformStats implements the Store interface.
 func (client *ClientMock) GetPlatformStats(context.Context, string, ...any) (*model.PlatformStats, error) {
 	return nil, nil
@@ -264,6 +424,11 @@ func (client *ClientMock) SelectLanguageStats(context.Context, string, ...any) (
 	return nil, nil
 }
 
+// SelectLocaleStats implements the Store interface.
+func (client *ClientMock) SelectLocaleStats(context.Context, string, ...any) ([]model.LocaleStats, error) {
+	return nil, nil
+}
+
 // SelectCountryStats implements the Store interface.
 func (client *ClientMock) SelectCountryStats(context.Context, string, ...any) ([]model.CountryStats, error) {
 	return nil, nil
@@ -289,6 +454,26 @@ func (client *ClientMock) SelectOSStats(context.Context, string, ...any) ([]mode
 	return nil, nil
 }
 
+// SelectWebviewStats implements the Store interface.
+func (client *ClientMock) SelectWebviewStats(context.Context, string, ...any) ([]model.WebviewStats, error) {
+	return nil, nil
+}
+
+// SelectDeviceTypeStats implements the Store interface.
+func (client *ClientMock) SelectDeviceTypeStats(context.Context, string, ...any) ([]model.DeviceTypeStats, error) {
+	return nil, nil
+}
+
+// SelectDeviceBrandStats implements the Store interface.
+func (client *ClientMock) SelectDeviceBrandStats(context.Context, string, ...any) ([]model.DeviceBrandStats, error) {
+	return nil, nil
+}
+
+// SelectDeviceModelStats implements the Store interface.
+func (client *ClientMock) SelectDeviceModelStats(context.Context, string, ...any) ([]model.DeviceModelStats, error) {
+	return nil, nil
+}
+
 // SelectScreenClassStats implements the Store interface.
 func (client *ClientMock) SelectScreenClassStats(context.Context, string, ...any) ([]model.ScreenClassStats, error) {
 	return nil, nil
@@ -339,6 +524,11 @@ func (client *ClientMock) SelectTagStats(context.Context, bool, string, ...any)
 	return nil, nil
 }
 
+// SelectSessionPropertyStats implements the Store interface.
+func (client *ClientMock) SelectSessionPropertyStats(context.Context, bool, string, ...any) ([]model.SessionPropertyStats, error) {
+	return nil, nil
+}
+
 // SelectSessions implements the Store interface.
 func (client *ClientMock) SelectSessions(ctx context.Context, query string, args ...any) ([]model.Session, error) {
 	return nil, nil
@@ -358,3 +548,18 @@ func (client *ClientMock) SelectEvents(context.Context, string, ...any) ([]model
 func (client *ClientMock) SelectFunnelSteps(context.Context, string, ...any) ([]model.FunnelStep, error) {
 	return nil, nil
 }
+
+// SelectSiteSearchStats implements the Store interface.
+func (client *ClientMock) SelectSiteSearchStats(context.Context, string, ...any) ([]model.SiteSearchStats, error) {
+	return nil, nil
+}
+
+// SelectSiteSearchCategoryStats implements the Store interface.
+func (client *ClientMock) SelectSiteSearchCategoryStats(context.Context, string, ...any) ([]model.SiteSearchCategoryStats, error) {
+	return nil, nil
+}
+
+// GetSiteSearchConversionStats implements the Store interface.
+func (client *ClientMock) GetSiteSearchConversionStats(context.Context, string, ...any) (*model.SiteSearchConversionStats, error) {
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
 	"github.com/stretchr/testify/assert"
@@ -92,6 +93,9 @@ func TestClient_SaveSessions(t *testing.T) {
 			Mobile:          false,
 			ScreenClass:     "XL",
 			Extended:        123,
+			PropertyKeys:    []string{"plan"},
+			PropertyValues:  []string{"pro"},
+			UserID:          42,
 		},
 		{
 			Sign:      -1,
@@ -183,6 +187,60 @@ func TestClient_SaveEvents(t *testing.T) {
 	}))
 }
 
+func TestClient_SaveClientErrors(t *testing.T) {
+	CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveClientErrors([]model.ClientError{
+		{
+			ClientID:       1,
+			VisitorID:      1,
+			SessionID:      util.RandUint32(),
+			Time:           time.Now(),
+			Hostname:       "example.com",
+			Path:           "/path",
+			Fingerprint:    "0123456789abcdef",
+			Message:        "TypeError: x is undefined",
+			Source:         "https://example.com/app.js",
+			Line:           12,
+			Column:         34,
+			Stack:          "at main (https://example.com/app.js:12:34)",
+			OS:             "os",
+			OSVersion:      "10",
+			Browser:        "browser",
+			BrowserVersion: "89",
+			DeviceType:     "desktop",
+		},
+		{
+			VisitorID:   1,
+			Time:        time.Now().UTC(),
+			Fingerprint: "0123456789abcdef",
+			Message:     "TypeError: x is undefined",
+		},
+	}))
+}
+
+func TestClient_SaveClicks(t *testing.T) {
+	CleanupDB(t, dbClient)
+	assert.NoError(t, dbClient.SaveClicks([]model.Click{
+		{
+			ClientID:    1,
+			VisitorID:   1,
+			SessionID:   util.RandUint32(),
+			Time:        time.Now(),
+			Hostname:    "example.com",
+			Path:        "/path",
+			X:           5000,
+			Y:           2500,
+			ScreenClass: "XL",
+			Selector:    "#signup",
+		},
+		{
+			VisitorID: 1,
+			Time:      time.Now().UTC(),
+			Path:      "/path",
+		},
+	}))
+}
+
 func TestClient_SaveRequests(t *testing.T) {
 	CleanupDB(t, dbClient)
 	assert.NoError(t, dbClient.SaveRequests([]model.Request{
@@ -208,10 +266,51 @@ func TestClient_SaveRequests(t *testing.T) {
 			Time:      time.Now(),
 			UserAgent: "ua2",
 			Path:      "/bar",
+			OptOut:    true,
 		},
 	}))
 }
 
+func TestClient_UpdateReferrers(t *testing.T) {
+	CleanupDB(t, dbClient)
+	now := time.Now()
+	assert.NoError(t, dbClient.SaveSessions([]model.Session{
+		{Sign: 1, ClientID: 1, VisitorID: 1, SessionID: 1, Time: now, Start: now, Referrer: "https://example.com", ReferrerName: "example.com"},
+		{Sign: 1, ClientID: 1, VisitorID: 2, SessionID: 2, Time: now, Start: now, Referrer: "https://other.com", ReferrerName: "other.com"},
+		{Sign: 1, ClientID: 2, VisitorID: 3, SessionID: 3, Time: now, Start: now, Referrer: "https://example.com", ReferrerName: "example.com"},
+	}))
+	assert.NoError(t, dbClient.SavePageViews([]model.PageView{
+		{ClientID: 1, VisitorID: 1, SessionID: 1, Time: now, Referrer: "https://example.com", ReferrerName: "example.com"},
+	}))
+	assert.NoError(t, dbClient.SaveEvents([]model.Event{
+		{ClientID: 1, VisitorID: 1, SessionID: 1, Time: now, Name: "event", Referrer: "https://example.com", ReferrerName: "example.com"},
+	}))
+	time.Sleep(time.Millisecond * 20)
+	n, err := dbClient.UpdateReferrers(context.Background(), 1, func(referrer string) (string, string, string) {
+		if referrer == "https://example.com" {
+			return "Example", "https://example.com/icon.png", "social"
+		}
+
+		return "", "", ""
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	time.Sleep(time.Millisecond * 100)
+
+	for _, table := range []string{"session", "page_view", "event"} {
+		names, err := dbClient.SelectOptions(context.Background(), fmt.Sprintf(`SELECT DISTINCT concat(referrer_name, ' ', referrer_icon, ' ', referrer_category) FROM "%s" WHERE client_id = 1 AND referrer = 'https://example.com'`, table))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Example https://example.com/icon.png social"}, names)
+	}
+
+	names, err := dbClient.SelectOptions(context.Background(), `SELECT DISTINCT referrer_name FROM "session" WHERE client_id = 1 AND referrer = 'https://other.com'`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"other.com"}, names)
+	names, err = dbClient.SelectOptions(context.Background(), `SELECT DISTINCT referrer_name FROM "session" WHERE client_id = 2`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example.com"}, names)
+}
+
 func TestClient_Session(t *testing.T) {
 	CleanupDB(t, dbClient)
 	now := time.Now().UTC().Add(-time.Second * 20)
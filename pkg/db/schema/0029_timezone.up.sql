@@ -0,0 +1,6 @@
+ALTER TABLE "session" ADD COLUMN timezone LowCardinality(String);
+ALTER TABLE "session" ADD COLUMN timezone_offset Int32;
+ALTER TABLE "page_view" ADD COLUMN timezone LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN timezone_offset Int32;
+ALTER TABLE "event" ADD COLUMN timezone LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN timezone_offset Int32;
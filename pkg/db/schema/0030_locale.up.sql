@@ -0,0 +1,6 @@
+ALTER TABLE "session" ADD COLUMN locale LowCardinality(String);
+ALTER TABLE "session" ADD COLUMN languages Array(LowCardinality(String));
+ALTER TABLE "page_view" ADD COLUMN locale LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN languages Array(LowCardinality(String));
+ALTER TABLE "event" ADD COLUMN locale LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN languages Array(LowCardinality(String));
@@ -0,0 +1,9 @@
+ALTER TABLE "session" ADD COLUMN device_type LowCardinality(String);
+ALTER TABLE "session" ADD COLUMN device_brand LowCardinality(String);
+ALTER TABLE "session" ADD COLUMN device_model LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN device_type LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN device_brand LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN device_model LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN device_type LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN device_brand LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN device_model LowCardinality(String);
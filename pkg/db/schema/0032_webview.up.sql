@@ -0,0 +1,6 @@
+ALTER TABLE "session" ADD COLUMN webview Int8 DEFAULT 0;
+ALTER TABLE "session" ADD COLUMN webview_app LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN webview Int8 DEFAULT 0;
+ALTER TABLE "page_view" ADD COLUMN webview_app LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN webview Int8 DEFAULT 0;
+ALTER TABLE "event" ADD COLUMN webview_app LowCardinality(String);
@@ -0,0 +1,6 @@
+ALTER TABLE "session" ADD COLUMN referrer_category LowCardinality(String);
+ALTER TABLE "session" ADD COLUMN referrer_keyword String;
+ALTER TABLE "page_view" ADD COLUMN referrer_category LowCardinality(String);
+ALTER TABLE "page_view" ADD COLUMN referrer_keyword String;
+ALTER TABLE "event" ADD COLUMN referrer_category LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN referrer_keyword String;
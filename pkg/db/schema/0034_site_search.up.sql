@@ -0,0 +1,2 @@
+ALTER TABLE "page_view" ADD COLUMN search_term String;
+ALTER TABLE "page_view" ADD COLUMN search_category LowCardinality(String);
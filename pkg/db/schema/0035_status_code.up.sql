@@ -0,0 +1 @@
+ALTER TABLE "page_view" ADD COLUMN status_code UInt16 DEFAULT 200;
@@ -0,0 +1,4 @@
+ALTER TABLE "event" ADD COLUMN event_kind LowCardinality(String);
+ALTER TABLE "event" ADD COLUMN event_target String;
+ALTER TABLE "event" ADD COLUMN event_target_host String;
+ALTER TABLE "event" ADD COLUMN event_target_extension LowCardinality(String);
@@ -0,0 +1,24 @@
+CREATE TABLE "client_error"
+(
+    "client_id" UInt64,
+    "visitor_id" UInt64,
+    "session_id" UInt32,
+    "time" DateTime64(3, 'UTC'),
+    "hostname" String,
+    "path" String,
+    "fingerprint" String,
+    "message" String,
+    "source" String,
+    "line" UInt32,
+    "column" UInt32,
+    "stack" String,
+    "os" LowCardinality(String),
+    "os_version" LowCardinality(String),
+    "browser" LowCardinality(String),
+    "browser_version" LowCardinality(String),
+    "device_type" LowCardinality(String)
+)
+ENGINE = MergeTree
+PARTITION BY toYYYYMM(time)
+ORDER BY (client_id, fingerprint, time)
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,17 @@
+CREATE TABLE "click"
+(
+    "client_id" UInt64,
+    "visitor_id" UInt64,
+    "session_id" UInt32,
+    "time" DateTime64(3, 'UTC'),
+    "hostname" String,
+    "path" String,
+    "x" UInt16,
+    "y" UInt16,
+    "screen_class" LowCardinality(String),
+    "selector" String
+)
+ENGINE = MergeTree
+PARTITION BY toYYYYMM(time)
+ORDER BY (client_id, path, time)
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,2 @@
+ALTER TABLE "session" ADD COLUMN property_keys Array(String);
+ALTER TABLE "session" ADD COLUMN property_values Array(String);
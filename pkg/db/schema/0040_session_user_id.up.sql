@@ -0,0 +1 @@
+ALTER TABLE "session" ADD COLUMN user_id UInt64 DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE "request" ADD COLUMN opt_out Boolean DEFAULT 0;
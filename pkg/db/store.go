@@ -21,6 +21,17 @@ type Store interface {
 	// SaveRequests saves given requests.
 	SaveRequests([]model.Request) error
 
+	// SaveClientErrors saves given JavaScript errors.
+	SaveClientErrors([]model.ClientError) error
+
+	// SaveClicks saves given clicks.
+	SaveClicks([]model.Click) error
+
+	// UpdateReferrers re-derives the referrer name, icon, and category for all sessions, page views, and events of given client.
+	// The function is called for each distinct referrer and returns the name, icon, and category. Referrers without a name are left unchanged.
+	// It returns the number of distinct referrers that have been updated.
+	UpdateReferrers(context.Context, uint64, func(string) (string, string, string)) (int, error)
+
 	// Session returns the last hit for given client, fingerprint, and maximum age.
 	Session(context.Context, uint64, uint64, time.Time) (*model.Session, error)
 
@@ -87,21 +98,66 @@ type Store interface {
 	// GetConversionsStats returns the model.ConversionsStats.
 	GetConversionsStats(context.Context, string, bool, ...any) (*model.ConversionsStats, error)
 
+	// SelectBrokenLinkStats selects model.BrokenLinkStats.
+	SelectBrokenLinkStats(context.Context, string, ...any) ([]model.BrokenLinkStats, error)
+
 	// SelectEventStats selects model.EventStats.
 	SelectEventStats(context.Context, bool, string, ...any) ([]model.EventStats, error)
 
 	// SelectEventListStats selects model.EventListStats.
 	SelectEventListStats(context.Context, string, ...any) ([]model.EventListStats, error)
 
+	// SelectEventTargetStats selects model.EventTargetStats.
+	SelectEventTargetStats(context.Context, bool, string, ...any) ([]model.EventTargetStats, error)
+
+	// SelectErrorGroupStats selects model.ErrorGroupStats.
+	SelectErrorGroupStats(context.Context, string, ...any) ([]model.ErrorGroupStats, error)
+
+	// SelectErrorBrowserStats selects model.ErrorBrowserStats.
+	SelectErrorBrowserStats(context.Context, string, ...any) ([]model.ErrorBrowserStats, error)
+
+	// SelectErrorOSStats selects model.ErrorOSStats.
+	SelectErrorOSStats(context.Context, string, ...any) ([]model.ErrorOSStats, error)
+
+	// SelectClickGridStats selects model.ClickGridStats.
+	SelectClickGridStats(context.Context, string, ...any) ([]model.ClickGridStats, error)
+
+	// SelectClickSelectorStats selects model.ClickSelectorStats.
+	SelectClickSelectorStats(context.Context, string, ...any) ([]model.ClickSelectorStats, error)
+
+	// SelectUserStats selects model.UserStats.
+	SelectUserStats(context.Context, string, ...any) ([]model.UserStats, error)
+
+	// SelectUserRetentionStats selects model.UserRetentionStats.
+	SelectUserRetentionStats(context.Context, string, ...any) ([]model.UserRetentionStats, error)
+
+	// GetOptOutStats returns the model.OptOutStats.
+	GetOptOutStats(context.Context, string, ...any) (*model.OptOutStats, error)
+
+	// SelectOptOutPathStats selects model.OptOutPathStats.
+	SelectOptOutPathStats(context.Context, string, ...any) ([]model.OptOutPathStats, error)
+
 	// SelectReferrerStats selects model.ReferrerStats.
 	SelectReferrerStats(context.Context, string, ...any) ([]model.ReferrerStats, error)
 
+	// SelectReferrerCategoryStats selects model.ReferrerCategoryStats.
+	SelectReferrerCategoryStats(context.Context, string, ...any) ([]model.ReferrerCategoryStats, error)
+
+	// SelectReferrerURLStats selects model.ReferrerURLStats.
+	SelectReferrerURLStats(context.Context, string, ...any) ([]model.ReferrerURLStats, error)
+
+	// SelectReferrerKeywordStats selects model.ReferrerKeywordStats.
+	SelectReferrerKeywordStats(context.Context, string, ...any) ([]model.ReferrerKeywordStats, error)
+
 	// GetPlatformStats returns the model.PlatformStats.
 	GetPlatformStats(context.Context, string, ...any) (*model.PlatformStats, error)
 
 	// SelectLanguageStats selects model.LanguageStats.
 	SelectLanguageStats(context.Context, string, ...any) ([]model.LanguageStats, error)
 
+	// SelectLocaleStats selects model.LocaleStats.
+	SelectLocaleStats(context.Context, string, ...any) ([]model.LocaleStats, error)
+
 	// SelectCountryStats selects model.CountryStats.
 	SelectCountryStats(context.Context, string, ...any) ([]model.CountryStats, error)
 
@@ -117,6 +173,18 @@ type Store interface {
 	// SelectOSStats selects model.OSStats.
 	SelectOSStats(context.Context, string, ...any) ([]model.OSStats, error)
 
+	// SelectWebviewStats selects model.WebviewStats.
+	SelectWebviewStats(context.Context, string, ...any) ([]model.WebviewStats, error)
+
+	// SelectDeviceTypeStats selects model.DeviceTypeStats.
+	SelectDeviceTypeStats(context.Context, string, ...any) ([]model.DeviceTypeStats, error)
+
+	// SelectDeviceBrandStats selects model.DeviceBrandStats.
+	SelectDeviceBrandStats(context.Context, string, ...any) ([]model.DeviceBrandStats, error)
+
+	// SelectDeviceModelStats selects model.DeviceModelStats.
+	SelectDeviceModelStats(context.Context, string, ...any) ([]model.DeviceModelStats, error)
+
 	// SelectScreenClassStats selects model.ScreenClassStats.
 	SelectScreenClassStats(context.Context, string, ...any) ([]model.ScreenClassStats, error)
 
@@ -147,6 +215,9 @@ type Store interface {
 	// SelectOptions selects a list of filter options.
 	SelectOptions(context.Context, string, ...any) ([]string, error)
 
+	// SelectSessionPropertyStats selects model.SessionPropertyStats.
+	SelectSessionPropertyStats(context.Context, bool, string, ...any) ([]model.SessionPropertyStats, error)
+
 	// SelectSessions selects sessions.
 	SelectSessions(context.Context, string, ...any) ([]model.Session, error)
 
@@ -158,4 +229,13 @@ type Store interface {
 
 	// SelectFunnelSteps selects funnel steps.
 	SelectFunnelSteps(context.Context, string, ...any) ([]model.FunnelStep, error)
+
+	// SelectSiteSearchStats selects model.SiteSearchStats.
+	SelectSiteSearchStats(context.Context, string, ...any) ([]model.SiteSearchStats, error)
+
+	// SelectSiteSearchCategoryStats selects model.SiteSearchCategoryStats.
+	SelectSiteSearchCategoryStats(context.Context, string, ...any) ([]model.SiteSearchCategoryStats, error)
+
+	// GetSiteSearchConversionStats returns the model.SiteSearchConversionStats.
+	GetSiteSearchConversionStats(context.Context, string, ...any) (*model.SiteSearchConversionStats, error)
 }
@@ -50,6 +50,8 @@ func CleanupDB(t *testing.T, client *Client) {
 		"session",
 		"event",
 		"request",
+		"client_error",
+		"click",
 		"imported_browser",
 		"imported_utm_campaign",
 		"imported_city",
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Click is a click on a page used to create heatmaps.
+// The X and Y position is relative to the document size in 1/10000, so that 5000 is the center of the page.
+type Click struct {
+	ClientID    uint64    `db:"client_id" json:"client_id"`
+	VisitorID   uint64    `db:"visitor_id" json:"visitor_id"`
+	SessionID   uint32    `db:"session_id" json:"session_id"`
+	Time        time.Time `json:"time"`
+	Hostname    string    `json:"hostname"`
+	Path        string    `json:"path"`
+	X           uint16    `json:"x"`
+	Y           uint16    `json:"y"`
+	ScreenClass string    `db:"screen_class" json:"screen_class"`
+	Selector    string    `json:"selector"`
+}
+
+// String implements the Stringer interface.
+func (click Click) String() string {
+	out, _ := json.Marshal(click)
+	return string(out)
+}
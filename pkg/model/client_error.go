@@ -0,0 +1,34 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ClientError is a JavaScript error that occurred in the browser of a visitor.
+// Errors are grouped by their Fingerprint, which is created from the normalized message and top stack frames.
+type ClientError struct {
+	ClientID       uint64    `db:"client_id" json:"client_id"`
+	VisitorID      uint64    `db:"visitor_id" json:"visitor_id"`
+	SessionID      uint32    `db:"session_id" json:"session_id"`
+	Time           time.Time `json:"time"`
+	Hostname       string    `json:"hostname"`
+	Path           string    `json:"path"`
+	Fingerprint    string    `json:"fingerprint"`
+	Message        string    `json:"message"`
+	Source         string    `json:"source"`
+	Line           uint32    `json:"line"`
+	Column         uint32    `json:"column"`
+	Stack          string    `json:"stack"`
+	OS             string    `json:"os"`
+	OSVersion      string    `db:"os_version" json:"os_version"`
+	Browser        string    `json:"browser"`
+	BrowserVersion string    `db:"browser_version" json:"browser_version"`
+	DeviceType     string    `db:"device_type" json:"device_type"`
+}
+
+// String implements the Stringer interface.
+func (clientError ClientError) String() string {
+	out, _ := json.Marshal(clientError)
+	return string(out)
+}
@@ -7,37 +7,53 @@ import (
 
 // Event represents a single data point for custom events.
 // It's basically the same as Session, but with some additional fields (event name, time, and meta fields).
+// Outbound link clicks and file downloads have a Kind (see pkg.EventKindOutboundLink) and a Target.
 type Event struct {
-	ClientID        uint64    `db:"client_id" json:"client_id"`
-	VisitorID       uint64    `db:"visitor_id" json:"visitor_id"`
-	Time            time.Time `json:"time"`
-	SessionID       uint32    `db:"session_id" json:"session_id"`
-	Name            string    `db:"event_name" json:"name"`
-	MetaKeys        []string  `db:"event_meta_keys" json:"meta_keys"`
-	MetaValues      []string  `db:"event_meta_values" json:"meta_values"`
-	DurationSeconds uint32    `db:"duration_seconds" json:"duration_seconds"`
-	Hostname        string    `json:"hostname"`
-	Path            string    `json:"path"`
-	Title           string    `json:"title"`
-	Language        string    `json:"language"`
-	CountryCode     string    `db:"country_code" json:"country_code"`
-	Region          string    `json:"region"`
-	City            string    `json:"city"`
-	Referrer        string    `json:"referrer"`
-	ReferrerName    string    `db:"referrer_name" json:"referrer_name"`
-	ReferrerIcon    string    `db:"referrer_icon" json:"referrer_icon"`
-	OS              string    `json:"os"`
-	OSVersion       string    `db:"os_version" json:"os_version"`
-	Browser         string    `json:"browser"`
-	BrowserVersion  string    `db:"browser_version" json:"browser_version"`
-	Desktop         bool      `json:"desktop"`
-	Mobile          bool      `json:"mobile"`
-	ScreenClass     string    `db:"screen_class" json:"screen_class"`
-	UTMSource       string    `db:"utm_source" json:"utm_source"`
-	UTMMedium       string    `db:"utm_medium" json:"utm_medium"`
-	UTMCampaign     string    `db:"utm_campaign" json:"utm_campaign"`
-	UTMContent      string    `db:"utm_content" json:"utm_content"`
-	UTMTerm         string    `db:"utm_term" json:"utm_term"`
+	ClientID         uint64    `db:"client_id" json:"client_id"`
+	VisitorID        uint64    `db:"visitor_id" json:"visitor_id"`
+	Time             time.Time `json:"time"`
+	SessionID        uint32    `db:"session_id" json:"session_id"`
+	Name             string    `db:"event_name" json:"name"`
+	MetaKeys         []string  `db:"event_meta_keys" json:"meta_keys"`
+	MetaValues       []string  `db:"event_meta_values" json:"meta_values"`
+	DurationSeconds  uint32    `db:"duration_seconds" json:"duration_seconds"`
+	Hostname         string    `json:"hostname"`
+	Path             string    `json:"path"`
+	Title            string    `json:"title"`
+	Language         string    `json:"language"`
+	Locale           string    `json:"locale"`
+	Languages        []string  `json:"languages"`
+	CountryCode      string    `db:"country_code" json:"country_code"`
+	Region           string    `json:"region"`
+	City             string    `json:"city"`
+	Timezone         string    `json:"timezone"`
+	TimezoneOffset   int32     `db:"timezone_offset" json:"timezone_offset"`
+	Referrer         string    `json:"referrer"`
+	ReferrerName     string    `db:"referrer_name" json:"referrer_name"`
+	ReferrerIcon     string    `db:"referrer_icon" json:"referrer_icon"`
+	ReferrerCategory string    `db:"referrer_category" json:"referrer_category"`
+	ReferrerKeyword  string    `db:"referrer_keyword" json:"referrer_keyword"`
+	OS               string    `json:"os"`
+	OSVersion        string    `db:"os_version" json:"os_version"`
+	Browser          string    `json:"browser"`
+	BrowserVersion   string    `db:"browser_version" json:"browser_version"`
+	Webview          bool      `json:"webview"`
+	WebviewApp       string    `db:"webview_app" json:"webview_app"`
+	Desktop          bool      `json:"desktop"`
+	Mobile           bool      `json:"mobile"`
+	DeviceType       string    `db:"device_type" json:"device_type"`
+	DeviceBrand      string    `db:"device_brand" json:"device_brand"`
+	DeviceModel      string    `db:"device_model" json:"device_model"`
+	ScreenClass      string    `db:"screen_class" json:"screen_class"`
+	UTMSource        string    `db:"utm_source" json:"utm_source"`
+	UTMMedium        string    `db:"utm_medium" json:"utm_medium"`
+	UTMCampaign      string    `db:"utm_campaign" json:"utm_campaign"`
+	UTMContent       string    `db:"utm_content" json:"utm_content"`
+	UTMTerm          string    `db:"utm_term" json:"utm_term"`
+	Kind             string    `db:"event_kind" json:"kind"`
+	Target           string    `db:"event_target" json:"target"`
+	TargetHost       string    `db:"event_target_host" json:"target_host"`
+	TargetExtension  string    `db:"event_target_extension" json:"target_extension"`
 }
 
 // String implements the Stringer interface.
@@ -7,35 +7,49 @@ import (
 
 // PageView represents a single page visit.
 type PageView struct {
-	ClientID        uint64    `db:"client_id" json:"client_id"`
-	VisitorID       uint64    `db:"visitor_id" json:"visitor_id"`
-	SessionID       uint32    `db:"session_id" json:"session_id"`
-	Time            time.Time `json:"time"`
-	DurationSeconds uint32    `db:"duration_seconds" json:"duration_seconds"`
-	Hostname        string    `json:"hostname"`
-	Path            string    `json:"path"`
-	Title           string    `json:"title"`
-	Language        string    `json:"language"`
-	CountryCode     string    `db:"country_code" json:"country_code"`
-	Region          string    `json:"region"`
-	City            string    `json:"city"`
-	Referrer        string    `json:"referrer"`
-	ReferrerName    string    `db:"referrer_name" json:"referrer_name"`
-	ReferrerIcon    string    `db:"referrer_icon" json:"referrer_icon"`
-	OS              string    `json:"os"`
-	OSVersion       string    `db:"os_version" json:"os_version"`
-	Browser         string    `json:"browser"`
-	BrowserVersion  string    `db:"browser_version" json:"browser_version"`
-	Desktop         bool      `json:"desktop"`
-	Mobile          bool      `json:"mobile"`
-	ScreenClass     string    `db:"screen_class" json:"screen_class"`
-	UTMSource       string    `db:"utm_source" json:"utm_source"`
-	UTMMedium       string    `db:"utm_medium" json:"utm_medium"`
-	UTMCampaign     string    `db:"utm_campaign" json:"utm_campaign"`
-	UTMContent      string    `db:"utm_content" json:"utm_content"`
-	UTMTerm         string    `db:"utm_term" json:"utm_term"`
-	TagKeys         []string  `db:"tag_keys" json:"tag_keys"`
-	TagValues       []string  `db:"tag_values" json:"tag_values"`
+	ClientID         uint64    `db:"client_id" json:"client_id"`
+	VisitorID        uint64    `db:"visitor_id" json:"visitor_id"`
+	SessionID        uint32    `db:"session_id" json:"session_id"`
+	Time             time.Time `json:"time"`
+	DurationSeconds  uint32    `db:"duration_seconds" json:"duration_seconds"`
+	Hostname         string    `json:"hostname"`
+	Path             string    `json:"path"`
+	Title            string    `json:"title"`
+	Language         string    `json:"language"`
+	Locale           string    `json:"locale"`
+	Languages        []string  `json:"languages"`
+	CountryCode      string    `db:"country_code" json:"country_code"`
+	Region           string    `json:"region"`
+	City             string    `json:"city"`
+	Timezone         string    `json:"timezone"`
+	TimezoneOffset   int32     `db:"timezone_offset" json:"timezone_offset"`
+	Referrer         string    `json:"referrer"`
+	ReferrerName     string    `db:"referrer_name" json:"referrer_name"`
+	ReferrerIcon     string    `db:"referrer_icon" json:"referrer_icon"`
+	ReferrerCategory string    `db:"referrer_category" json:"referrer_category"`
+	ReferrerKeyword  string    `db:"referrer_keyword" json:"referrer_keyword"`
+	OS               string    `json:"os"`
+	OSVersion        string    `db:"os_version" json:"os_version"`
+	Browser          string    `json:"browser"`
+	BrowserVersion   string    `db:"browser_version" json:"browser_version"`
+	Webview          bool      `json:"webview"`
+	WebviewApp       string    `db:"webview_app" json:"webview_app"`
+	Desktop          bool      `json:"desktop"`
+	Mobile           bool      `json:"mobile"`
+	DeviceType       string    `db:"device_type" json:"device_type"`
+	DeviceBrand      string    `db:"device_brand" json:"device_brand"`
+	DeviceModel      string    `db:"device_model" json:"device_model"`
+	ScreenClass      string    `db:"screen_class" json:"screen_class"`
+	UTMSource        string    `db:"utm_source" json:"utm_source"`
+	UTMMedium        string    `db:"utm_medium" json:"utm_medium"`
+	UTMCampaign      string    `db:"utm_campaign" json:"utm_campaign"`
+	UTMContent       string    `db:"utm_content" json:"utm_content"`
+	UTMTerm          string    `db:"utm_term" json:"utm_term"`
+	TagKeys          []string  `db:"tag_keys" json:"tag_keys"`
+	TagValues        []string  `db:"tag_values" json:"tag_values"`
+	SearchTerm       string    `db:"search_term" json:"search_term"`
+	SearchCategory   string    `db:"search_category" json:"search_category"`
+	StatusCode       uint16    `db:"status_code" json:"status_code"`
 }
 
 // String implements the Stringer interface.
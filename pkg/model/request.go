@@ -7,6 +7,8 @@ import (
 
 // Request represents a request that has or has not been flagged as a bot.
 // The creation time, User-Agent, path, and event name are stored in the database to find bots.
+// Requests opting out of tracking using a privacy signal (Global Privacy Control or Do-Not-Track) are flagged as OptOut,
+// unless they are tracked anyway.
 type Request struct {
 	ClientID    uint64    `db:"client_id" json:"client_id"`
 	VisitorID   uint64    `db:"visitor_id" json:"visitor_id"`
@@ -22,6 +24,7 @@ type Request struct {
 	UTMCampaign string    `db:"utm_campaign" json:"utm_campaign"`
 	Bot         bool      `json:"bot"`
 	BotReason   string    `db:"bot_reason" json:"bot_reason"`
+	OptOut      bool      `db:"opt_out" json:"opt_out"`
 }
 
 // String implements the Stringer interface.
@@ -7,41 +7,55 @@ import (
 
 // Session represents a single visitor.
 type Session struct {
-	Sign            int8      `json:"sign"`
-	Version         uint16    `json:"version"`
-	ClientID        uint64    `db:"client_id" json:"client_id"`
-	VisitorID       uint64    `db:"visitor_id" json:"visitor_id"`
-	SessionID       uint32    `db:"session_id" json:"session_id"`
-	Time            time.Time `json:"time"`
-	Start           time.Time `json:"start"`
-	DurationSeconds uint32    `db:"duration_seconds" json:"duration_seconds"`
-	Hostname        string    `json:"hostname"`
-	EntryPath       string    `db:"entry_path" json:"entry_path"`
-	ExitPath        string    `db:"exit_path" json:"exit_path"`
-	PageViews       uint16    `db:"page_views" json:"page_views"`
-	IsBounce        bool      `db:"is_bounce" json:"is_bounce"`
-	EntryTitle      string    `db:"entry_title" json:"entry_title"`
-	ExitTitle       string    `db:"exit_title" json:"exit_title"`
-	Language        string    `json:"language"`
-	CountryCode     string    `db:"country_code" json:"country_code"`
-	Region          string    `json:"region"`
-	City            string    `json:"city"`
-	Referrer        string    `json:"referrer"`
-	ReferrerName    string    `db:"referrer_name" json:"referrer_name"`
-	ReferrerIcon    string    `db:"referrer_icon" json:"referrer_icon"`
-	OS              string    `json:"os"`
-	OSVersion       string    `db:"os_version" json:"os_version"`
-	Browser         string    `json:"browser"`
-	BrowserVersion  string    `db:"browser_version" json:"browser_version"`
-	Desktop         bool      `json:"desktop"`
-	Mobile          bool      `json:"mobile"`
-	ScreenClass     string    `db:"screen_class" json:"screen_class"`
-	UTMSource       string    `db:"utm_source" json:"utm_source"`
-	UTMMedium       string    `db:"utm_medium" json:"utm_medium"`
-	UTMCampaign     string    `db:"utm_campaign" json:"utm_campaign"`
-	UTMContent      string    `db:"utm_content" json:"utm_content"`
-	UTMTerm         string    `db:"utm_term" json:"utm_term"`
-	Extended        uint16    `json:"extended"`
+	Sign             int8      `json:"sign"`
+	Version          uint16    `json:"version"`
+	ClientID         uint64    `db:"client_id" json:"client_id"`
+	VisitorID        uint64    `db:"visitor_id" json:"visitor_id"`
+	SessionID        uint32    `db:"session_id" json:"session_id"`
+	Time             time.Time `json:"time"`
+	Start            time.Time `json:"start"`
+	DurationSeconds  uint32    `db:"duration_seconds" json:"duration_seconds"`
+	Hostname         string    `json:"hostname"`
+	EntryPath        string    `db:"entry_path" json:"entry_path"`
+	ExitPath         string    `db:"exit_path" json:"exit_path"`
+	PageViews        uint16    `db:"page_views" json:"page_views"`
+	IsBounce         bool      `db:"is_bounce" json:"is_bounce"`
+	EntryTitle       string    `db:"entry_title" json:"entry_title"`
+	ExitTitle        string    `db:"exit_title" json:"exit_title"`
+	Language         string    `json:"language"`
+	Locale           string    `json:"locale"`
+	Languages        []string  `json:"languages"`
+	CountryCode      string    `db:"country_code" json:"country_code"`
+	Region           string    `json:"region"`
+	City             string    `json:"city"`
+	Timezone         string    `json:"timezone"`
+	TimezoneOffset   int32     `db:"timezone_offset" json:"timezone_offset"`
+	Referrer         string    `json:"referrer"`
+	ReferrerName     string    `db:"referrer_name" json:"referrer_name"`
+	ReferrerIcon     string    `db:"referrer_icon" json:"referrer_icon"`
+	ReferrerCategory string    `db:"referrer_category" json:"referrer_category"`
+	ReferrerKeyword  string    `db:"referrer_keyword" json:"referrer_keyword"`
+	OS               string    `json:"os"`
+	OSVersion        string    `db:"os_version" json:"os_version"`
+	Browser          string    `json:"browser"`
+	BrowserVersion   string    `db:"browser_version" json:"browser_version"`
+	Webview          bool      `json:"webview"`
+	WebviewApp       string    `db:"webview_app" json:"webview_app"`
+	Desktop          bool      `json:"desktop"`
+	Mobile           bool      `json:"mobile"`
+	DeviceType       string    `db:"device_type" json:"device_type"`
+	DeviceBrand      string    `db:"device_brand" json:"device_brand"`
+	DeviceModel      string    `db:"device_model" json:"device_model"`
+	ScreenClass      string    `db:"screen_class" json:"screen_class"`
+	UTMSource        string    `db:"utm_source" json:"utm_source"`
+	UTMMedium        string    `db:"utm_medium" json:"utm_medium"`
+	UTMCampaign      string    `db:"utm_campaign" json:"utm_campaign"`
+	UTMContent       string    `db:"utm_content" json:"utm_content"`
+	UTMTerm          string    `db:"utm_term" json:"utm_term"`
+	Extended         uint16    `json:"extended"`
+	PropertyKeys     []string  `db:"property_keys" json:"property_keys"`
+	PropertyValues   []string  `db:"property_values" json:"property_values"`
+	UserID           uint64    `db:"user_id" json:"user_id"`
 }
 
 // String implements the Stringer interface.
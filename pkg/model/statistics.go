@@ -2,6 +2,7 @@ package model
 
 import (
 	"github.com/emvi/null"
+	"time"
 )
 
 // ActiveVisitorStats is the result type for active visitor statistics.
@@ -168,6 +169,17 @@ type ConversionsStats struct {
 	CustomMetricTotal float64 `db:"custom_metric_total" json:"custom_metric_total"`
 }
 
+// BrokenLinkStats is the result type for error pages.
+type BrokenLinkStats struct {
+	Hostname   string   `json:"hostname"`
+	Path       string   `json:"path"`
+	StatusCode uint16   `db:"status_code" json:"status_code"`
+	Visitors   int      `json:"visitors"`
+	Views      int      `json:"views"`
+	Referrers  []string `json:"referrers"`
+	EntryPaths []string `db:"entry_paths" json:"entry_paths"`
+}
+
 // EventStats is the result type for custom events.
 type EventStats struct {
 	Name                   string   `db:"event_name" json:"name"`
@@ -188,6 +200,17 @@ type EventListStats struct {
 	Count    int               `json:"count"`
 }
 
+// EventTargetStats is the result type for outbound link and download statistics.
+// The Target is the URL, hostname, file path, or file extension, depending on the statistic.
+// The Path is only set for downloads grouped by the page they were started on.
+type EventTargetStats struct {
+	Path             string  `json:"path,omitempty"`
+	Target           string  `json:"target"`
+	Visitors         int     `json:"visitors"`
+	Count            int     `json:"count"`
+	RelativeVisitors float64 `db:"relative_visitors" json:"relative_visitors"`
+}
+
 // ReferrerStats is the result type for referrer statistics.
 type ReferrerStats struct {
 	Referrer         string  `json:"referrer"`
@@ -200,6 +223,28 @@ type ReferrerStats struct {
 	BounceRate       float64 `db:"bounce_rate" json:"bounce_rate"`
 }
 
+// ReferrerURLStats is the result type for referrer URL and path prefix statistics.
+type ReferrerURLStats struct {
+	Referrer                      string  `json:"referrer"`
+	Visitors                      int     `json:"visitors"`
+	Sessions                      int     `json:"sessions"`
+	Bounces                       int     `json:"bounces"`
+	BounceRate                    float64 `db:"bounce_rate" json:"bounce_rate"`
+	AverageSessionDurationSeconds int     `db:"average_session_duration_seconds" json:"average_session_duration_seconds"`
+}
+
+// ReferrerCategoryStats is the result type for referrer category statistics.
+type ReferrerCategoryStats struct {
+	MetaStats
+	ReferrerCategory string `db:"referrer_category" json:"referrer_category"`
+}
+
+// ReferrerKeywordStats is the result type for search keyword statistics.
+type ReferrerKeywordStats struct {
+	MetaStats
+	ReferrerKeyword string `db:"referrer_keyword" json:"referrer_keyword"`
+}
+
 // PlatformStats is the result type for platform statistics.
 type PlatformStats struct {
 	PlatformDesktop         int     `db:"platform_desktop" json:"platform_desktop"`
@@ -234,6 +279,12 @@ type LanguageStats struct {
 	Language string `json:"language"`
 }
 
+// LocaleStats is the result type for locale statistics.
+type LocaleStats struct {
+	MetaStats
+	Locale string `json:"locale"`
+}
+
 // CountryStats is the result type for country statistics.
 type CountryStats struct {
 	MetaStats
@@ -281,6 +332,31 @@ type OSVersionStats struct {
 	OSVersion string `db:"os_version" json:"os_version"`
 }
 
+// WebviewStats is the result type for in-app browser statistics.
+type WebviewStats struct {
+	MetaStats
+	WebviewApp string `db:"webview_app" json:"webview_app"`
+}
+
+// DeviceTypeStats is the result type for device type statistics.
+type DeviceTypeStats struct {
+	MetaStats
+	DeviceType string `db:"device_type" json:"device_type"`
+}
+
+// DeviceBrandStats is the result type for device brand statistics.
+type DeviceBrandStats struct {
+	MetaStats
+	DeviceBrand string `db:"device_brand" json:"device_brand"`
+}
+
+// DeviceModelStats is the result type for device model statistics.
+type DeviceModelStats struct {
+	MetaStats
+	DeviceBrand string `db:"device_brand" json:"device_brand"`
+	DeviceModel string `db:"device_model" json:"device_model"`
+}
+
 // ScreenClassStats is the result type for screen class statistics.
 type ScreenClassStats struct {
 	MetaStats
@@ -353,6 +429,15 @@ type TagStats struct {
 	RelativeViews    float64 `db:"relative_views" json:"relative_views"`
 }
 
+// SessionPropertyStats is the result type for session properties.
+type SessionPropertyStats struct {
+	Key              string  `json:"key"`
+	Value            string  `json:"value"`
+	Visitors         int     `json:"visitors"`
+	Sessions         int     `json:"sessions"`
+	RelativeVisitors float64 `db:"relative_visitors" json:"relative_visitors"`
+}
+
 // SessionStep is the result type combining page views and events for a single session.
 type SessionStep struct {
 	PageView *PageView `json:"page_view"`
@@ -369,3 +454,112 @@ type FunnelStep struct {
 	Dropped                  int     `json:"dropped"`
 	DropOff                  float64 `db:"drop_off" json:"drop_off"`
 }
+
+// SiteSearchStats is the result type for site search terms.
+type SiteSearchStats struct {
+	SearchTerm     string  `db:"search_term" json:"search_term"`
+	Visitors       int     `json:"visitors"`
+	Searches       int     `json:"searches"`
+	Exits          int     `json:"exits"`
+	Refinements    int     `json:"refinements"`
+	ExitRate       float64 `db:"exit_rate" json:"exit_rate"`
+	RefinementRate float64 `db:"refinement_rate" json:"refinement_rate"`
+}
+
+// SiteSearchCategoryStats is the result type for site search categories.
+type SiteSearchCategoryStats struct {
+	MetaStats
+	SearchCategory string `db:"search_category" json:"search_category"`
+}
+
+// SiteSearchConversionStats is the result type for the site search conversion rate.
+type SiteSearchConversionStats struct {
+	Visitors    int     `json:"visitors"`
+	Sessions    int     `json:"sessions"`
+	Conversions int     `json:"conversions"`
+	CR          float64 `json:"cr"`
+}
+
+// ErrorGroupStats is the result type for JavaScript errors grouped by fingerprint.
+// The message, source, line, and column are taken from the most recent error of the group.
+type ErrorGroupStats struct {
+	Fingerprint string    `json:"fingerprint"`
+	Message     string    `json:"message"`
+	Source      string    `json:"source"`
+	Line        uint32    `json:"line"`
+	Column      uint32    `json:"column"`
+	Visitors    int       `json:"visitors"`
+	Sessions    int       `json:"sessions"`
+	Count       int       `json:"count"`
+	FirstSeen   time.Time `db:"first_seen" json:"first_seen"`
+	LastSeen    time.Time `db:"last_seen" json:"last_seen"`
+}
+
+// ErrorBrowserStats is the result type for JavaScript errors grouped by browser.
+type ErrorBrowserStats struct {
+	Browser  string `json:"browser"`
+	Visitors int    `json:"visitors"`
+	Sessions int    `json:"sessions"`
+	Count    int    `json:"count"`
+}
+
+// ErrorOSStats is the result type for JavaScript errors grouped by operating system.
+type ErrorOSStats struct {
+	OS       string `json:"os"`
+	Visitors int    `json:"visitors"`
+	Sessions int    `json:"sessions"`
+	Count    int    `json:"count"`
+}
+
+// ClickGridStats is the result type for the click heatmap of a page.
+// X and Y are the cell of the grid, and the density is the share of clicks on the page and screen class in the cell.
+type ClickGridStats struct {
+	Path        string  `json:"path"`
+	ScreenClass string  `db:"screen_class" json:"screen_class"`
+	X           int     `json:"x"`
+	Y           int     `json:"y"`
+	Clicks      int     `json:"clicks"`
+	Density     float64 `json:"density"`
+}
+
+// ClickSelectorStats is the result type for clicked elements on a page.
+type ClickSelectorStats struct {
+	Path           string  `json:"path"`
+	ScreenClass    string  `db:"screen_class" json:"screen_class"`
+	Selector       string  `json:"selector"`
+	Visitors       int     `json:"visitors"`
+	Clicks         int     `json:"clicks"`
+	RelativeClicks float64 `db:"relative_clicks" json:"relative_clicks"`
+}
+
+// UserStats is the result type for new and returning users.
+// The day is the first day of the period.
+type UserStats struct {
+	Day            time.Time `json:"day"`
+	Users          int       `json:"users"`
+	NewUsers       int       `db:"new_users" json:"new_users"`
+	ReturningUsers int       `db:"returning_users" json:"returning_users"`
+}
+
+// UserRetentionStats is the result type for user retention.
+// The cohort is the first day of the period users have been seen first, and the period is the number of periods since.
+type UserRetentionStats struct {
+	Cohort        time.Time `json:"cohort"`
+	Period        int       `json:"period"`
+	Users         int       `json:"users"`
+	RelativeUsers float64   `db:"relative_users" json:"relative_users"`
+}
+
+// OptOutStats is the result type for hits (page views and events) that opted out of tracking.
+// Requests is the total number of hits, including the ones that opted out.
+type OptOutStats struct {
+	Requests         int     `json:"requests"`
+	OptedOut         int     `db:"opted_out" json:"opted_out"`
+	RelativeOptedOut float64 `db:"relative_opted_out" json:"relative_opted_out"`
+}
+
+// OptOutPathStats is the result type for requests that opted out of tracking grouped by path.
+type OptOutPathStats struct {
+	Path     string `json:"path"`
+	Requests int    `json:"requests"`
+}
@@ -0,0 +1,39 @@
+package tracker
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"math"
+	"strings"
+)
+
+const (
+	maxClickPosition       = 10000
+	maxClickSelectorLength = 500
+)
+
+// ClickOptions are the options to save a click for heatmaps.
+type ClickOptions struct {
+	// X is the horizontal position of the click relative to the document width, between 0 and 1 (required).
+	X float64
+
+	// Y is the vertical position of the click relative to the document height, between 0 and 1 (required).
+	Y float64
+
+	// ViewportWidth is the width of the viewport which will be translated to a screen class.
+	ViewportWidth uint16
+
+	// Selector is an optional CSS selector of the clicked element, like "#signup" or "nav > a.pricing".
+	Selector string
+}
+
+func (options *ClickOptions) validate() bool {
+	options.Selector = util.ShortenString(strings.TrimSpace(options.Selector), maxClickSelectorLength)
+	return !math.IsNaN(options.X) && !math.IsNaN(options.Y) &&
+		options.X >= 0 && options.X <= 1 &&
+		options.Y >= 0 && options.Y <= 1
+}
+
+// getPosition returns the position in 1/10000 of the document size.
+func (options *ClickOptions) getPosition() (uint16, uint16) {
+	return uint16(math.Round(options.X * maxClickPosition)), uint16(math.Round(options.Y * maxClickPosition))
+}
@@ -0,0 +1,25 @@
+package tracker
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestClickOptions_validate(t *testing.T) {
+	options := ClickOptions{X: 0.5, Y: 1, Selector: " " + strings.Repeat("a", maxClickSelectorLength+1)}
+	assert.True(t, options.validate())
+	assert.Len(t, options.Selector, maxClickSelectorLength)
+	x, y := options.getPosition()
+	assert.Equal(t, uint16(5000), x)
+	assert.Equal(t, uint16(10000), y)
+	options = ClickOptions{X: 0.12345, Y: 0}
+	assert.True(t, options.validate())
+	x, y = options.getPosition()
+	assert.Equal(t, uint16(1235), x)
+	assert.Equal(t, uint16(0), y)
+	assert.False(t, (&ClickOptions{X: -0.1, Y: 0.5}).validate())
+	assert.False(t, (&ClickOptions{X: 0.5, Y: 1.1}).validate())
+	assert.False(t, (&ClickOptions{X: math.NaN(), Y: 0.5}).validate())
+}
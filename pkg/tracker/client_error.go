@@ -0,0 +1,111 @@
+package tracker
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxErrorMessageLength = 1000
+	maxErrorSourceLength  = 2000
+	maxErrorStackLength   = 10000
+	errorFingerprintDepth = 3
+)
+
+var (
+	errorQuoted        = regexp.MustCompile(`"[^"]*"|'[^']*'|` + "`[^`]*`")
+	errorHex           = regexp.MustCompile(`\b0x[0-9a-fA-F]+\b|\b[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}\b`)
+	errorNumber        = regexp.MustCompile(`\b\d+(\.\d+)?\b`)
+	errorFileHash      = regexp.MustCompile(`[.-][0-9a-fA-F]{6,}(\.[a-z]+)`)
+	errorLineColumn    = regexp.MustCompile(`(:\d+)+\)?$`)
+	errorFrameLocation = regexp.MustCompile(`\(?[a-z]+://[^\s)]+`)
+)
+
+// ErrorOptions are the options to save a JavaScript error.
+// The message is required. All other fields are optional.
+type ErrorOptions struct {
+	// Message is the error message (required), like "TypeError: x is undefined".
+	Message string
+
+	// Source is the URL of the script that caused the error.
+	Source string
+
+	// Line is the line number in the Source.
+	Line uint32
+
+	// Column is the column number in the Source.
+	Column uint32
+
+	// Stack is the stack trace as returned by Error.stack in JavaScript.
+	Stack string
+}
+
+func (options *ErrorOptions) validate() {
+	options.Message = util.ShortenString(strings.TrimSpace(options.Message), maxErrorMessageLength)
+	options.Source = util.ShortenString(strings.TrimSpace(options.Source), maxErrorSourceLength)
+	options.Stack = util.ShortenString(strings.TrimSpace(options.Stack), maxErrorStackLength)
+}
+
+// fingerprint returns a hash used to group errors.
+// Variable parts of the message, like numbers and quoted strings, are removed,
+// as well as line numbers, query parameters, and build hashes from the top stack frames.
+// The Source is used in case the stack is empty.
+func (options *ErrorOptions) fingerprint() string {
+	var sb strings.Builder
+	sb.WriteString(options.normalizeMessage())
+	frames := options.topStackFrames()
+
+	if len(frames) == 0 && options.Source != "" {
+		frames = append(frames, options.normalizeFrame(options.Source))
+	}
+
+	for _, frame := range frames {
+		sb.WriteString("\n")
+		sb.WriteString(frame)
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(sb.String()))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func (options *ErrorOptions) normalizeMessage() string {
+	msg := errorQuoted.ReplaceAllString(options.Message, "<s>")
+	msg = errorHex.ReplaceAllString(msg, "<x>")
+	msg = errorNumber.ReplaceAllString(msg, "<n>")
+	return strings.Join(strings.Fields(msg), " ")
+}
+
+func (options *ErrorOptions) topStackFrames() []string {
+	frames := make([]string, 0, errorFingerprintDepth)
+
+	for _, line := range strings.Split(options.Stack, "\n") {
+		line = strings.TrimSpace(line)
+
+		// Chrome uses "at fn (url:line:column)", Firefox and Safari "fn@url:line:column"
+		if strings.HasPrefix(line, "at ") || strings.Contains(line, "@") {
+			frames = append(frames, options.normalizeFrame(strings.TrimPrefix(line, "at ")))
+
+			if len(frames) == errorFingerprintDepth {
+				break
+			}
+		}
+	}
+
+	return frames
+}
+
+func (options *ErrorOptions) normalizeFrame(frame string) string {
+	return errorFrameLocation.ReplaceAllStringFunc(frame, func(location string) string {
+		location = errorLineColumn.ReplaceAllString(location, "")
+
+		if i := strings.IndexAny(location, "?#"); i > -1 {
+			location = location[:i]
+		}
+
+		return errorFileHash.ReplaceAllString(location, "$1")
+	})
+}
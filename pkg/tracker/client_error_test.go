@@ -0,0 +1,49 @@
+package tracker
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestErrorOptions_fingerprint(t *testing.T) {
+	chrome := ErrorOptions{
+		Message: `TypeError: Cannot read properties of undefined (reading 'id')`,
+		Stack: `TypeError: Cannot read properties of undefined (reading 'id')
+    at render (https://example.com/assets/app.3f2a1b9c.js:12:345)
+    at update (https://example.com/assets/app.3f2a1b9c.js:56:78)
+    at main (https://example.com/assets/main.js?v=12:1:2)
+    at https://example.com/assets/vendor.js:9:10`,
+	}
+	deployed := ErrorOptions{
+		Message: `TypeError: Cannot read properties of undefined (reading 'name')`,
+		Stack: `TypeError: Cannot read properties of undefined (reading 'name')
+    at render (https://example.com/assets/app.9e8d7c6b.js:13:1)
+    at update (https://example.com/assets/app.9e8d7c6b.js:60:2)
+    at main (https://example.com/assets/main.js?v=13:1:2)
+    at https://example.com/assets/other.js:1:1`,
+	}
+	other := ErrorOptions{
+		Message: chrome.Message,
+		Stack: `TypeError: Cannot read properties of undefined (reading 'id')
+    at checkout (https://example.com/assets/app.3f2a1b9c.js:12:345)`,
+	}
+	assert.Len(t, chrome.fingerprint(), 16)
+	assert.Equal(t, chrome.fingerprint(), deployed.fingerprint())
+	assert.NotEqual(t, chrome.fingerprint(), other.fingerprint())
+	assert.Equal(t, "TypeError: Cannot read properties of undefined (reading <s>)", chrome.normalizeMessage())
+	assert.Equal(t, []string{
+		"render (https://example.com/assets/app.js)",
+		"update (https://example.com/assets/app.js)",
+		"main (https://example.com/assets/main.js)",
+	}, chrome.topStackFrames())
+	firefox := ErrorOptions{
+		Message: "Error: request 42 failed after 1.5 seconds at 0x1f",
+		Stack:   "load@https://example.com/app.js:1:2\n@https://example.com/app.js:3:4",
+	}
+	assert.Equal(t, "Error: request <n> failed after <n> seconds at <x>", firefox.normalizeMessage())
+	assert.Equal(t, []string{"load@https://example.com/app.js", "@https://example.com/app.js"}, firefox.topStackFrames())
+	source := ErrorOptions{Message: "Script error.", Source: "https://example.com/app.js?v=1"}
+	sourceDeployed := ErrorOptions{Message: "Script error.", Source: "https://example.com/app.js?v=2"}
+	assert.Equal(t, source.fingerprint(), sourceDeployed.fingerprint())
+	assert.NotEqual(t, source.fingerprint(), (&ErrorOptions{Message: "Script error."}).fingerprint())
+}
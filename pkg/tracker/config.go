@@ -4,7 +4,9 @@ import (
 	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/geodb"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ip"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/keys"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/session"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ua"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
 	"log/slog"
 	"net"
@@ -18,6 +20,7 @@ const (
 	defaultWorkerTimeout    = time.Second * 5
 	maxWorkerTimeout        = time.Second * 60
 	defaultMaxPageViews     = uint16(200)
+	maxLanguages            = 10
 )
 
 // Config is the configuration for the Tracker.
@@ -26,30 +29,59 @@ type Config struct {
 	Salt                string
 	FingerprintKey0     uint64
 	FingerprintKey1     uint64
+	Fingerprinter       Fingerprinter
+	KeyProvider         keys.Provider
 	Worker              int
 	WorkerBufferSize    int
 	WorkerTimeout       time.Duration
 	SessionCache        session.Cache
+	UserAgentCache      *ua.Cache
+	SiteSearch          *SiteSearch
+	PrivacyMode         PrivacyMode
+	PrerenderTimeout    time.Duration
+	MaxPrerenders       int
 	HeaderParser        []ip.HeaderParser
 	AllowedProxySubnets []net.IPNet
 	MaxPageViews        uint16
-	GeoDB               *geodb.GeoDB
+	MaxLanguages        int
+	GeoDB               geodb.GeoLocator
+	GeoPrecision        GeoPrecision
 	IPFilter            ip.Filter
 	LogIP               bool
+	TruncateIP          bool
 	Logger              *slog.Logger
 }
 
 func (config *Config) validate() {
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+
 	if config.Salt == "" {
 		config.Salt = util.RandString(20)
+		config.Logger.Warn("no salt configured, using a random salt: user IDs will change on restart and differ between instances")
 	}
 
-	if config.FingerprintKey0 == 0 {
-		config.FingerprintKey0 = util.RandUint64()
+	if config.FingerprintKey0 == 0 || config.FingerprintKey1 == 0 {
+		if config.FingerprintKey0 == 0 {
+			config.FingerprintKey0 = util.RandUint64()
+		}
+
+		if config.FingerprintKey1 == 0 {
+			config.FingerprintKey1 = util.RandUint64()
+		}
+
+		if config.KeyProvider == nil && config.Fingerprinter == nil {
+			config.Logger.Warn("no fingerprint keys or key provider configured, using random keys: sessions won't continue on restart or between instances")
+		}
 	}
 
-	if config.FingerprintKey1 == 0 {
-		config.FingerprintKey1 = util.RandUint64()
+	if config.Fingerprinter == nil {
+		config.Fingerprinter = &SipHashFingerprinter{
+			Salt: config.Salt,
+			Key0: config.FingerprintKey0,
+			Key1: config.FingerprintKey1,
+		}
 	}
 
 	if config.Worker < 1 {
@@ -74,7 +106,9 @@ func (config *Config) validate() {
 		config.MaxPageViews = defaultMaxPageViews
 	}
 
-	if config.Logger == nil {
-		config.Logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	if config.MaxLanguages < 0 {
+		config.MaxLanguages = 0
+	} else if config.MaxLanguages > maxLanguages {
+		config.MaxLanguages = maxLanguages
 	}
 }
@@ -12,6 +12,7 @@ func TestConfig_validate(t *testing.T) {
 	assert.Len(t, cfg.Salt, 20)
 	assert.NotZero(t, cfg.FingerprintKey0)
 	assert.NotZero(t, cfg.FingerprintKey1)
+	assert.NotNil(t, cfg.Fingerprinter)
 	assert.Greater(t, cfg.Worker, 1)
 	assert.Equal(t, defaultWorkerBufferSize, cfg.WorkerBufferSize)
 	assert.Equal(t, defaultWorkerTimeout, cfg.WorkerTimeout)
@@ -20,4 +21,7 @@ func TestConfig_validate(t *testing.T) {
 	cfg.WorkerTimeout = time.Second * 999
 	cfg.validate()
 	assert.Equal(t, maxWorkerTimeout, cfg.WorkerTimeout)
+	cfg.MaxLanguages = 99
+	cfg.validate()
+	assert.Equal(t, maxLanguages, cfg.MaxLanguages)
 }
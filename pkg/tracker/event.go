@@ -1,6 +1,14 @@
 package tracker
 
-import "strings"
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const maxEventTargetLength = 2000
 
 // EventOptions are the options to save a new event.
 // The name is required. All other fields are optional.
@@ -41,3 +49,60 @@ func (options *EventOptions) getMetaData(tagKeys, tagValues []string) ([]string,
 
 	return keys, values
 }
+
+// eventTarget is the link or file for outbound link clicks and downloads.
+type eventTarget struct {
+	kind      string
+	target    string
+	host      string
+	extension string
+}
+
+// getOutboundLink returns the eventTarget for a link to another site.
+// Links to the same hostname as the page and links that are not using http(s) are rejected.
+func getOutboundLink(link, hostname string) (eventTarget, bool) {
+	u, err := url.ParseRequestURI(strings.TrimSpace(link))
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return eventTarget{}, false
+	}
+
+	host := util.StripWWW(strings.ToLower(u.Hostname()))
+
+	if host == util.StripWWW(hostname) {
+		return eventTarget{}, false
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.RawQuery = ""
+	u.Fragment = ""
+	return eventTarget{
+		kind:   pkg.EventKindOutboundLink,
+		target: util.ShortenString(u.String(), maxEventTargetLength),
+		host:   host,
+	}, true
+}
+
+// getDownload returns the eventTarget for a file, which is resolved relative to the page URL.
+func getDownload(file, pageURL string) (eventTarget, bool) {
+	u, err := url.Parse(strings.TrimSpace(file))
+
+	if err != nil {
+		return eventTarget{}, false
+	}
+
+	if page, err := url.Parse(pageURL); err == nil {
+		u = page.ResolveReference(u)
+	}
+
+	if u.Path == "" || strings.HasSuffix(u.Path, "/") {
+		return eventTarget{}, false
+	}
+
+	return eventTarget{
+		kind:      pkg.EventKindDownload,
+		target:    util.ShortenString(u.Path, maxEventTargetLength),
+		host:      util.StripWWW(strings.ToLower(u.Hostname())),
+		extension: strings.TrimPrefix(strings.ToLower(path.Ext(u.Path)), "."),
+	}, true
+}
@@ -1,6 +1,7 @@
 package tracker
 
 import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -32,3 +33,39 @@ func TestEventOptions_getMetaData(t *testing.T) {
 	assert.Contains(t, v, "value")
 	assert.Contains(t, v, "world")
 }
+
+func TestGetOutboundLink(t *testing.T) {
+	target, ok := getOutboundLink(" https://WWW.Partner.com/Article?utm_source=example#top ", "example.com")
+	assert.True(t, ok)
+	assert.Equal(t, eventTarget{
+		kind:   pkg.EventKindOutboundLink,
+		target: "https://www.partner.com/Article",
+		host:   "partner.com",
+	}, target)
+
+	for _, link := range []string{"", "/relative", "mailto:mail@example.com", "javascript:void(0)", "https://www.example.com/page"} {
+		_, ok = getOutboundLink(link, "example.com")
+		assert.False(t, ok, link)
+	}
+}
+
+func TestGetDownload(t *testing.T) {
+	target, ok := getDownload("/files/Report.PDF?version=2", "https://www.example.com/reports/")
+	assert.True(t, ok)
+	assert.Equal(t, eventTarget{
+		kind:      pkg.EventKindDownload,
+		target:    "/files/Report.PDF",
+		host:      "example.com",
+		extension: "pdf",
+	}, target)
+	target, ok = getDownload("archive.tar.gz", "https://example.com/downloads/")
+	assert.True(t, ok)
+	assert.Equal(t, "/downloads/archive.tar.gz", target.target)
+	assert.Equal(t, "gz", target.extension)
+	target, ok = getDownload("https://cdn.example.com/setup", "https://example.com/")
+	assert.True(t, ok)
+	assert.Equal(t, "cdn.example.com", target.host)
+	assert.Empty(t, target.extension)
+	_, ok = getDownload("https://example.com/folder/", "https://example.com/")
+	assert.False(t, ok)
+}
@@ -0,0 +1,147 @@
+package tracker
+
+import (
+	"fmt"
+	"github.com/dchest/siphash"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/keys"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// FingerprintRotationDaily changes fingerprints every day. This is the default.
+	FingerprintRotationDaily = FingerprintRotation(iota)
+
+	// FingerprintRotationHourly changes fingerprints every hour.
+	FingerprintRotationHourly
+
+	// FingerprintRotationWeekly changes fingerprints every ISO week.
+	FingerprintRotationWeekly
+)
+
+var fingerprintClientHints = []string{
+	"Sec-CH-UA",
+	"Sec-CH-UA-Mobile",
+	"Sec-CH-UA-Platform",
+	"Sec-CH-UA-Platform-Version",
+	"Sec-CH-UA-Model",
+}
+
+// FingerprintRotation is the period after which fingerprints change.
+type FingerprintRotation int
+
+// FingerprintData is the data available to calculate a fingerprint for a request.
+type FingerprintData struct {
+	// Request is the HTTP request.
+	Request *http.Request
+
+	// UserAgent is the raw User-Agent string.
+	UserAgent string
+
+	// IP is the visitor's IP address.
+	IP string
+
+	// DeviceID is the optional device ID set by the caller (Options.DeviceID).
+	DeviceID string
+
+	// Keys are the keys from the Config.KeyProvider, if set.
+	// The fingerprint is calculated for the current and previous keys while they are rotated.
+	Keys *keys.Keys
+
+	// Time is the time the fingerprint is calculated for.
+	// It's used to rotate fingerprints and might lie in the past to look up sessions of the previous period.
+	Time time.Time
+}
+
+// Fingerprinter calculates fingerprints used as the visitor ID.
+// Fingerprints must be stable within a rotation period for the same visitor, so that sessions can be looked up.
+// The Tracker checks the fingerprint for the current time and for the maximum session age,
+// so that sessions continue when the rotation period changes.
+type Fingerprinter interface {
+	// Fingerprint returns the fingerprint for given data.
+	Fingerprint(FingerprintData) uint64
+}
+
+// SipHashFingerprinter is the default Fingerprinter.
+// It hashes the User-Agent, IP, salt, and rotation period using SipHash,
+// optionally including client hints, the Accept-Language header, and a device ID.
+// The salt and keys are overridden by the FingerprintData.Keys if set.
+type SipHashFingerprinter struct {
+	// Salt is added to the fingerprint.
+	Salt string
+
+	// Key0 and Key1 are the SipHash keys.
+	Key0, Key1 uint64
+
+	// Rotation sets how often fingerprints change.
+	Rotation FingerprintRotation
+
+	// ClientHints includes the User-Agent client hint headers (like Sec-CH-UA).
+	ClientHints bool
+
+	// AcceptLanguage includes the Accept-Language header.
+	AcceptLanguage bool
+
+	// TruncateIPv6 truncates IPv6 addresses to their /64 prefix,
+	// so that fingerprints are not affected by privacy extensions changing the interface identifier.
+	TruncateIPv6 bool
+
+	// IgnoreDeviceID ignores the device ID set by the caller.
+	IgnoreDeviceID bool
+}
+
+// Fingerprint implements the Fingerprinter interface.
+func (fingerprinter *SipHashFingerprinter) Fingerprint(data FingerprintData) uint64 {
+	salt, key0, key1 := fingerprinter.Salt, fingerprinter.Key0, fingerprinter.Key1
+
+	if data.Keys != nil {
+		salt, key0, key1 = data.Keys.Salt, data.Keys.Key0, data.Keys.Key1
+	}
+
+	var sb strings.Builder
+	sb.WriteString(data.UserAgent)
+
+	if fingerprinter.ClientHints && data.Request != nil {
+		for _, header := range fingerprintClientHints {
+			sb.WriteString(data.Request.Header.Get(header))
+		}
+	}
+
+	sb.WriteString(fingerprinter.ip(data.IP))
+	sb.WriteString(salt)
+	sb.WriteString(fingerprinter.period(data.Time))
+
+	if fingerprinter.AcceptLanguage && data.Request != nil {
+		sb.WriteString(data.Request.Header.Get("Accept-Language"))
+	}
+
+	if !fingerprinter.IgnoreDeviceID {
+		sb.WriteString(data.DeviceID)
+	}
+
+	return siphash.Hash(key0, key1, []byte(sb.String()))
+}
+
+func (fingerprinter *SipHashFingerprinter) ip(ip string) string {
+	if fingerprinter.TruncateIPv6 {
+		if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+			return parsed.Mask(net.CIDRMask(64, 128)).String()
+		}
+	}
+
+	return ip
+}
+
+func (fingerprinter *SipHashFingerprinter) period(t time.Time) string {
+	switch fingerprinter.Rotation {
+	case FingerprintRotationHourly:
+		return t.Format("2006010215")
+	case FingerprintRotationWeekly:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%dW%02d", year, week)
+	default:
+		return t.Format("20060102")
+	}
+}
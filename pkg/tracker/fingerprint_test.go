@@ -0,0 +1,56 @@
+package tracker
+
+import (
+	"github.com/dchest/siphash"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/keys"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSipHashFingerprinter_Fingerprint(t *testing.T) {
+	now := time.Date(2024, 6, 3, 10, 30, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("Sec-CH-UA", `"Chromium";v="124"`)
+	req.Header.Set("Accept-Language", "de-CH")
+	fingerprinter := &SipHashFingerprinter{Salt: "salt", Key0: 1, Key1: 2}
+	data := FingerprintData{Request: req, UserAgent: userAgent, IP: "81.2.69.142", Time: now}
+	fingerprint := fingerprinter.Fingerprint(data)
+	assert.Equal(t, siphash.Hash(1, 2, []byte(userAgent+"81.2.69.142salt20240603")), fingerprint)
+	assert.Equal(t, siphash.Hash(3, 4, []byte(userAgent+"81.2.69.142other20240603")), fingerprinter.Fingerprint(FingerprintData{
+		UserAgent: userAgent,
+		IP:        "81.2.69.142",
+		Keys:      &keys.Keys{Salt: "other", Key0: 3, Key1: 4},
+		Time:      now,
+	}))
+	assert.Equal(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Hour)}))
+	assert.NotEqual(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Hour * 24)}))
+	data.DeviceID = "device"
+	assert.NotEqual(t, fingerprint, fingerprinter.Fingerprint(data))
+	fingerprinter.IgnoreDeviceID = true
+	assert.Equal(t, fingerprint, fingerprinter.Fingerprint(data))
+	fingerprinter.ClientHints = true
+	withClientHints := fingerprinter.Fingerprint(data)
+	assert.NotEqual(t, fingerprint, withClientHints)
+	fingerprinter.AcceptLanguage = true
+	assert.NotEqual(t, withClientHints, fingerprinter.Fingerprint(data))
+
+	fingerprinter = &SipHashFingerprinter{Salt: "salt", Rotation: FingerprintRotationHourly}
+	fingerprint = fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now})
+	assert.Equal(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Minute * 20)}))
+	assert.NotEqual(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Minute * 30)}))
+
+	fingerprinter = &SipHashFingerprinter{Salt: "salt", Rotation: FingerprintRotationWeekly}
+	fingerprint = fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now})
+	assert.Equal(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Hour * 24 * 6)}))
+	assert.NotEqual(t, fingerprint, fingerprinter.Fingerprint(FingerprintData{UserAgent: userAgent, IP: "81.2.69.142", Time: now.Add(time.Hour * 24 * 7)}))
+
+	fingerprinter = &SipHashFingerprinter{Salt: "salt"}
+	assert.NotEqual(t, fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:2:a::1", Time: now}), fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:2:b::2", Time: now}))
+	fingerprinter.TruncateIPv6 = true
+	assert.Equal(t, fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:2:a::1", Time: now}), fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:2:b::2", Time: now}))
+	assert.NotEqual(t, fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:2:a::1", Time: now}), fingerprinter.Fingerprint(FingerprintData{IP: "2001:db8:1:3:a::1", Time: now}))
+	assert.NotEqual(t, fingerprinter.Fingerprint(FingerprintData{IP: "81.2.69.142", Time: now}), fingerprinter.Fingerprint(FingerprintData{IP: "81.2.69.143", Time: now}))
+}
@@ -23,6 +23,7 @@ const (
 )
 
 // GeoDB maps IPs to their geological location based on MaxMinds GeoLite2 or GeoIP2 database.
+// It implements the GeoLocator interface and can be used with any compatible city database (like DB-IP Lite) through UpdateFromFile.
 type GeoDB struct {
 	licenseKey   string
 	downloadPath string
@@ -53,13 +54,14 @@ func NewGeoDB(licenseKey, downloadPath, downloadURL string) (*GeoDB, error) {
 	return geoDB, nil
 }
 
-// GetLocation looks up the country code, subdivision (region), and city for given IP.
+// GetLocation implements the GeoLocator interface.
+// It looks up the country code, subdivision (region), and city for given IP.
 // If the IP is invalid it will return an empty string.
 // The country code is returned in lowercase.
 func (db *GeoDB) GetLocation(ip string) (string, string, string) {
 	parsedIP := net.ParseIP(ip)
 
-	if parsedIP == nil {
+	if db == nil || parsedIP == nil {
 		return "", "", ""
 	}
 
@@ -83,6 +85,10 @@ func (db *GeoDB) GetLocation(ip string) (string, string, string) {
 	db.m.RLock()
 	defer db.m.RUnlock()
 
+	if db.db == nil {
+		return "", "", ""
+	}
+
 	if err := db.db.Lookup(parsedIP, &record); err != nil {
 		return "", "", ""
 	}
@@ -100,6 +106,36 @@ func (db *GeoDB) GetLocation(ip string) (string, string, string) {
 	return strings.ToLower(record.Country.ISOCode), subdivision, record.City.Names.En
 }
 
+// GetTimezone implements the TimezoneLocator interface.
+// It looks up the IANA time zone (like "Europe/Berlin") for given IP from the location of the city record.
+// If the IP is invalid or the database doesn't contain a time zone it will return an empty string.
+func (db *GeoDB) GetTimezone(ip string) string {
+	parsedIP := net.ParseIP(ip)
+
+	if db == nil || parsedIP == nil {
+		return ""
+	}
+
+	record := struct {
+		Location struct {
+			TimeZone string `maxminddb:"time_zone"`
+		} `maxminddb:"location"`
+	}{}
+
+	db.m.RLock()
+	defer db.m.RUnlock()
+
+	if db.db == nil {
+		return ""
+	}
+
+	if err := db.db.Lookup(parsedIP, &record); err != nil {
+		return ""
+	}
+
+	return record.Location.TimeZone
+}
+
 // Update downloads and unpacks the MaxMind GeoLite2 database.
 func (db *GeoDB) Update() error {
 	if err := db.download(); err != nil {
@@ -171,12 +207,31 @@ func (db *GeoDB) unpackAndUpdate() error {
 	defer func() {
 		_ = file.Close()
 	}()
-	gzipFile, err := gzip.NewReader(file)
+	data, err := extractDB(file)
+
+	if err != nil {
+		return err
+	}
+
+	db.m.Lock()
+	defer db.m.Unlock()
+	geoDB, err := maxminddb.FromBytes(data)
 
 	if err != nil {
 		return err
 	}
 
+	db.db = geoDB
+	return nil
+}
+
+func extractDB(in io.Reader) ([]byte, error) {
+	gzipFile, err := gzip.NewReader(in)
+
+	if err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		_ = gzipFile.Close()
 	}()
@@ -189,26 +244,17 @@ func (db *GeoDB) unpackAndUpdate() error {
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return err
+			return nil, err
 		}
 
 		if filepath.Base(header.Name) == geoLite2Filename {
 			if _, err := io.Copy(&out, r); err != nil {
-				return err
+				return nil, err
 			}
 
 			break
 		}
 	}
 
-	db.m.Lock()
-	defer db.m.Unlock()
-	geoDB, err := maxminddb.FromBytes(out.Bytes())
-
-	if err != nil {
-		return err
-	}
-
-	db.db = geoDB
-	return nil
+	return out.Bytes(), nil
 }
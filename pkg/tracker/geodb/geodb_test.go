@@ -31,3 +31,12 @@ func TestGeoDB_GetLocation(t *testing.T) {
 	assert.Equal(t, "England", region)
 	assert.Equal(t, "London", city)
 }
+
+func TestGeoDB_GetTimezone(t *testing.T) {
+	geoDB, _ := NewGeoDB("", "", "")
+	assert.Empty(t, geoDB.GetTimezone("81.2.69.142"))
+	assert.NoError(t, geoDB.UpdateFromFile("../../../test/GeoIP2-City-Test.mmdb"))
+	assert.Equal(t, "Europe/London", geoDB.GetTimezone("81.2.69.142"))
+	assert.Empty(t, geoDB.GetTimezone("127.0.0.1"))
+	assert.Empty(t, geoDB.GetTimezone("invalid"))
+}
@@ -0,0 +1,170 @@
+package geodb
+
+import (
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// GeoLocator maps IPs to their geological location.
+// GeoDB is the default implementation, but any database can be used by implementing this interface.
+type GeoLocator interface {
+	// GetLocation looks up the country code, subdivision (region), and city for given IP.
+	// The country code must be returned in lowercase and all values must be empty if the IP cannot be found.
+	GetLocation(string) (string, string, string)
+}
+
+// TimezoneLocator is an optional interface for GeoLocators that can look up the IANA time zone for IPs.
+type TimezoneLocator interface {
+	// GetTimezone looks up the IANA time zone (like "Europe/Berlin") for given IP.
+	// It must return an empty string if the IP or time zone cannot be found.
+	GetTimezone(string) string
+}
+
+// Chain implements the GeoLocator interface by looking up the location in a list of GeoLocators in order.
+// The first result containing a country code will be returned.
+// This can be used to fall back to a different database or to override locations by putting a CIDR in front.
+type Chain []GeoLocator
+
+// GetLocation implements the GeoLocator interface.
+func (chain Chain) GetLocation(ip string) (string, string, string) {
+	for _, locator := range chain {
+		if locator == nil {
+			continue
+		}
+
+		if countryCode, region, city := locator.GetLocation(ip); countryCode != "" {
+			return countryCode, region, city
+		}
+	}
+
+	return "", "", ""
+}
+
+// GetTimezone implements the TimezoneLocator interface.
+// It returns the first time zone found by a GeoLocator in the chain that implements the TimezoneLocator interface.
+func (chain Chain) GetTimezone(ip string) string {
+	for _, locator := range chain {
+		if tzLocator, ok := locator.(TimezoneLocator); ok {
+			if timezone := tzLocator.GetTimezone(ip); timezone != "" {
+				return timezone
+			}
+		}
+	}
+
+	return ""
+}
+
+// Location is a fixed location for an IP network used by CIDR.
+type Location struct {
+	// Network is the IP network in CIDR notation, like "10.0.0.0/8" or "2001:db8::/32".
+	Network string
+
+	// CountryCode is the two-letter ISO country code.
+	CountryCode string
+
+	// Region is the subdivision (region).
+	Region string
+
+	// City is the city name.
+	City string
+
+	// Timezone is the optional IANA time zone, like "Europe/Berlin".
+	Timezone string
+}
+
+type cidrLocation struct {
+	prefix      netip.Prefix
+	countryCode string
+	region      string
+	city        string
+	timezone    string
+}
+
+// CIDR implements the GeoLocator interface for a static list of IP networks.
+// This is useful to map internal (corporate) networks, or to override the location for specific networks.
+// The most specific network (longest prefix) is used in case networks overlap.
+type CIDR struct {
+	locations []cidrLocation
+	m         sync.RWMutex
+}
+
+// NewCIDR creates a new CIDR GeoLocator for given locations.
+func NewCIDR(locations []Location) (*CIDR, error) {
+	cidr := new(CIDR)
+
+	if err := cidr.Update(locations); err != nil {
+		return nil, err
+	}
+
+	return cidr, nil
+}
+
+// Update replaces the list of locations.
+// It returns an error if one of the networks is invalid and keeps the previous list.
+func (cidr *CIDR) Update(locations []Location) error {
+	list := make([]cidrLocation, 0, len(locations))
+
+	for _, location := range locations {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(location.Network))
+
+		if err != nil {
+			return err
+		}
+
+		list = append(list, cidrLocation{
+			prefix:      prefix.Masked(),
+			countryCode: strings.ToLower(strings.TrimSpace(location.CountryCode)),
+			region:      strings.TrimSpace(location.Region),
+			city:        strings.TrimSpace(location.City),
+			timezone:    strings.TrimSpace(location.Timezone),
+		})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].prefix.Bits() > list[j].prefix.Bits()
+	})
+	cidr.m.Lock()
+	defer cidr.m.Unlock()
+	cidr.locations = list
+	return nil
+}
+
+// GetLocation implements the GeoLocator interface.
+func (cidr *CIDR) GetLocation(ip string) (string, string, string) {
+	if location := cidr.find(ip); location != nil {
+		return location.countryCode, location.region, location.city
+	}
+
+	return "", "", ""
+}
+
+// GetTimezone implements the TimezoneLocator interface.
+func (cidr *CIDR) GetTimezone(ip string) string {
+	if location := cidr.find(ip); location != nil {
+		return location.timezone
+	}
+
+	return ""
+}
+
+func (cidr *CIDR) find(ip string) *cidrLocation {
+	addr, err := netip.ParseAddr(ip)
+
+	if err != nil {
+		return nil
+	}
+
+	addr = addr.Unmap()
+	cidr.m.RLock()
+	defer cidr.m.RUnlock()
+
+	for i := range cidr.locations {
+		if cidr.locations[i].prefix.Contains(addr) {
+			return &cidr.locations[i]
+		}
+	}
+
+	return nil
+}
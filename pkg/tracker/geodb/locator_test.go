@@ -0,0 +1,62 @@
+package geodb
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCIDR_GetLocation(t *testing.T) {
+	cidr, err := NewCIDR([]Location{
+		{Network: "10.0.0.0/8", CountryCode: "DE", Region: "Hesse", City: "Frankfurt"},
+		{Network: "10.1.0.0/16", CountryCode: "de", Region: "Berlin", City: "Berlin", Timezone: "Europe/Berlin"},
+		{Network: "2001:db8::/32", CountryCode: "us", Region: "New York", City: "New York"},
+	})
+	assert.NoError(t, err)
+	countryCode, region, city := cidr.GetLocation("10.2.3.4")
+	assert.Equal(t, "de", countryCode)
+	assert.Equal(t, "Hesse", region)
+	assert.Equal(t, "Frankfurt", city)
+	countryCode, region, city = cidr.GetLocation("10.1.3.4")
+	assert.Equal(t, "de", countryCode)
+	assert.Equal(t, "Berlin", region)
+	assert.Equal(t, "Berlin", city)
+	assert.Equal(t, "Europe/Berlin", cidr.GetTimezone("10.1.3.4"))
+	assert.Empty(t, cidr.GetTimezone("10.2.3.4"))
+	countryCode, _, _ = cidr.GetLocation("::ffff:10.1.3.4")
+	assert.Equal(t, "de", countryCode)
+	countryCode, _, city = cidr.GetLocation("2001:db8::1")
+	assert.Equal(t, "us", countryCode)
+	assert.Equal(t, "New York", city)
+	countryCode, region, city = cidr.GetLocation("81.2.69.142")
+	assert.Empty(t, countryCode)
+	assert.Empty(t, region)
+	assert.Empty(t, city)
+	countryCode, _, _ = cidr.GetLocation("invalid")
+	assert.Empty(t, countryCode)
+	assert.Error(t, cidr.Update([]Location{{Network: "invalid"}}))
+	countryCode, _, _ = cidr.GetLocation("10.2.3.4")
+	assert.Equal(t, "de", countryCode)
+}
+
+func TestChain_GetLocation(t *testing.T) {
+	geoDB, _ := NewGeoDB("", "", "")
+	assert.NoError(t, geoDB.UpdateFromFile("../../../test/GeoIP2-City-Test.mmdb"))
+	override, err := NewCIDR([]Location{
+		{Network: "81.2.69.0/24", CountryCode: "fr", Region: "Île-de-France", City: "Paris"},
+	})
+	assert.NoError(t, err)
+	emptyGeoDB, _ := NewGeoDB("", "", "")
+	chain := Chain{nil, emptyGeoDB, geoDB}
+	countryCode, region, city := chain.GetLocation("81.2.69.142")
+	assert.Equal(t, "gb", countryCode)
+	assert.Equal(t, "England", region)
+	assert.Equal(t, "London", city)
+	chain = Chain{override, geoDB}
+	countryCode, region, city = chain.GetLocation("81.2.69.142")
+	assert.Equal(t, "fr", countryCode)
+	assert.Equal(t, "Île-de-France", region)
+	assert.Equal(t, "Paris", city)
+	assert.Equal(t, "Europe/London", chain.GetTimezone("81.2.69.142"))
+	countryCode, _, _ = chain.GetLocation("127.0.0.1")
+	assert.Empty(t, countryCode)
+}
@@ -0,0 +1,311 @@
+package geodb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	checksumSuffix   = ".sha256"
+	previousSuffix   = ".previous"
+	tmpSuffix        = ".tmp"
+	defaultTestIP    = "8.8.8.8"
+	maxChecksumBytes = 1024
+	maxArchiveBytes  = 256 * 1024 * 1024
+)
+
+var (
+	// ErrChecksumMismatch is returned if the SHA256 checksum of a downloaded archive does not match the published checksum.
+	ErrChecksumMismatch = errors.New("geodb: checksum mismatch")
+
+	// ErrDatabaseNotFound is returned if the archive does not contain the database file.
+	ErrDatabaseNotFound = errors.New("geodb: database not found in archive")
+
+	// ErrValidationFailed is returned if the test lookup on a new database did not return a location.
+	ErrValidationFailed = errors.New("geodb: test lookup failed")
+
+	// ErrNoPreviousDatabase is returned by Updater.Rollback if there is no previous database to roll back to.
+	ErrNoPreviousDatabase = errors.New("geodb: no previous database")
+
+	// ErrDownloadTooLarge is returned if a download exceeds its size limit (see UpdaterConfig.MaxArchiveSize).
+	ErrDownloadTooLarge = errors.New("geodb: download too large")
+)
+
+// UpdaterConfig is the configuration for the Updater.
+type UpdaterConfig struct {
+	// ChecksumURL is the URL of the SHA256 checksum published next to the archive.
+	// It may contain "LICENSE_KEY" for the license key.
+	// If empty, it will be derived from the GeoDB download URL ("suffix=tar.gz.sha256" for the MaxMind permalink, or ".sha256" appended otherwise).
+	ChecksumURL string
+
+	// SkipChecksum disables the checksum verification for mirrors that don't publish one.
+	SkipChecksum bool
+
+	// TestIP is looked up on a new database before it's put into use. The lookup must return a country.
+	// Defaults to 8.8.8.8.
+	TestIP string
+
+	// MaxArchiveSize is the maximum size of the downloaded archive in bytes.
+	// Defaults to 256 MB.
+	MaxArchiveSize int64
+
+	// Schedule starts calling the function periodically and returns a function to stop it.
+	// Defaults to util.RunAtMidnight. Use Every for a fixed interval or wrap a cron library for cron expressions.
+	Schedule func(func()) context.CancelFunc
+
+	// Logger is used to log failed scheduled updates.
+	Logger *slog.Logger
+}
+
+func (config *UpdaterConfig) validate() {
+	if config.TestIP == "" {
+		config.TestIP = defaultTestIP
+	}
+
+	if config.MaxArchiveSize <= 0 {
+		config.MaxArchiveSize = maxArchiveBytes
+	}
+
+	if config.Schedule == nil {
+		config.Schedule = util.RunAtMidnight
+	}
+
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+}
+
+// Updater keeps a GeoDB up to date.
+// Downloads are verified against the published checksum and the new database is validated by a test lookup,
+// before the reader is swapped. The previously used database file is kept to roll back to.
+// Calls to Update and Rollback are serialized, so scheduled and manual updates don't interfere.
+type Updater struct {
+	db     *GeoDB
+	config UpdaterConfig
+	cancel context.CancelFunc
+	m      sync.Mutex
+	update sync.Mutex
+}
+
+// Every returns an UpdaterConfig.Schedule that calls the function in given interval using util.RunEvery.
+func Every(interval time.Duration) func(func()) context.CancelFunc {
+	return func(f func()) context.CancelFunc {
+		return util.RunEvery(f, interval)
+	}
+}
+
+// NewUpdater creates a new Updater for given GeoDB.
+func NewUpdater(db *GeoDB, config UpdaterConfig) *Updater {
+	config.validate()
+	return &Updater{
+		db:     db,
+		config: config,
+	}
+}
+
+// Start starts updating the GeoDB on schedule.
+// Failed updates are logged and leave the current database in place.
+func (updater *Updater) Start() {
+	updater.Stop()
+	updater.m.Lock()
+	defer updater.m.Unlock()
+	updater.cancel = updater.config.Schedule(func() {
+		if err := updater.Update(); err != nil {
+			updater.config.Logger.Error("error updating GeoDB", "err", err)
+		}
+	})
+}
+
+// Stop stops updating the GeoDB.
+func (updater *Updater) Stop() {
+	updater.m.Lock()
+	defer updater.m.Unlock()
+
+	if updater.cancel != nil {
+		updater.cancel()
+		updater.cancel = nil
+	}
+}
+
+// Update downloads, verifies, and validates the database and swaps it in if all checks pass.
+// The database file in use so far is kept for Rollback.
+func (updater *Updater) Update() error {
+	updater.update.Lock()
+	defer updater.update.Unlock()
+
+	if err := os.MkdirAll(updater.db.downloadPath, 0755); err != nil {
+		return err
+	}
+
+	tarGz, err := updater.get(updater.db.downloadURL, updater.config.MaxArchiveSize)
+
+	if err != nil {
+		return err
+	}
+
+	if !updater.config.SkipChecksum {
+		if err := updater.verifyChecksum(tarGz); err != nil {
+			return err
+		}
+	}
+
+	data, err := extractDB(bytes.NewReader(tarGz))
+
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		return ErrDatabaseNotFound
+	}
+
+	reader, err := updater.validate(data)
+
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(updater.db.downloadPath, geoLite2Filename)
+
+	if err := os.WriteFile(path+tmpSuffix, data, 0644); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if err := os.Rename(path, path+previousSuffix); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(path+tmpSuffix, path); err != nil {
+		return err
+	}
+
+	updater.swap(reader)
+	return nil
+}
+
+// Rollback restores the database that was in use before the last Update.
+func (updater *Updater) Rollback() error {
+	updater.update.Lock()
+	defer updater.update.Unlock()
+	path := filepath.Join(updater.db.downloadPath, geoLite2Filename)
+	data, err := os.ReadFile(path + previousSuffix)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNoPreviousDatabase
+	} else if err != nil {
+		return err
+	}
+
+	reader, err := updater.validate(data)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(path+previousSuffix, path); err != nil {
+		return err
+	}
+
+	updater.swap(reader)
+	return nil
+}
+
+func (updater *Updater) verifyChecksum(tarGz []byte) error {
+	checksumURL := updater.config.ChecksumURL
+
+	if checksumURL == "" {
+		checksumURL = updater.db.downloadURL
+
+		if strings.Contains(checksumURL, "suffix=tar.gz") {
+			checksumURL = strings.Replace(checksumURL, "suffix=tar.gz", "suffix=tar.gz"+checksumSuffix, 1)
+		} else {
+			checksumURL += checksumSuffix
+		}
+	}
+
+	// the file is formatted like sha256sum output: "<checksum>  <filename>"
+	checksum, err := updater.get(checksumURL, maxChecksumBytes)
+
+	if err != nil {
+		return err
+	}
+
+	fields := strings.Fields(string(checksum))
+
+	if len(fields) == 0 {
+		return ErrChecksumMismatch
+	}
+
+	sum := sha256.Sum256(tarGz)
+
+	if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
+
+func (updater *Updater) validate(data []byte) (*maxminddb.Reader, error) {
+	reader, err := maxminddb.FromBytes(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if countryCode, _, _ := (&GeoDB{db: reader}).GetLocation(updater.config.TestIP); countryCode == "" {
+		return nil, ErrValidationFailed
+	}
+
+	return reader, nil
+}
+
+func (updater *Updater) swap(reader *maxminddb.Reader) {
+	updater.db.m.Lock()
+	defer updater.db.m.Unlock()
+	updater.db.db = reader
+}
+
+func (updater *Updater) get(url string, limit int64) ([]byte, error) {
+	resp, err := http.Get(strings.Replace(url, geoLite2LicenseKey, updater.db.licenseKey, 1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geodb: unexpected status code %d", resp.StatusCode)
+	}
+
+	// read one more byte than allowed to tell a body that is too large apart from one that has exactly the limit
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(body)) > limit {
+		return nil, ErrDownloadTooLarge
+	}
+
+	return body, nil
+}
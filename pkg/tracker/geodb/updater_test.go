@@ -0,0 +1,132 @@
+package geodb
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUpdater(t *testing.T) {
+	mmdb, err := os.ReadFile("../../../test/GeoIP2-City-Test.mmdb")
+	assert.NoError(t, err)
+	tarGz := testTarGz(t, mmdb)
+	sum := sha256.Sum256(tarGz)
+	checksum := hex.EncodeToString(sum[:]) + "  GeoLite2-City_20240101.tar.gz\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("license_key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Query().Get("suffix") == "tar.gz.sha256" {
+			_, _ = w.Write([]byte(checksum))
+		} else {
+			_, _ = w.Write(tarGz)
+		}
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	geoDB, err := NewGeoDB("key", "", server.URL+"/?license_key=LICENSE_KEY&suffix=tar.gz")
+	assert.NoError(t, err)
+	geoDB.downloadPath = dir
+	updater := NewUpdater(geoDB, UpdaterConfig{TestIP: "81.2.69.142"})
+	assert.NoError(t, updater.Update())
+	assert.FileExists(t, filepath.Join(dir, geoLite2Filename))
+	assert.NoFileExists(t, filepath.Join(dir, geoLite2Filename+previousSuffix))
+	countryCode, _, city := geoDB.GetLocation("81.2.69.142")
+	assert.Equal(t, "gb", countryCode)
+	assert.Equal(t, "London", city)
+	assert.ErrorIs(t, updater.Rollback(), ErrNoPreviousDatabase)
+	assert.NoError(t, updater.Update())
+	assert.FileExists(t, filepath.Join(dir, geoLite2Filename+previousSuffix))
+
+	// a corrupted download must not replace the database in use
+	checksum = "0000  GeoLite2-City_20240101.tar.gz\n"
+	assert.ErrorIs(t, updater.Update(), ErrChecksumMismatch)
+	countryCode, _, _ = geoDB.GetLocation("81.2.69.142")
+	assert.Equal(t, "gb", countryCode)
+
+	// the test lookup must return a location
+	checksum = hex.EncodeToString(sum[:])
+	updater = NewUpdater(geoDB, UpdaterConfig{TestIP: "127.0.0.1"})
+	assert.ErrorIs(t, updater.Update(), ErrValidationFailed)
+	countryCode, _, _ = geoDB.GetLocation("81.2.69.142")
+	assert.Equal(t, "gb", countryCode)
+
+	updater = NewUpdater(geoDB, UpdaterConfig{TestIP: "81.2.69.142"})
+	assert.NoError(t, updater.Rollback())
+	assert.NoFileExists(t, filepath.Join(dir, geoLite2Filename+previousSuffix))
+	countryCode, _, _ = geoDB.GetLocation("81.2.69.142")
+	assert.Equal(t, "gb", countryCode)
+
+	geoDB.licenseKey = "invalid"
+	assert.Error(t, updater.Update())
+	tarGz = testTarGz(t, nil)
+	sum = sha256.Sum256(tarGz)
+	checksum = hex.EncodeToString(sum[:])
+	geoDB.licenseKey = "key"
+	assert.ErrorIs(t, updater.Update(), ErrDatabaseNotFound)
+}
+
+func TestUpdaterMaxArchiveSize(t *testing.T) {
+	mmdb, err := os.ReadFile("../../../test/GeoIP2-City-Test.mmdb")
+	assert.NoError(t, err)
+	tarGz := testTarGz(t, mmdb)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarGz)
+	}))
+	defer server.Close()
+	geoDB, err := NewGeoDB("key", "", server.URL+"/GeoLite2-City.tar.gz")
+	assert.NoError(t, err)
+	geoDB.downloadPath = t.TempDir()
+	updater := NewUpdater(geoDB, UpdaterConfig{
+		SkipChecksum:   true,
+		TestIP:         "81.2.69.142",
+		MaxArchiveSize: int64(len(tarGz) - 1),
+	})
+	assert.ErrorIs(t, updater.Update(), ErrDownloadTooLarge)
+	assert.NoFileExists(t, filepath.Join(geoDB.downloadPath, geoLite2Filename))
+	updater.config.MaxArchiveSize = int64(len(tarGz))
+	assert.NoError(t, updater.Update())
+}
+
+func TestEvery(t *testing.T) {
+	var calls atomic.Int32
+	cancel := Every(time.Millisecond * 10)(func() {
+		calls.Add(1)
+	})
+	defer cancel()
+	assert.Eventually(t, func() bool {
+		return calls.Load() >= 3
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func testTarGz(t *testing.T, mmdb []byte) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if mmdb != nil {
+		assert.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name: "GeoLite2-City_20240101/" + geoLite2Filename,
+			Mode: 0644,
+			Size: int64(len(mmdb)),
+		}))
+		_, err := tarWriter.Write(mmdb)
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+	return buffer.Bytes()
+}
@@ -69,6 +69,22 @@ func Get(r *http.Request, parser []HeaderParser, allowed []net.IPNet) string {
 	return ip
 }
 
+// Truncate removes the host part of given IP address, keeping the /24 prefix of IPv4 and the /48 prefix of IPv6 addresses.
+// An empty string is returned if the IP address is invalid.
+func Truncate(address string) string {
+	ip := net.ParseIP(address)
+
+	if ip == nil {
+		return ""
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(24, 32)).String()
+	}
+
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
+
 // cleanIP returns the ip without port, if any.
 func cleanIP(ip string) string {
 	if strings.Contains(ip, ":") {
@@ -170,3 +170,26 @@ func TestIsValidIP(t *testing.T) {
 	assert.False(t, isValidIP("0.0.0.0"))
 	assert.True(t, isValidIP("1.2.3.4"))
 }
+
+func TestTruncate(t *testing.T) {
+	input := []string{
+		"65.182.89.102",
+		"::ffff:65.182.89.102",
+		"2001:db8:85a3:1234:5678:8a2e:370:7334",
+		"2001:db8::1",
+		"invalid",
+		"",
+	}
+	expected := []string{
+		"65.182.89.0",
+		"65.182.89.0",
+		"2001:db8:85a3::",
+		"2001:db8::",
+		"",
+		"",
+	}
+
+	for i, in := range input {
+		assert.Equal(t, expected[i], Truncate(in))
+	}
+}
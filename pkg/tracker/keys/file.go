@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileStorage implements the Storage interface using a JSON file.
+// Updates are only synchronized within the process.
+// Use a RedisStorage or only enable the rotation on one instance in case the file is shared.
+type FileStorage struct {
+	path string
+	m    sync.Mutex
+}
+
+// NewFileStorage creates a new FileStorage for given path.
+func NewFileStorage(path string) *FileStorage {
+	return &FileStorage{
+		path: path,
+	}
+}
+
+// Load implements the Storage interface.
+func (storage *FileStorage) Load() (*Set, error) {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+	return storage.load()
+}
+
+// Update implements the Storage interface.
+func (storage *FileStorage) Update(update func(*Set) *Set) error {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+	set, err := storage.load()
+
+	if err != nil {
+		return err
+	}
+
+	set = update(set)
+
+	if set == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(set)
+
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so that other instances never read a partially written file
+	tmp, err := os.CreateTemp(filepath.Dir(storage.path), filepath.Base(storage.path)+".*")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), storage.path)
+}
+
+func (storage *FileStorage) load() (*Set, error) {
+	data, err := os.ReadFile(storage.path)
+
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	set := new(Set)
+
+	if err := json.Unmarshal(data, set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
@@ -0,0 +1,37 @@
+package keys
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileStorage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	storage := NewFileStorage(path)
+	set, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Nil(t, set)
+	rotated := time.Now().UTC().Truncate(time.Second)
+	assert.NoError(t, storage.Update(func(stored *Set) *Set {
+		assert.Nil(t, stored)
+		return &Set{Current: Keys{Salt: "salt", Key0: 1, Key1: 2}, Rotated: rotated}
+	}))
+	assert.NoError(t, storage.Update(func(stored *Set) *Set {
+		assert.NotNil(t, stored)
+		return nil
+	}))
+	set, err = NewFileStorage(path).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, Keys{Salt: "salt", Key0: 1, Key1: 2}, set.Current)
+	assert.Nil(t, set.Previous)
+	assert.True(t, rotated.Equal(set.Rotated))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.NoError(t, os.WriteFile(path, []byte("invalid"), 0600))
+	_, err = storage.Load()
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package keys
+
+import (
+	"errors"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"time"
+)
+
+var (
+	// ErrMismatch is returned in case the keys configured for an instance differ from the stored keys.
+	ErrMismatch = errors.New("configured keys do not match the stored keys")
+)
+
+// Keys are the salt and SipHash keys used to calculate fingerprints.
+type Keys struct {
+	Salt string `json:"salt"`
+	Key0 uint64 `json:"key0"`
+	Key1 uint64 `json:"key1"`
+}
+
+// Set is the current and previous Keys, as well as the time they have been rotated.
+type Set struct {
+	Current  Keys      `json:"current"`
+	Previous *Keys     `json:"previous,omitempty"`
+	Rotated  time.Time `json:"rotated"`
+}
+
+// Provider provides the Keys used to calculate fingerprints.
+type Provider interface {
+	// Keys returns the current Keys and the previous Keys.
+	// The previous Keys must be nil if they have never been rotated or the grace period after the rotation has passed.
+	Keys() (*Keys, *Keys)
+}
+
+// Storage stores the Set shared between instances.
+type Storage interface {
+	// Load returns the stored Set, or nil if none has been stored yet.
+	Load() (*Set, error)
+
+	// Update atomically updates the stored Set.
+	// The function is called with the stored Set (or nil) and returns the Set to store, or nil to leave it unchanged.
+	Update(func(*Set) *Set) error
+}
+
+// Static implements the Provider interface for Keys that never change.
+type Static Keys
+
+// Keys implements the Provider interface.
+func (static *Static) Keys() (*Keys, *Keys) {
+	keys := Keys(*static)
+	return &keys, nil
+}
+
+// NewKeys returns new random Keys.
+func NewKeys() Keys {
+	return Keys{
+		Salt: util.RandString(20),
+		Key0: util.RandUint64(),
+		Key1: util.RandUint64(),
+	}
+}
@@ -0,0 +1,14 @@
+package keys
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStatic_Keys(t *testing.T) {
+	static := Static{Salt: "salt", Key0: 1, Key1: 2}
+	current, previous := static.Keys()
+	assert.Equal(t, Keys{Salt: "salt", Key0: 1, Key1: 2}, *current)
+	assert.Nil(t, previous)
+	assert.NotEqual(t, NewKeys(), NewKeys())
+}
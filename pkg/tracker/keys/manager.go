@@ -0,0 +1,178 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultGracePeriod     = time.Hour * 24
+	defaultRefreshInterval = time.Minute
+)
+
+// ManagerConfig is the configuration for the Manager.
+type ManagerConfig struct {
+	// Storage stores the keys shared between instances (required).
+	Storage Storage
+
+	// Keys are the keys configured for this instance (optional).
+	// They are stored in case the Storage is empty, and NewManager returns ErrMismatch if the stored keys are different.
+	// This is used to make sure all instances use the same keys. Don't set it in case the keys are rotated.
+	Keys *Keys
+
+	// Rotation is the interval to rotate the keys. Keys are not rotated if zero.
+	Rotation time.Duration
+
+	// GracePeriod is the time after a rotation in which the previous keys are still used to look up sessions.
+	// It defaults to 24 hours, which is the maximum length of a session.
+	GracePeriod time.Duration
+
+	// RefreshInterval is the interval to reload the keys from the Storage. It defaults to one minute.
+	RefreshInterval time.Duration
+
+	// Logger is used to log failed reloads and rotations.
+	Logger *slog.Logger
+}
+
+func (config *ManagerConfig) validate() {
+	if config.GracePeriod <= 0 {
+		config.GracePeriod = defaultGracePeriod
+	}
+
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+}
+
+// Manager implements the Provider interface by loading keys from a Storage shared between instances.
+// It periodically reloads the keys in the background and rotates them once the rotation interval has passed.
+// Keys never blocks on the Storage, as it returns the last loaded keys.
+type Manager struct {
+	config ManagerConfig
+	set    atomic.Pointer[Set]
+	cancel context.CancelFunc
+}
+
+// NewManager creates a new Manager for given configuration and starts reloading the keys in the background.
+// The keys are generated and stored in case the Storage is empty.
+// ErrMismatch is returned if ManagerConfig.Keys is set and differs from the stored keys.
+// Call Stop to stop reloading the keys.
+func NewManager(config ManagerConfig) (*Manager, error) {
+	if config.Storage == nil {
+		return nil, errors.New("storage required")
+	}
+
+	config.validate()
+	var set *Set
+	err := config.Storage.Update(func(stored *Set) *Set {
+		if stored != nil {
+			set = stored
+			return nil
+		}
+
+		set = &Set{
+			Current: NewKeys(),
+			Rotated: time.Now().UTC(),
+		}
+
+		if config.Keys != nil {
+			set.Current = *config.Keys
+		}
+
+		return set
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Keys != nil && set.Current != *config.Keys {
+		return nil, ErrMismatch
+	}
+
+	manager := &Manager{
+		config: config,
+	}
+	manager.set.Store(set)
+	manager.rotate()
+	manager.cancel = util.RunEvery(manager.reload, config.RefreshInterval)
+	return manager, nil
+}
+
+// Keys implements the Provider interface.
+func (manager *Manager) Keys() (*Keys, *Keys) {
+	set := manager.set.Load()
+	current := set.Current
+	var previous *Keys
+
+	if set.Previous != nil && time.Since(set.Rotated) < manager.config.GracePeriod {
+		keys := *set.Previous
+		previous = &keys
+	}
+
+	return &current, previous
+}
+
+// Stop stops reloading the keys in the background.
+func (manager *Manager) Stop() {
+	manager.cancel()
+}
+
+func (manager *Manager) reload() {
+	set, err := manager.config.Storage.Load()
+
+	if err != nil {
+		manager.config.Logger.Error("error loading keys", "err", err)
+		return
+	}
+
+	if set != nil {
+		manager.set.Store(set)
+	}
+
+	manager.rotate()
+}
+
+func (manager *Manager) rotate() {
+	if manager.config.Rotation <= 0 || time.Since(manager.set.Load().Rotated) < manager.config.Rotation {
+		return
+	}
+
+	// another instance might have rotated the keys already, so we need to check the stored keys again
+	var rotated *Set
+	err := manager.config.Storage.Update(func(stored *Set) *Set {
+		if stored != nil && time.Since(stored.Rotated) < manager.config.Rotation {
+			rotated = stored
+			return nil
+		}
+
+		previous := manager.set.Load().Current
+
+		if stored != nil {
+			previous = stored.Current
+		}
+
+		rotated = &Set{
+			Current:  NewKeys(),
+			Previous: &previous,
+			Rotated:  time.Now().UTC(),
+		}
+		return rotated
+	})
+
+	if err != nil {
+		manager.config.Logger.Error("error rotating keys", "err", err)
+		return
+	}
+
+	manager.set.Store(rotated)
+}
@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memStorage struct {
+	set *Set
+	m   sync.Mutex
+}
+
+func (storage *memStorage) Load() (*Set, error) {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+
+	if storage.set == nil {
+		return nil, nil
+	}
+
+	set := *storage.set
+	return &set, nil
+}
+
+func (storage *memStorage) Update(update func(*Set) *Set) error {
+	storage.m.Lock()
+	defer storage.m.Unlock()
+	var stored *Set
+
+	if storage.set != nil {
+		set := *storage.set
+		stored = &set
+	}
+
+	if set := update(stored); set != nil {
+		storage.set = set
+	}
+
+	return nil
+}
+
+func TestNewManager(t *testing.T) {
+	_, err := NewManager(ManagerConfig{})
+	assert.Error(t, err)
+	storage := new(memStorage)
+	manager, err := NewManager(ManagerConfig{Storage: storage})
+	assert.NoError(t, err)
+	defer manager.Stop()
+	current, previous := manager.Keys()
+	assert.Len(t, current.Salt, 20)
+	assert.NotZero(t, current.Key0)
+	assert.NotZero(t, current.Key1)
+	assert.Nil(t, previous)
+	other, err := NewManager(ManagerConfig{Storage: storage})
+	assert.NoError(t, err)
+	defer other.Stop()
+	otherCurrent, _ := other.Keys()
+	assert.Equal(t, current, otherCurrent)
+	_, err = NewManager(ManagerConfig{Storage: storage, Keys: &Keys{Salt: "salt", Key0: 1, Key1: 2}})
+	assert.ErrorIs(t, err, ErrMismatch)
+	storage = new(memStorage)
+	fixed, err := NewManager(ManagerConfig{Storage: storage, Keys: &Keys{Salt: "salt", Key0: 1, Key1: 2}})
+	assert.NoError(t, err)
+	defer fixed.Stop()
+	current, _ = fixed.Keys()
+	assert.Equal(t, Keys{Salt: "salt", Key0: 1, Key1: 2}, *current)
+	fixedOther, err := NewManager(ManagerConfig{Storage: storage, Keys: &Keys{Salt: "salt", Key0: 1, Key1: 2}})
+	assert.NoError(t, err)
+	defer fixedOther.Stop()
+}
+
+func TestManager_Keys(t *testing.T) {
+	keys := Keys{Salt: "salt", Key0: 1, Key1: 2}
+	storage := &memStorage{set: &Set{
+		Current: keys,
+		Rotated: time.Now().Add(-time.Hour * 2),
+	}}
+	manager, err := NewManager(ManagerConfig{
+		Storage:         storage,
+		Rotation:        time.Hour,
+		GracePeriod:     time.Minute,
+		RefreshInterval: time.Millisecond * 10,
+	})
+	assert.NoError(t, err)
+	defer manager.Stop()
+	current, previous := manager.Keys()
+	assert.NotEqual(t, keys, *current)
+	assert.NotNil(t, previous)
+	assert.Equal(t, keys, *previous)
+	stored, _ := storage.Load()
+	assert.Equal(t, *current, stored.Current)
+	other, err := NewManager(ManagerConfig{
+		Storage:  storage,
+		Rotation: time.Hour,
+	})
+	assert.NoError(t, err)
+	defer other.Stop()
+	otherCurrent, otherPrevious := other.Keys()
+	assert.Equal(t, current, otherCurrent)
+	assert.Equal(t, previous, otherPrevious)
+
+	// rotated by another instance after the grace period
+	assert.NoError(t, storage.Update(func(*Set) *Set {
+		return &Set{
+			Current:  Keys{Salt: "new", Key0: 3, Key1: 4},
+			Previous: current,
+			Rotated:  time.Now().Add(-time.Minute * 2),
+		}
+	}))
+	assert.Eventually(t, func() bool {
+		current, _ = manager.Keys()
+		return current.Salt == "new"
+	}, time.Second, time.Millisecond*10)
+	current, previous = manager.Keys()
+	assert.Equal(t, Keys{Salt: "new", Key0: 3, Key1: 4}, *current)
+	assert.Nil(t, previous)
+}
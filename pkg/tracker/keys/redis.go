@@ -0,0 +1,92 @@
+package keys
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"github.com/go-redsync/redsync/v4"
+	"github.com/go-redsync/redsync/v4/redis/goredis/v8"
+)
+
+const (
+	defaultRedisKey = "pirsch_keys"
+)
+
+// RedisStorage implements the Storage interface using Redis.
+// Updates are synchronized between instances using a distributed lock.
+type RedisStorage struct {
+	key string
+	rds *redis.Client
+	rs  *redsync.Redsync
+}
+
+// NewRedisStorage creates a new RedisStorage for given key and redis connection.
+// The key defaults to "pirsch_keys" if empty.
+func NewRedisStorage(key string, redisOptions *redis.Options) *RedisStorage {
+	if key == "" {
+		key = defaultRedisKey
+	}
+
+	client := redis.NewClient(redisOptions)
+	return &RedisStorage{
+		key: key,
+		rds: client,
+		rs:  redsync.New(goredis.NewPool(client)),
+	}
+}
+
+// Load implements the Storage interface.
+func (storage *RedisStorage) Load() (*Set, error) {
+	r, err := storage.rds.Get(context.Background(), storage.key).Result()
+
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	set := new(Set)
+
+	if err := json.Unmarshal([]byte(r), set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// Update implements the Storage interface.
+func (storage *RedisStorage) Update(update func(*Set) *Set) error {
+	m := storage.rs.NewMutex(storage.key + "_lock")
+
+	if err := m.Lock(); err != nil {
+		return err
+	}
+
+	defer m.Unlock()
+	set, err := storage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	set = update(set)
+
+	if set == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(set)
+
+	if err != nil {
+		return err
+	}
+
+	return storage.rds.Set(context.Background(), storage.key, data, 0).Err()
+}
+
+// Clear removes the stored keys.
+func (storage *RedisStorage) Clear() error {
+	return storage.rds.Del(context.Background(), storage.key).Err()
+}
@@ -0,0 +1,30 @@
+package keys
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRedisStorage(t *testing.T) {
+	storage := NewRedisStorage("", &redis.Options{
+		Addr: "localhost:6379",
+	})
+	require.NoError(t, storage.Clear())
+	set, err := storage.Load()
+	assert.NoError(t, err)
+	assert.Nil(t, set)
+	assert.NoError(t, storage.Update(func(stored *Set) *Set {
+		assert.Nil(t, stored)
+		return &Set{Current: Keys{Salt: "salt", Key0: 1, Key1: 2}, Rotated: time.Now().UTC()}
+	}))
+	set, err = storage.Load()
+	require.NoError(t, err)
+	require.NotNil(t, set)
+	assert.Equal(t, Keys{Salt: "salt", Key0: 1, Key1: 2}, set.Current)
+	_, err = NewManager(ManagerConfig{Storage: storage, Keys: &Keys{Salt: "other"}})
+	assert.ErrorIs(t, err, ErrMismatch)
+	assert.NoError(t, storage.Clear())
+}
@@ -4,10 +4,23 @@ import (
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
 	"net/http"
 	"net/url"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 )
 
+const (
+	minTimezoneOffset = -14 * 60
+	maxTimezoneOffset = 12 * 60
+	minStatusCode     = 100
+	maxStatusCode     = 599
+
+	maxSessionProperties          = 20
+	maxSessionPropertyKeyLength   = 100
+	maxSessionPropertyValueLength = 200
+)
+
 // Options are optional parameters for page views and events.
 type Options struct {
 	// URL is the full request URL.
@@ -35,8 +48,37 @@ type Options struct {
 	// Usually this is set to the time the request arrives at the Tracker.
 	Time time.Time
 
+	// Timezone is the IANA time zone of the visitor, like "Europe/Berlin".
+	// It can be obtained using Intl.DateTimeFormat().resolvedOptions().timeZone in JavaScript
+	// and takes precedence over the time zone looked up from the GeoDB.
+	Timezone string
+
+	// TimezoneOffset is the offset to UTC in minutes as returned by Date.getTimezoneOffset() in JavaScript.
+	// It's used in case the time zone cannot be determined otherwise.
+	// Leave it nil if the offset is unknown, as an offset of 0 is stored as UTC.
+	TimezoneOffset *int16
+
 	// Tags are optional fields used to break down page views into segments.
 	Tags map[string]string
+
+	// Properties are optional fields that belong to the whole session, like a plan or A/B test variant.
+	// They are merged with the properties of previous page views and events of the session, overriding existing keys.
+	Properties map[string]string
+
+	// UserID is an optional pseudonymous identifier for visitors who have consented to being recognized or are logged in.
+	// It's hashed together with the salt and stored for the session in addition to the visitor ID,
+	// so that new and returning users and retention can be analyzed for them across days.
+	// The salt (Config.Salt) must therefore be stable and the same for all instances.
+	// The hash doesn't depend on the fingerprint keys or the Config.KeyProvider. Leave it empty to keep tracking cookieless.
+	UserID string
+
+	// DeviceID is an optional identifier for the device set by the caller, like an app installation ID.
+	// It's passed to the Config.Fingerprinter and included in the fingerprint by default.
+	DeviceID string
+
+	// StatusCode is the HTTP status code of the page, like 404 for a missing page.
+	// It defaults to 200 and is only stored for page views.
+	StatusCode uint16
 }
 
 func (options *Options) validate(r *http.Request) {
@@ -60,10 +102,26 @@ func (options *Options) validate(r *http.Request) {
 
 	options.Title = util.ShortenString(options.Title, 512)
 	options.Path = util.ShortenString(options.Path, 2000)
+	options.UserID = strings.TrimSpace(options.UserID)
+	options.DeviceID = util.ShortenString(strings.TrimSpace(options.DeviceID), 200)
 
 	if options.Path == "" {
 		options.Path = "/"
 	}
+
+	if options.Timezone != "" {
+		if _, err := time.LoadLocation(options.Timezone); err != nil || len(options.Timezone) > 50 {
+			options.Timezone = ""
+		}
+	}
+
+	if options.StatusCode < minStatusCode || options.StatusCode > maxStatusCode {
+		options.StatusCode = http.StatusOK
+	}
+
+	if options.TimezoneOffset != nil && (*options.TimezoneOffset < minTimezoneOffset || *options.TimezoneOffset > maxTimezoneOffset) {
+		options.TimezoneOffset = nil
+	}
 }
 
 func (options *Options) getTags() ([]string, []string) {
@@ -81,3 +139,40 @@ func (options *Options) getTags() ([]string, []string) {
 
 	return keys, values
 }
+
+// mergeProperties merges the properties into given keys and values, which are not modified.
+// New keys are ignored once the session has reached the maximum number of properties.
+func (options *Options) mergeProperties(keys, values []string) ([]string, []string) {
+	if len(options.Properties) == 0 {
+		return keys, values
+	}
+
+	mergedKeys, mergedValues := make([]string, len(keys), len(keys)+len(options.Properties)), make([]string, len(values), len(keys)+len(options.Properties))
+	copy(mergedKeys, keys)
+	copy(mergedValues, values)
+	newKeys := make([]string, 0, len(options.Properties))
+
+	for k := range options.Properties {
+		newKeys = append(newKeys, k)
+	}
+
+	sort.Strings(newKeys)
+
+	for _, k := range newKeys {
+		v := util.ShortenString(strings.TrimSpace(options.Properties[k]), maxSessionPropertyValueLength)
+		k = util.ShortenString(strings.TrimSpace(k), maxSessionPropertyKeyLength)
+
+		if k == "" || v == "" {
+			continue
+		}
+
+		if i := slices.Index(mergedKeys, k); i > -1 {
+			mergedValues[i] = v
+		} else if len(mergedKeys) < maxSessionProperties {
+			mergedKeys = append(mergedKeys, k)
+			mergedValues = append(mergedValues, v)
+		}
+	}
+
+	return mergedKeys, mergedValues
+}
@@ -1,6 +1,7 @@
 package tracker
 
 import (
+	"fmt"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -42,3 +43,64 @@ func TestOptions_validate(t *testing.T) {
 	assert.Contains(t, v, "value0")
 	assert.Contains(t, v, "value1")
 }
+
+func TestOptions_validateTimezone(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	options := Options{Timezone: "Europe/Berlin", TimezoneOffset: timezoneOffset(-60)}
+	options.validate(req)
+	assert.Equal(t, "Europe/Berlin", options.Timezone)
+	assert.Equal(t, int16(-60), *options.TimezoneOffset)
+	options = Options{Timezone: "Invalid/Timezone", TimezoneOffset: timezoneOffset(1000)}
+	options.validate(req)
+	assert.Empty(t, options.Timezone)
+	assert.Nil(t, options.TimezoneOffset)
+	options = Options{TimezoneOffset: timezoneOffset(0)}
+	options.validate(req)
+	assert.Equal(t, int16(0), *options.TimezoneOffset)
+}
+
+func timezoneOffset(minutes int16) *int16 {
+	return &minutes
+}
+
+func TestOptions_validateStatusCode(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	options := Options{}
+	options.validate(req)
+	assert.Equal(t, uint16(http.StatusOK), options.StatusCode)
+	options = Options{StatusCode: http.StatusNotFound}
+	options.validate(req)
+	assert.Equal(t, uint16(http.StatusNotFound), options.StatusCode)
+	options = Options{StatusCode: 1000}
+	options.validate(req)
+	assert.Equal(t, uint16(http.StatusOK), options.StatusCode)
+}
+
+func TestOptions_mergeProperties(t *testing.T) {
+	options := Options{}
+	keys, values := options.mergeProperties([]string{"plan"}, []string{"free"})
+	assert.Equal(t, []string{"plan"}, keys)
+	assert.Equal(t, []string{"free"}, values)
+	options = Options{Properties: map[string]string{
+		"plan":  "pro",
+		"role":  " admin ",
+		"empty": "",
+	}}
+	existingKeys, existingValues := []string{"plan"}, []string{"free"}
+	keys, values = options.mergeProperties(existingKeys, existingValues)
+	assert.Equal(t, []string{"plan", "role"}, keys)
+	assert.Equal(t, []string{"pro", "admin"}, values)
+	assert.Equal(t, []string{"free"}, existingValues)
+	existingKeys, existingValues = make([]string, maxSessionProperties), make([]string, maxSessionProperties)
+
+	for i := range existingKeys {
+		existingKeys[i] = fmt.Sprintf("key%d", i)
+		existingValues[i] = "value"
+	}
+
+	options = Options{Properties: map[string]string{"key0": "new", "plan": "pro"}}
+	keys, values = options.mergeProperties(existingKeys, existingValues)
+	assert.Len(t, keys, maxSessionProperties)
+	assert.NotContains(t, keys, "plan")
+	assert.Equal(t, "new", values[0])
+}
@@ -0,0 +1,203 @@
+package tracker
+
+import (
+	"container/list"
+	"context"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ip"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ignoreReasonPrefetch  = "prefetch"
+	ignoreReasonPrerender = "prerender"
+	defaultMaxPrerenders  = 10_000
+)
+
+// speculativeHeaders are removed from prerendered requests before they are tracked on activation.
+var speculativeHeaders = []string{
+	"Sec-Purpose",
+	"Purpose",
+	"X-Purpose",
+	"X-Moz",
+}
+
+type prerenderKey struct {
+	clientID    uint64
+	fingerprint uint64
+	path        string
+}
+
+type prerender struct {
+	key     prerenderKey
+	r       *http.Request
+	options Options
+	expires time.Time
+}
+
+// prerenderCache keeps prerendered page views in the order they have been added, up to a maximum number of entries.
+// The oldest entry is removed if the cache is full. It is safe for concurrent use.
+type prerenderCache struct {
+	maxSize int
+	entries map[prerenderKey]*list.Element
+	order   *list.List
+	m       sync.Mutex
+}
+
+func newPrerenderCache(maxSize int) *prerenderCache {
+	if maxSize <= 0 {
+		maxSize = defaultMaxPrerenders
+	}
+
+	return &prerenderCache{
+		maxSize: maxSize,
+		entries: make(map[prerenderKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (cache *prerenderCache) put(p *prerender) {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+
+	if element, found := cache.entries[p.key]; found {
+		cache.order.Remove(element)
+	} else if cache.order.Len() >= cache.maxSize {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*prerender).key)
+	}
+
+	cache.entries[p.key] = cache.order.PushFront(p)
+}
+
+func (cache *prerenderCache) take(key prerenderKey) (*prerender, bool) {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+	element, found := cache.entries[key]
+
+	if !found {
+		return nil, false
+	}
+
+	cache.order.Remove(element)
+	delete(cache.entries, key)
+	return element.Value.(*prerender), true
+}
+
+// expire removes all entries that have expired at given time.
+func (cache *prerenderCache) expire(now time.Time) {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+
+	for element := cache.order.Back(); element != nil; {
+		prev := element.Prev()
+
+		if p := element.Value.(*prerender); now.After(p.expires) {
+			cache.order.Remove(element)
+			delete(cache.entries, p.key)
+		}
+
+		element = prev
+	}
+}
+
+func (cache *prerenderCache) len() int {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+	return cache.order.Len()
+}
+
+// Activate tracks a page view that has been prerendered (Sec-Purpose: prefetch;prerender) at the time the visitor sees it.
+// It must be called from the page once it has been activated (the prerenderingchange event in JavaScript),
+// with the same visitor and Options.Path (or Options.URL) as the prerendered page view.
+// Prerendered page views are only kept if Config.PrerenderTimeout is set and are ignored otherwise.
+// Returns true if a prerendered page view has been found and accepted and false otherwise.
+func (tracker *Tracker) Activate(r *http.Request, clientID uint64, options Options) bool {
+	if tracker.stopped.Load() || tracker.config.PrerenderTimeout <= 0 {
+		return false
+	}
+
+	now := time.Now().UTC()
+	options.validate(r)
+
+	if !options.Time.IsZero() {
+		now = options.Time
+	}
+
+	ipAddress := ip.Get(r, tracker.config.HeaderParser, tracker.config.AllowedProxySubnets)
+	fingerprints := tracker.fingerprints(r, r.UserAgent(), ipAddress, options.DeviceID, now, now.Add(-tracker.config.PrerenderTimeout))
+
+	for _, fingerprint := range fingerprints {
+		if pending, ok := tracker.prerenders.take(prerenderKey{clientID, fingerprint, options.Path}); ok {
+			if now.After(pending.expires) {
+				return false
+			}
+
+			pending.options.Time = now
+			return tracker.PageView(pending.r, clientID, pending.options)
+		}
+	}
+
+	return false
+}
+
+// deferPrerender keeps a prerendered page view until it's activated or Config.PrerenderTimeout has passed.
+// Expired page views are removed in the background (see startPrerenderExpiry).
+func (tracker *Tracker) deferPrerender(now time.Time, clientID uint64, r *http.Request, ipAddress string, options Options) {
+	current, _ := tracker.keys()
+	key := prerenderKey{
+		clientID:    clientID,
+		fingerprint: tracker.fingerprint(r, r.UserAgent(), ipAddress, options.DeviceID, current, now),
+		path:        options.Path,
+	}
+	activation := r.Clone(context.Background())
+
+	for _, header := range speculativeHeaders {
+		activation.Header.Del(header)
+	}
+
+	tracker.prerenders.put(&prerender{
+		key:     key,
+		r:       activation,
+		options: options,
+		expires: now.Add(tracker.config.PrerenderTimeout),
+	})
+}
+
+// startPrerenderExpiry periodically removes prerendered page views that haven't been activated in time.
+func (tracker *Tracker) startPrerenderExpiry() {
+	if tracker.config.PrerenderTimeout > 0 {
+		tracker.cancelPrerenders = util.RunEvery(func() {
+			tracker.prerenders.expire(time.Now().UTC())
+		}, tracker.config.PrerenderTimeout)
+	}
+}
+
+func (tracker *Tracker) stopPrerenderExpiry() {
+	if tracker.cancelPrerenders != nil {
+		tracker.cancelPrerenders()
+	}
+}
+
+// getSpeculativeLoad returns whether the request is a prefetch or prerender based on the Sec-Purpose header.
+// The header is a list of tokens, like "prefetch" or "prefetch;prerender".
+func getSpeculativeLoad(r *http.Request) string {
+	reason := ""
+
+	for _, item := range strings.Split(r.Header.Get("Sec-Purpose"), ",") {
+		for _, token := range strings.Split(item, ";") {
+			switch strings.ToLower(strings.TrimSpace(token)) {
+			case ignoreReasonPrerender:
+				return ignoreReasonPrerender
+			case ignoreReasonPrefetch:
+				reason = ignoreReasonPrefetch
+			}
+		}
+	}
+
+	return reason
+}
@@ -0,0 +1,64 @@
+package tracker
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ip"
+	"net/http"
+)
+
+const (
+	// PrivacyModeTrack tracks requests sending a privacy signal like any other request without flagging them as opted out.
+	// This is the default.
+	PrivacyModeTrack = PrivacyMode(iota)
+
+	// PrivacyModeAggregate doesn't create a session or fingerprint for requests sending a privacy signal.
+	// They are stored as opted-out requests containing the hostname, path, event name, referrer, and UTM parameters only.
+	PrivacyModeAggregate
+
+	// PrivacyModeIgnore ignores requests sending a privacy signal. They are only counted as opted-out requests.
+	PrivacyModeIgnore
+)
+
+const (
+	// GeoPrecisionCity stores the country, region, and city. This is the default.
+	GeoPrecisionCity = GeoPrecision(iota)
+
+	// GeoPrecisionRegion stores the country and region.
+	// The time zone is not looked up from the GeoDB, but only taken from the Options.
+	GeoPrecisionRegion
+
+	// GeoPrecisionCountry stores the country only.
+	// The time zone is not looked up from the GeoDB, but only taken from the Options.
+	GeoPrecisionCountry
+)
+
+// PrivacyMode sets how requests sending Global Privacy Control (Sec-GPC) or Do-Not-Track (DNT) headers are handled.
+type PrivacyMode int
+
+// GeoPrecision sets how precise the location derived from the IP address is.
+type GeoPrecision int
+
+// apply removes the parts of the location that are more precise than the GeoPrecision.
+func (precision GeoPrecision) apply(countryCode, region, city string) (string, string, string) {
+	switch precision {
+	case GeoPrecisionRegion:
+		return countryCode, region, ""
+	case GeoPrecisionCountry:
+		return countryCode, "", ""
+	default:
+		return countryCode, region, city
+	}
+}
+
+// hasPrivacySignal returns whether the request has opted out of tracking using Global Privacy Control or Do-Not-Track.
+func hasPrivacySignal(r *http.Request) bool {
+	return r.Header.Get("Sec-GPC") == "1" || r.Header.Get("DNT") == "1"
+}
+
+// truncateIP truncates given IP address if Config.TruncateIP is set.
+func (tracker *Tracker) truncateIP(ipAddress string) string {
+	if tracker.config.TruncateIP {
+		return ip.Truncate(ipAddress)
+	}
+
+	return ipAddress
+}
@@ -1,152 +1,482 @@
 package referrer
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"golang.org/x/net/html"
+	"log/slog"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	androidAppPrefix       = "android-app://"
-	googlePlayStoreURL     = "https://play.google.com/store/apps/details?id=%s"
-	androidAppCacheMaxSize = 10_000
-	androidAppCacheMaxAge  = time.Hour * 24 * 7
+	androidAppPrefix              = "android-app://"
+	googlePlayStoreURL            = "https://play.google.com/store/apps/details?id=%s"
+	androidAppCacheMaxSize        = 10_000
+	androidAppCacheMaxAge         = time.Hour * 24 * 7
+	androidAppCacheErrorMaxAge    = time.Hour * 24
+	androidAppQueueSize           = 1000
+	androidAppLookupTimeout       = time.Second * 10
+	androidAppCacheSaveInterval   = time.Minute
+	androidAppCacheFilePermission = 0644
 )
 
 var (
-	androidAppCache = newAndroid()
+	androidAppResolver atomic.Pointer[AndroidAppResolver]
+
+	// ErrAndroidAppNotFound is returned by an AndroidAppLookup if the app does not exist.
+	ErrAndroidAppNotFound = errors.New("android app not found")
 )
 
-type androidApp struct {
-	name string
-	icon string
+func init() {
+	androidAppResolver.Store(NewAndroidAppResolver(AndroidAppResolverConfig{}))
+}
+
+// AndroidApp is the name and icon of an Android app.
+type AndroidApp struct {
+	Name string `json:"name"`
+	Icon string `json:"icon,omitempty"`
 }
 
-type android struct {
-	cache      map[string]androidApp
-	maxSize    int
-	maxAge     time.Duration
-	nextUpdate time.Time
-	m          sync.RWMutex
+// AndroidAppLookup looks up the app for given package name, like "com.Slack".
+type AndroidAppLookup func(ctx context.Context, packageName string) (AndroidApp, error)
+
+// AndroidAppResolverConfig is the configuration for an AndroidAppResolver.
+type AndroidAppResolverConfig struct {
+	// Lookup is the function used to resolve unknown apps (GooglePlayLookup by default).
+	Lookup AndroidAppLookup
+
+	// DisableNetwork disables the Lookup, so that apps are only resolved from the Seed and the CacheFile.
+	// Use this for air-gapped deployments.
+	DisableNetwork bool
+
+	// Seed are apps that are known upfront (AndroidAppSeed by default).
+	// They are still looked up to get the icon, but the seeded name is used until the lookup succeeded or if it fails.
+	Seed map[string]AndroidApp
+
+	// CacheFile is the optional path of a JSON file to persist resolved apps.
+	CacheFile string
+
+	// MaxSize is the maximum number of cached apps (10.000 by default).
+	MaxSize int
+
+	// MaxAge is the time a resolved app is cached before it is looked up again (7 days by default).
+	MaxAge time.Duration
+
+	// ErrorMaxAge is the time a failed lookup is cached before it is retried (1 day by default).
+	ErrorMaxAge time.Duration
+
+	// QueueSize is the maximum number of pending lookups (1000 by default). Further lookups are dropped.
+	QueueSize int
+
+	// LookupTimeout is the timeout for a single lookup (10 seconds by default).
+	LookupTimeout time.Duration
+
+	// SaveInterval is the interval in which the CacheFile is written if it changed (1 minute by default).
+	SaveInterval time.Duration
+
+	// Logger is the log/slog instance used for errors.
+	Logger *slog.Logger
 }
 
-func newAndroid() *android {
-	return &android{
-		cache:      make(map[string]androidApp),
-		maxSize:    androidAppCacheMaxSize,
-		maxAge:     androidAppCacheMaxAge,
-		nextUpdate: time.Now().UTC().Add(androidAppCacheMaxAge),
+func (config *AndroidAppResolverConfig) validate() {
+	if config.Lookup == nil {
+		config.Lookup = GooglePlayLookup
+	}
+
+	if config.Seed == nil {
+		config.Seed = AndroidAppSeed
+	}
+
+	if config.MaxSize <= 0 {
+		config.MaxSize = androidAppCacheMaxSize
 	}
+
+	if config.MaxAge <= 0 {
+		config.MaxAge = androidAppCacheMaxAge
+	}
+
+	if config.ErrorMaxAge <= 0 {
+		config.ErrorMaxAge = androidAppCacheErrorMaxAge
+	}
+
+	if config.QueueSize <= 0 {
+		config.QueueSize = androidAppQueueSize
+	}
+
+	if config.LookupTimeout <= 0 {
+		config.LookupTimeout = androidAppLookupTimeout
+	}
+
+	if config.SaveInterval <= 0 {
+		config.SaveInterval = androidAppCacheSaveInterval
+	}
+
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+}
+
+// AndroidAppResolver resolves Android app referrers to the app name and icon.
+// Unknown apps are looked up in the background, so resolving never blocks.
+// The app is therefore only returned for referrers after the lookup has finished.
+type AndroidAppResolver struct {
+	config  AndroidAppResolverConfig
+	cache   map[string]androidAppCacheEntry
+	pending map[string]struct{}
+	queue   chan string
+	done    chan struct{}
+	start   sync.Once
+	stop    sync.Once
+	dirty   bool
+	m       sync.RWMutex
+	saveM   sync.Mutex
 }
 
-func (cache *android) get(referrer string) (string, string) {
-	packageName := referrer[len(androidAppPrefix):]
+type androidAppCacheEntry struct {
+	AndroidApp
+	Expires time.Time `json:"expires"`
+}
 
-	if strings.HasSuffix(packageName, "/") {
-		packageName = packageName[:len(packageName)-1]
+// NewAndroidAppResolver creates a new AndroidAppResolver for given configuration.
+// The CacheFile is loaded if it exists. Expired entries are still used until they have been looked up again.
+func NewAndroidAppResolver(config AndroidAppResolverConfig) *AndroidAppResolver {
+	config.validate()
+	resolver := &AndroidAppResolver{
+		config:  config,
+		cache:   make(map[string]androidAppCacheEntry),
+		pending: make(map[string]struct{}),
+		queue:   make(chan string, config.QueueSize),
+		done:    make(chan struct{}),
 	}
 
-	cache.m.RLock()
-	app, found := cache.cache[packageName]
-	cache.m.RUnlock()
+	if config.CacheFile != "" {
+		if err := resolver.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+			config.Logger.Error("error loading Android app cache", "err", err, "file", config.CacheFile)
+		}
+	}
 
-	if found {
-		return app.name, app.icon
+	return resolver
+}
+
+// SetAndroidAppResolver replaces the AndroidAppResolver used by Get.
+// The previous resolver is not stopped. Passing nil restores the default resolver.
+func SetAndroidAppResolver(resolver *AndroidAppResolver) {
+	if resolver == nil {
+		resolver = NewAndroidAppResolver(AndroidAppResolverConfig{})
 	}
 
-	resp, err := http.Get(fmt.Sprintf(googlePlayStoreURL, packageName))
+	androidAppResolver.Store(resolver)
+}
 
-	if err != nil || resp.StatusCode != http.StatusOK {
-		cache.updateApp(packageName, "", "")
-		return "", ""
+// Resolve returns the app for given package name, like "com.Slack".
+// If the app is unknown or expired, it is queued for a lookup and the cached value (if any) is returned.
+// The Seed is used as a fallback in case the app hasn't been resolved (yet).
+func (resolver *AndroidAppResolver) Resolve(packageName string) (AndroidApp, bool) {
+	resolver.m.RLock()
+	entry, found := resolver.cache[packageName]
+	resolver.m.RUnlock()
+
+	if !found || time.Now().UTC().After(entry.Expires) {
+		resolver.enqueue(packageName)
 	}
 
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	doc, err := html.Parse(resp.Body)
+	if found && entry.Name != "" {
+		return entry.AndroidApp, true
+	}
+
+	if app, found := resolver.config.Seed[packageName]; found {
+		return app, true
+	}
+
+	return entry.AndroidApp, false
+}
+
+// Stop stops the background lookups and saves the CacheFile.
+func (resolver *AndroidAppResolver) Stop() {
+	resolver.stop.Do(func() {
+		close(resolver.done)
+	})
+
+	if err := resolver.Save(); err != nil {
+		resolver.config.Logger.Error("error saving Android app cache", "err", err, "file", resolver.config.CacheFile)
+	}
+}
+
+// Save writes the cache to the CacheFile. It does nothing if no CacheFile is configured.
+func (resolver *AndroidAppResolver) Save() error {
+	if resolver.config.CacheFile == "" {
+		return nil
+	}
+
+	resolver.saveM.Lock()
+	defer resolver.saveM.Unlock()
+	resolver.m.Lock()
+	data, err := json.Marshal(resolver.cache)
+	resolver.dirty = false
+	resolver.m.Unlock()
 
 	if err != nil {
-		cache.updateApp(packageName, "", "")
-		return "", ""
+		return err
 	}
 
-	titleNode := cache.findName(doc)
+	// write to a temporary file first, so that the cache isn't corrupted in case of an error
+	tmp, err := os.CreateTemp(filepath.Dir(resolver.config.CacheFile), filepath.Base(resolver.config.CacheFile)+".*")
 
-	if titleNode == nil {
-		cache.updateApp(packageName, "", "")
-		return "", ""
+	if err != nil {
+		return err
 	}
 
-	appName := cache.findTextNode(titleNode)
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
 
-	if appName == nil {
-		cache.updateApp(packageName, "", "")
-		return "", ""
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
 	}
 
-	icon := ""
-	iconNode := cache.findIcon(doc)
+	if err := os.Chmod(tmp.Name(), androidAppCacheFilePermission); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
 
-	if iconNode != nil {
-		icon = cache.getHTMLAttribute(iconNode, "src")
+	return os.Rename(tmp.Name(), resolver.config.CacheFile)
+}
+
+func (resolver *AndroidAppResolver) get(referrer string) (string, string) {
+	packageName := strings.TrimSuffix(referrer[len(androidAppPrefix):], "/")
+	app, _ := resolver.Resolve(packageName)
+	return app.Name, app.Icon
+}
+
+func (resolver *AndroidAppResolver) enqueue(packageName string) {
+	if resolver.config.DisableNetwork {
+		return
+	}
+
+	resolver.m.Lock()
+	defer resolver.m.Unlock()
+
+	if _, found := resolver.pending[packageName]; found {
+		return
 	}
 
-	cache.updateApp(packageName, appName.Data, icon)
-	return appName.Data, icon
+	select {
+	case <-resolver.done:
+		return
+	default:
+	}
+
+	select {
+	case resolver.queue <- packageName:
+		resolver.pending[packageName] = struct{}{}
+		resolver.start.Do(func() {
+			go resolver.worker()
+		})
+	default:
+		// drop the lookup if the queue is full, it will be retried with the next referrer
+	}
 }
 
-func (cache *android) updateApp(packageName, name, icon string) {
-	cache.m.Lock()
-	defer cache.m.Unlock()
+func (resolver *AndroidAppResolver) worker() {
+	ticker := time.NewTicker(resolver.config.SaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-resolver.done:
+			return
+		case packageName := <-resolver.queue:
+			resolver.lookup(packageName)
+		case <-ticker.C:
+			resolver.m.RLock()
+			dirty := resolver.dirty
+			resolver.m.RUnlock()
+
+			if dirty {
+				if err := resolver.Save(); err != nil {
+					resolver.config.Logger.Error("error saving Android app cache", "err", err, "file", resolver.config.CacheFile)
+				}
+			}
+		}
+	}
+}
+
+func (resolver *AndroidAppResolver) lookup(packageName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resolver.config.LookupTimeout)
+	defer cancel()
+	app, err := resolver.config.Lookup(ctx, packageName)
 	now := time.Now().UTC()
+	resolver.m.Lock()
+	defer resolver.m.Unlock()
+	delete(resolver.pending, packageName)
+
+	if err != nil {
+		if !errors.Is(err, ErrAndroidAppNotFound) {
+			resolver.config.Logger.Debug("error looking up Android app", "err", err, "package", packageName)
+		}
 
-	if len(cache.cache) > cache.maxSize || now.After(cache.nextUpdate) {
-		cache.cache = make(map[string]androidApp)
-		cache.nextUpdate = now.Add(cache.maxAge)
+		// keep the previous app until the lookup succeeds again
+		entry := resolver.cache[packageName]
+		entry.Expires = now.Add(resolver.config.ErrorMaxAge)
+		resolver.set(packageName, entry)
+		return
 	}
 
-	cache.cache[packageName] = androidApp{name, icon}
+	resolver.set(packageName, androidAppCacheEntry{
+		AndroidApp: app,
+		Expires:    now.Add(resolver.config.MaxAge),
+	})
 }
 
-func (cache *android) findName(node *html.Node) *html.Node {
-	if node.Type == html.ElementNode && node.Data == "h1" {
-		return node
+func (resolver *AndroidAppResolver) set(packageName string, entry androidAppCacheEntry) {
+	if _, found := resolver.cache[packageName]; !found && len(resolver.cache) >= resolver.config.MaxSize {
+		resolver.evict()
 	}
 
-	for c := node.FirstChild; c != nil; c = c.NextSibling {
-		if n := cache.findName(c); n != nil {
-			return n
+	resolver.cache[packageName] = entry
+	resolver.dirty = true
+}
+
+func (resolver *AndroidAppResolver) evict() {
+	now := time.Now().UTC()
+	oldest := ""
+	var oldestExpires time.Time
+
+	for packageName, entry := range resolver.cache {
+		if now.After(entry.Expires) {
+			delete(resolver.cache, packageName)
+		} else if oldest == "" || entry.Expires.Before(oldestExpires) {
+			oldest = packageName
+			oldestExpires = entry.Expires
 		}
 	}
 
-	return nil
+	if len(resolver.cache) >= resolver.config.MaxSize {
+		delete(resolver.cache, oldest)
+	}
 }
 
-func (cache *android) findIcon(node *html.Node) *html.Node {
-	if node.Type == html.ElementNode && node.Data == "img" && cache.hasHTMLAttribute(node, "itemprop", "image") {
-		return node
+func (resolver *AndroidAppResolver) load() error {
+	data, err := os.ReadFile(resolver.config.CacheFile)
+
+	if err != nil {
+		return err
 	}
 
-	for c := node.FirstChild; c != nil; c = c.NextSibling {
-		if n := cache.findIcon(c); n != nil {
-			return n
+	cache := make(map[string]androidAppCacheEntry)
+
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return err
+	}
+
+	resolver.m.Lock()
+	defer resolver.m.Unlock()
+
+	for packageName, entry := range cache {
+		if len(resolver.cache) >= resolver.config.MaxSize {
+			break
 		}
+
+		resolver.cache[packageName] = entry
 	}
 
 	return nil
 }
 
-func (cache *android) findTextNode(node *html.Node) *html.Node {
-	if node.Type == html.TextNode {
+// GooglePlayLookup looks up the app name and icon from the Google Play Store.
+func GooglePlayLookup(ctx context.Context, packageName string) (AndroidApp, error) {
+	return lookupGooglePlay(ctx, http.DefaultClient, googlePlayStoreURL, packageName)
+}
+
+// NewGooglePlayLookup returns an AndroidAppLookup that reads the app page from given URL using given client,
+// for example to use a proxy or mirror of the Google Play Store.
+// The URL must contain "%s" for the package name, like "https://play.google.com/store/apps/details?id=%s".
+// The client defaults to http.DefaultClient if nil.
+func NewGooglePlayLookup(url string, client *http.Client) AndroidAppLookup {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return func(ctx context.Context, packageName string) (AndroidApp, error) {
+		return lookupGooglePlay(ctx, client, url, packageName)
+	}
+}
+
+func lookupGooglePlay(ctx context.Context, client *http.Client, url, packageName string) (AndroidApp, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(url, packageName), nil)
+
+	if err != nil {
+		return AndroidApp{}, err
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return AndroidApp{}, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return AndroidApp{}, ErrAndroidAppNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		return AndroidApp{}, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	doc, err := html.Parse(resp.Body)
+
+	if err != nil {
+		return AndroidApp{}, err
+	}
+
+	titleNode := findHTMLNode(doc, func(node *html.Node) bool {
+		return node.Type == html.ElementNode && node.Data == "h1"
+	})
+
+	if titleNode == nil {
+		return AndroidApp{}, ErrAndroidAppNotFound
+	}
+
+	appName := findHTMLNode(titleNode, func(node *html.Node) bool {
+		return node.Type == html.TextNode
+	})
+
+	if appName == nil {
+		return AndroidApp{}, ErrAndroidAppNotFound
+	}
+
+	icon := ""
+	iconNode := findHTMLNode(doc, func(node *html.Node) bool {
+		return node.Type == html.ElementNode && node.Data == "img" && hasHTMLAttribute(node, "itemprop", "image")
+	})
+
+	if iconNode != nil {
+		icon = getHTMLAttribute(iconNode, "src")
+	}
+
+	return AndroidApp{Name: appName.Data, Icon: icon}, nil
+}
+
+func findHTMLNode(node *html.Node, match func(*html.Node) bool) *html.Node {
+	if match(node) {
 		return node
 	}
 
 	for c := node.FirstChild; c != nil; c = c.NextSibling {
-		if n := cache.findTextNode(c); n != nil {
+		if n := findHTMLNode(c, match); n != nil {
 			return n
 		}
 	}
@@ -154,7 +484,7 @@ func (cache *android) findTextNode(node *html.Node) *html.Node {
 	return nil
 }
 
-func (cache *android) hasHTMLAttribute(node *html.Node, key, value string) bool {
+func hasHTMLAttribute(node *html.Node, key, value string) bool {
 	for _, attr := range node.Attr {
 		if attr.Key == key && attr.Val == value {
 			return true
@@ -164,7 +494,7 @@ func (cache *android) hasHTMLAttribute(node *html.Node, key, value string) bool
 	return false
 }
 
-func (cache *android) getHTMLAttribute(node *html.Node, key string) string {
+func getHTMLAttribute(node *html.Node, key string) string {
 	for _, attr := range node.Attr {
 		if attr.Key == key {
 			return attr.Val
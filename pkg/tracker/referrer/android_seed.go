@@ -0,0 +1,41 @@
+package referrer
+
+// AndroidAppSeed is a list of popular Android apps by package name.
+// Their names are used until the app has been looked up, which also provides the icon.
+var AndroidAppSeed = map[string]AndroidApp{
+	"com.android.chrome":                      {Name: "Google Chrome"},
+	"com.brave.browser":                       {Name: "Brave"},
+	"com.discord":                             {Name: "Discord"},
+	"com.duckduckgo.mobile.android":           {Name: "DuckDuckGo"},
+	"com.facebook.katana":                     {Name: "Facebook"},
+	"com.facebook.orca":                       {Name: "Messenger"},
+	"com.google.android.apps.docs":            {Name: "Google Drive"},
+	"com.google.android.apps.magazines":       {Name: "Google News"},
+	"com.google.android.apps.messaging":       {Name: "Google Messages"},
+	"com.google.android.gm":                   {Name: "Gmail"},
+	"com.google.android.googlequicksearchbox": {Name: "Google"},
+	"com.google.android.youtube":              {Name: "YouTube"},
+	"com.instagram.android":                   {Name: "Instagram"},
+	"com.linkedin.android":                    {Name: "LinkedIn"},
+	"com.microsoft.bing":                      {Name: "Microsoft Bing"},
+	"com.microsoft.emmx":                      {Name: "Microsoft Edge"},
+	"com.microsoft.office.outlook":            {Name: "Microsoft Outlook"},
+	"com.microsoft.teams":                     {Name: "Microsoft Teams"},
+	"com.opera.browser":                       {Name: "Opera"},
+	"com.pinterest":                           {Name: "Pinterest"},
+	"com.quora.android":                       {Name: "Quora"},
+	"com.reddit.frontpage":                    {Name: "Reddit"},
+	"com.sec.android.app.sbrowser":            {Name: "Samsung Internet"},
+	"com.Slack":                               {Name: "Slack"},
+	"com.snapchat.android":                    {Name: "Snapchat"},
+	"com.tencent.mm":                          {Name: "WeChat"},
+	"com.twitter.android":                     {Name: "X"},
+	"com.viber.voip":                          {Name: "Viber"},
+	"com.whatsapp":                            {Name: "WhatsApp"},
+	"com.zhiliaoapp.musically":                {Name: "TikTok"},
+	"flipboard.app":                           {Name: "Flipboard"},
+	"jp.naver.line.android":                   {Name: "LINE"},
+	"org.mozilla.firefox":                     {Name: "Firefox"},
+	"org.telegram.messenger":                  {Name: "Telegram"},
+	"org.thoughtcrime.securesms":              {Name: "Signal"},
+}
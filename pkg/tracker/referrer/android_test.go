@@ -1,23 +1,241 @@
 package referrer
 
 import (
+	"context"
+	"errors"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
-func TestAndroidAppCache(t *testing.T) {
+func TestAndroidAppResolver(t *testing.T) {
+	var lookups atomic.Int32
+	resolver := NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup: func(ctx context.Context, packageName string) (AndroidApp, error) {
+			lookups.Add(1)
+			time.Sleep(time.Millisecond * 10)
+
+			if packageName == "com.example.app" {
+				return AndroidApp{Name: "Example", Icon: "https://example.com/icon.png"}, nil
+			}
+
+			return AndroidApp{}, ErrAndroidAppNotFound
+		},
+	})
+	defer resolver.Stop()
 	var wg sync.WaitGroup
 	wg.Add(100)
 
 	for i := 0; i < 100; i++ {
 		go func() {
-			name, icon := androidAppCache.get(androidAppPrefix + "com.Slack")
-			assert.Equal(t, "Slack", name)
-			assert.NotEmpty(t, icon)
+			resolver.Resolve("com.example.app")
+			resolver.Resolve("does-not-exist")
 			wg.Done()
 		}()
 	}
 
 	wg.Wait()
+	assert.Eventually(t, func() bool {
+		_, found := resolver.Resolve("com.example.app")
+		return found
+	}, time.Second, time.Millisecond*10)
+	app, found := resolver.Resolve("com.example.app")
+	assert.True(t, found)
+	assert.Equal(t, "Example", app.Name)
+	assert.Equal(t, "https://example.com/icon.png", app.Icon)
+	assert.Eventually(t, func() bool {
+		return lookups.Load() == 2
+	}, time.Second, time.Millisecond*10)
+	app, found = resolver.Resolve("does-not-exist")
+	assert.False(t, found)
+	assert.Empty(t, app.Name)
+	assert.Equal(t, int32(2), lookups.Load())
+	name, icon := resolver.get(androidAppPrefix + "com.example.app/")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "https://example.com/icon.png", icon)
+}
+
+func TestAndroidAppResolverSeed(t *testing.T) {
+	var lookups atomic.Int32
+	resolver := NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup: func(ctx context.Context, packageName string) (AndroidApp, error) {
+			lookups.Add(1)
+
+			if packageName == "com.Slack" {
+				return AndroidApp{Name: "Slack for Android", Icon: "https://example.com/slack.png"}, nil
+			}
+
+			return AndroidApp{}, ErrAndroidAppNotFound
+		},
+	})
+	defer resolver.Stop()
+	app, found := resolver.Resolve("com.Slack")
+	assert.True(t, found)
+	assert.Equal(t, "Slack", app.Name)
+	assert.Empty(t, app.Icon)
+	app, found = resolver.Resolve("com.pinterest")
+	assert.True(t, found)
+	assert.Equal(t, "Pinterest", app.Name)
+	assert.Eventually(t, func() bool {
+		return lookups.Load() == 2
+	}, time.Second, time.Millisecond*10)
+	assert.Eventually(t, func() bool {
+		app, _ = resolver.Resolve("com.Slack")
+		return app.Icon != ""
+	}, time.Second, time.Millisecond*10)
+	assert.Equal(t, "Slack for Android", app.Name)
+	assert.Equal(t, "https://example.com/slack.png", app.Icon)
+	app, found = resolver.Resolve("com.pinterest")
+	assert.True(t, found)
+	assert.Equal(t, "Pinterest", app.Name)
+	assert.Empty(t, app.Icon)
+}
+
+func TestAndroidAppResolverDisableNetwork(t *testing.T) {
+	var lookups atomic.Int32
+	resolver := NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup: func(ctx context.Context, packageName string) (AndroidApp, error) {
+			lookups.Add(1)
+			return AndroidApp{Name: "Example"}, nil
+		},
+		DisableNetwork: true,
+		Seed: map[string]AndroidApp{
+			"com.example.seed": {Name: "Seed"},
+		},
+	})
+	defer resolver.Stop()
+	app, found := resolver.Resolve("com.example.seed")
+	assert.True(t, found)
+	assert.Equal(t, "Seed", app.Name)
+	_, found = resolver.Resolve("com.Slack")
+	assert.False(t, found)
+	_, found = resolver.Resolve("com.example.app")
+	assert.False(t, found)
+	time.Sleep(time.Millisecond * 20)
+	assert.Zero(t, lookups.Load())
+}
+
+func TestAndroidAppResolverCacheFile(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "android.json")
+	var lookups atomic.Int32
+	lookup := func(ctx context.Context, packageName string) (AndroidApp, error) {
+		lookups.Add(1)
+
+		if packageName == "com.example.error" {
+			return AndroidApp{}, errors.New("timeout")
+		}
+
+		return AndroidApp{Name: packageName}, nil
+	}
+	resolver := NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup:    lookup,
+		CacheFile: cacheFile,
+	})
+	resolver.Resolve("com.example.app")
+	assert.Eventually(t, func() bool {
+		_, found := resolver.Resolve("com.example.app")
+		return found
+	}, time.Second, time.Millisecond*10)
+	resolver.Stop()
+	_, err := os.Stat(cacheFile)
+	assert.NoError(t, err)
+
+	// the app is loaded from disk without a lookup
+	resolver = NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup:    lookup,
+		CacheFile: cacheFile,
+	})
+	app, found := resolver.Resolve("com.example.app")
+	assert.True(t, found)
+	assert.Equal(t, "com.example.app", app.Name)
+	assert.Equal(t, int32(1), lookups.Load())
+
+	// expired apps are still returned and refreshed in the background, failed lookups keep the previous app
+	resolver.m.Lock()
+	resolver.cache["com.example.error"] = androidAppCacheEntry{
+		AndroidApp: AndroidApp{Name: "Error"},
+		Expires:    time.Now().UTC().Add(-time.Minute),
+	}
+	resolver.m.Unlock()
+	app, found = resolver.Resolve("com.example.error")
+	assert.True(t, found)
+	assert.Equal(t, "Error", app.Name)
+	assert.Eventually(t, func() bool {
+		return lookups.Load() == 2
+	}, time.Second, time.Millisecond*10)
+	assert.Eventually(t, func() bool {
+		resolver.m.RLock()
+		defer resolver.m.RUnlock()
+		return resolver.cache["com.example.error"].Expires.After(time.Now().UTC())
+	}, time.Second, time.Millisecond*10)
+	app, found = resolver.Resolve("com.example.error")
+	assert.True(t, found)
+	assert.Equal(t, "Error", app.Name)
+	assert.Equal(t, int32(2), lookups.Load())
+	resolver.Stop()
+
+	// invalid files are ignored
+	assert.NoError(t, os.WriteFile(cacheFile, []byte("invalid"), 0644))
+	resolver = NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup:         lookup,
+		CacheFile:      cacheFile,
+		DisableNetwork: true,
+	})
+	_, found = resolver.Resolve("com.example.app")
+	assert.False(t, found)
+	resolver.Stop()
+}
+
+func TestAndroidAppResolverMaxSize(t *testing.T) {
+	resolver := NewAndroidAppResolver(AndroidAppResolverConfig{MaxSize: 2})
+	now := time.Now().UTC()
+	resolver.m.Lock()
+	resolver.set("a", androidAppCacheEntry{AndroidApp{Name: "a"}, now.Add(time.Hour)})
+	resolver.set("b", androidAppCacheEntry{AndroidApp{Name: "b"}, now.Add(time.Minute)})
+	resolver.set("c", androidAppCacheEntry{AndroidApp{Name: "c"}, now.Add(time.Hour)})
+	assert.Len(t, resolver.cache, 2)
+	assert.Contains(t, resolver.cache, "a")
+	assert.Contains(t, resolver.cache, "c")
+	resolver.set("a", androidAppCacheEntry{AndroidApp{Name: "a"}, now.Add(-time.Minute)})
+	resolver.set("d", androidAppCacheEntry{AndroidApp{Name: "d"}, now.Add(time.Hour)})
+	assert.Len(t, resolver.cache, 2)
+	assert.Contains(t, resolver.cache, "c")
+	assert.Contains(t, resolver.cache, "d")
+	resolver.m.Unlock()
+}
+
+func TestGooglePlayLookup(t *testing.T) {
+	page, err := os.ReadFile("../../../test/google-play-app.html")
+	assert.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("id") {
+		case "com.Slack":
+			_, _ = w.Write(page)
+		case "empty":
+			_, _ = w.Write([]byte("<html><body></body></html>"))
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	lookup := NewGooglePlayLookup(server.URL+"/store/apps/details?id=%s", server.Client())
+	app, err := lookup(context.Background(), "com.Slack")
+	assert.NoError(t, err)
+	assert.Equal(t, "Slack", app.Name)
+	assert.Equal(t, "https://play-lh.googleusercontent.com/mzJpTCsTW_FuR6YqOPaLHrSEVCSJuXzCljdxnCKhVZMcu6EESZBQTCHxMh8slVtnKqo=s48-rw", app.Icon)
+	_, err = lookup(context.Background(), "does-not-exist")
+	assert.ErrorIs(t, err, ErrAndroidAppNotFound)
+	_, err = lookup(context.Background(), "empty")
+	assert.ErrorIs(t, err, ErrAndroidAppNotFound)
+	_, err = lookup(context.Background(), "error")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrAndroidAppNotFound)
 }
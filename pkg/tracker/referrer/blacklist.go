@@ -1,7 +1,7 @@
 package referrer
 
-// Blacklist is a list of referrer keywords to ignore.
-var Blacklist = []string{
+// defaultBlacklist is the list of referrer keywords to ignore by default (see DefaultBlacklist).
+var defaultBlacklist = []string{
 	" and ",
 	" or ",
 	" xor ",
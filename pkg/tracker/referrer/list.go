@@ -2358,4 +2358,3951 @@ var (
 		"zoeken.nl":                                "Zoeken",
 		"zoohoo.cz":                                "Zoohoo",
 	}
+
+	categories = map[string]string{
+		"1.cz":                                    "search",
+		"1881.no":                                 "search",
+		"247realmedia.com":                        "paid",
+		"2gis.ru":                                 "search",
+		"a.mozo.com.au":                           "paid",
+		"abacho.at":                               "search",
+		"abacho.ch":                               "search",
+		"abacho.co.uk":                            "search",
+		"abacho.com":                              "search",
+		"abacho.de":                               "search",
+		"abacho.es":                               "search",
+		"abacho.fr":                               "search",
+		"abacho.it":                               "search",
+		"abcsolk.no":                              "search",
+		"acoon.de":                                "search",
+		"acuityplatform.com":                      "paid",
+		"ad-apac.doubleclick.net":                 "paid",
+		"ad.doubleclick.net":                      "paid",
+		"adadvisor.net":                           "paid",
+		"adform.net":                              "paid",
+		"adfox.ru":                                "paid",
+		"adingo.jp":                               "paid",
+		"adition.com":                             "paid",
+		"adnet.de":                                "paid",
+		"adnxs.com":                               "paid",
+		"adroll.com":                              "paid",
+		"ads.adfox.ru":                            "paid",
+		"ads.google.com":                          "paid",
+		"adspirit.de":                             "paid",
+		"aim.search.aol.com":                      "search",
+		"alexa.com":                               "search",
+		"alicesuche.aol.de":                       "search",
+		"alicesuchet.aol.de":                      "search",
+		"all.by":                                  "search",
+		"alltheweb.com":                           "search",
+		"altavista.com":                           "search",
+		"altavista.de":                            "search",
+		"altavista.fr":                            "search",
+		"amazon.com":                              "search",
+		"an.yandex.ru":                            "paid",
+		"aolbusqueda.aol.com.mx":                  "search",
+		"aolimages.aol.fr":                        "search",
+		"aolrecherche.aol.fr":                     "search",
+		"aolrecherches.aol.fr":                    "search",
+		"aolsearch.aol.co.uk":                     "search",
+		"aolsearch.aol.com":                       "search",
+		"aolsearch.com":                           "search",
+		"api.taboola.com":                         "paid",
+		"apollo.lv/portal/search/":                "search",
+		"apollo7.de":                              "search",
+		"apontador.com.br":                        "search",
+		"app.slack.com":                           "social",
+		"apple.com/maps":                          "search",
+		"ar.search.yahoo.com":                     "search",
+		"ar.yahoo.com":                            "search",
+		"arama.com":                               "search",
+		"arcor.de":                                "search",
+		"ariadna.elmundo.es":                      "search",
+		"arianna.com":                             "search",
+		"arianna.libero.it":                       "search",
+		"ask.co.uk":                               "search",
+		"ask.com":                                 "search",
+		"ask.reference.com":                       "search",
+		"askkids.com":                             "search",
+		"at.indeed.com":                           "search",
+		"at.pinterest.com":                        "social",
+		"at.search.yahoo.com":                     "search",
+		"au.indeed.com":                           "search",
+		"au.pinterest.com":                        "social",
+		"au.search.yahoo.com":                     "search",
+		"au.yahoo.com":                            "search",
+		"away.vk.com":                             "social",
+		"badoo.com":                               "social",
+		"baidu.com":                               "search",
+		"bap.navigator.gmx.net":                   "mail",
+		"bap.navigator.web.de":                    "mail",
+		"basic.messaging.bigpond.com":             "email",
+		"be-fr.altavista.com":                     "search",
+		"be-nl.altavista.com":                     "search",
+		"bebo.com":                                "social",
+		"bidswitch.net":                           "paid",
+		"bing.com":                                "search",
+		"bing.com/images/search":                  "search",
+		"blackplanet.com":                         "social",
+		"blekko.com":                              "search",
+		"blogdigger.com":                          "search",
+		"blogpulse.com":                           "search",
+		"blogs.icerocket.com":                     "search",
+		"blogsearch.google.ac":                    "search",
+		"blogsearch.google.ad":                    "search",
+		"blogsearch.google.ae":                    "search",
+		"blogsearch.google.am":                    "search",
+		"blogsearch.google.as":                    "search",
+		"blogsearch.google.at":                    "search",
+		"blogsearch.google.az":                    "search",
+		"blogsearch.google.ba":                    "search",
+		"blogsearch.google.be":                    "search",
+		"blogsearch.google.bf":                    "search",
+		"blogsearch.google.bg":                    "search",
+		"blogsearch.google.bi":                    "search",
+		"blogsearch.google.bj":                    "search",
+		"blogsearch.google.bs":                    "search",
+		"blogsearch.google.by":                    "search",
+		"blogsearch.google.ca":                    "search",
+		"blogsearch.google.cat":                   "search",
+		"blogsearch.google.cc":                    "search",
+		"blogsearch.google.cd":                    "search",
+		"blogsearch.google.cf":                    "search",
+		"blogsearch.google.cg":                    "search",
+		"blogsearch.google.ch":                    "search",
+		"blogsearch.google.ci":                    "search",
+		"blogsearch.google.cl":                    "search",
+		"blogsearch.google.cm":                    "search",
+		"blogsearch.google.cn":                    "search",
+		"blogsearch.google.co.bw":                 "search",
+		"blogsearch.google.co.ck":                 "search",
+		"blogsearch.google.co.cr":                 "search",
+		"blogsearch.google.co.id":                 "search",
+		"blogsearch.google.co.il":                 "search",
+		"blogsearch.google.co.in":                 "search",
+		"blogsearch.google.co.jp":                 "search",
+		"blogsearch.google.co.ke":                 "search",
+		"blogsearch.google.co.kr":                 "search",
+		"blogsearch.google.co.ls":                 "search",
+		"blogsearch.google.co.ma":                 "search",
+		"blogsearch.google.co.mz":                 "search",
+		"blogsearch.google.co.nz":                 "search",
+		"blogsearch.google.co.th":                 "search",
+		"blogsearch.google.co.tz":                 "search",
+		"blogsearch.google.co.ug":                 "search",
+		"blogsearch.google.co.uk":                 "search",
+		"blogsearch.google.co.uz":                 "search",
+		"blogsearch.google.co.ve":                 "search",
+		"blogsearch.google.co.vi":                 "search",
+		"blogsearch.google.co.za":                 "search",
+		"blogsearch.google.co.zm":                 "search",
+		"blogsearch.google.co.zw":                 "search",
+		"blogsearch.google.com":                   "search",
+		"blogsearch.google.com.af":                "search",
+		"blogsearch.google.com.ag":                "search",
+		"blogsearch.google.com.ai":                "search",
+		"blogsearch.google.com.ar":                "search",
+		"blogsearch.google.com.au":                "search",
+		"blogsearch.google.com.bd":                "search",
+		"blogsearch.google.com.bh":                "search",
+		"blogsearch.google.com.bn":                "search",
+		"blogsearch.google.com.bo":                "search",
+		"blogsearch.google.com.br":                "search",
+		"blogsearch.google.com.by":                "search",
+		"blogsearch.google.com.bz":                "search",
+		"blogsearch.google.com.co":                "search",
+		"blogsearch.google.com.cu":                "search",
+		"blogsearch.google.com.cy":                "search",
+		"blogsearch.google.com.do":                "search",
+		"blogsearch.google.com.ec":                "search",
+		"blogsearch.google.com.eg":                "search",
+		"blogsearch.google.com.et":                "search",
+		"blogsearch.google.com.fj":                "search",
+		"blogsearch.google.com.gh":                "search",
+		"blogsearch.google.com.gi":                "search",
+		"blogsearch.google.com.gt":                "search",
+		"blogsearch.google.com.hk":                "search",
+		"blogsearch.google.com.jm":                "search",
+		"blogsearch.google.com.kh":                "search",
+		"blogsearch.google.com.kw":                "search",
+		"blogsearch.google.com.lb":                "search",
+		"blogsearch.google.com.lc":                "search",
+		"blogsearch.google.com.ly":                "search",
+		"blogsearch.google.com.mt":                "search",
+		"blogsearch.google.com.mx":                "search",
+		"blogsearch.google.com.my":                "search",
+		"blogsearch.google.com.na":                "search",
+		"blogsearch.google.com.nf":                "search",
+		"blogsearch.google.com.ng":                "search",
+		"blogsearch.google.com.ni":                "search",
+		"blogsearch.google.com.np":                "search",
+		"blogsearch.google.com.om":                "search",
+		"blogsearch.google.com.pa":                "search",
+		"blogsearch.google.com.pe":                "search",
+		"blogsearch.google.com.ph":                "search",
+		"blogsearch.google.com.pk":                "search",
+		"blogsearch.google.com.pr":                "search",
+		"blogsearch.google.com.py":                "search",
+		"blogsearch.google.com.qa":                "search",
+		"blogsearch.google.com.sa":                "search",
+		"blogsearch.google.com.sb":                "search",
+		"blogsearch.google.com.sg":                "search",
+		"blogsearch.google.com.sl":                "search",
+		"blogsearch.google.com.sv":                "search",
+		"blogsearch.google.com.tj":                "search",
+		"blogsearch.google.com.tn":                "search",
+		"blogsearch.google.com.tr":                "search",
+		"blogsearch.google.com.tw":                "search",
+		"blogsearch.google.com.ua":                "search",
+		"blogsearch.google.com.uy":                "search",
+		"blogsearch.google.com.vc":                "search",
+		"blogsearch.google.com.vn":                "search",
+		"blogsearch.google.cv":                    "search",
+		"blogsearch.google.cz":                    "search",
+		"blogsearch.google.de":                    "search",
+		"blogsearch.google.dj":                    "search",
+		"blogsearch.google.dk":                    "search",
+		"blogsearch.google.dm":                    "search",
+		"blogsearch.google.dz":                    "search",
+		"blogsearch.google.ee":                    "search",
+		"blogsearch.google.es":                    "search",
+		"blogsearch.google.fi":                    "search",
+		"blogsearch.google.fm":                    "search",
+		"blogsearch.google.fr":                    "search",
+		"blogsearch.google.ga":                    "search",
+		"blogsearch.google.gd":                    "search",
+		"blogsearch.google.ge":                    "search",
+		"blogsearch.google.gf":                    "search",
+		"blogsearch.google.gg":                    "search",
+		"blogsearch.google.gl":                    "search",
+		"blogsearch.google.gm":                    "search",
+		"blogsearch.google.gp":                    "search",
+		"blogsearch.google.gr":                    "search",
+		"blogsearch.google.gy":                    "search",
+		"blogsearch.google.hn":                    "search",
+		"blogsearch.google.hr":                    "search",
+		"blogsearch.google.ht":                    "search",
+		"blogsearch.google.hu":                    "search",
+		"blogsearch.google.ie":                    "search",
+		"blogsearch.google.im":                    "search",
+		"blogsearch.google.io":                    "search",
+		"blogsearch.google.iq":                    "search",
+		"blogsearch.google.is":                    "search",
+		"blogsearch.google.it":                    "search",
+		"blogsearch.google.it.ao":                 "search",
+		"blogsearch.google.je":                    "search",
+		"blogsearch.google.jo":                    "search",
+		"blogsearch.google.kg":                    "search",
+		"blogsearch.google.ki":                    "search",
+		"blogsearch.google.kz":                    "search",
+		"blogsearch.google.la":                    "search",
+		"blogsearch.google.li":                    "search",
+		"blogsearch.google.lk":                    "search",
+		"blogsearch.google.lt":                    "search",
+		"blogsearch.google.lu":                    "search",
+		"blogsearch.google.lv":                    "search",
+		"blogsearch.google.md":                    "search",
+		"blogsearch.google.me":                    "search",
+		"blogsearch.google.mg":                    "search",
+		"blogsearch.google.mk":                    "search",
+		"blogsearch.google.ml":                    "search",
+		"blogsearch.google.mn":                    "search",
+		"blogsearch.google.ms":                    "search",
+		"blogsearch.google.mu":                    "search",
+		"blogsearch.google.mv":                    "search",
+		"blogsearch.google.mw":                    "search",
+		"blogsearch.google.ne":                    "search",
+		"blogsearch.google.nl":                    "search",
+		"blogsearch.google.no":                    "search",
+		"blogsearch.google.nr":                    "search",
+		"blogsearch.google.nu":                    "search",
+		"blogsearch.google.pl":                    "search",
+		"blogsearch.google.pn":                    "search",
+		"blogsearch.google.ps":                    "search",
+		"blogsearch.google.pt":                    "search",
+		"blogsearch.google.ro":                    "search",
+		"blogsearch.google.rs":                    "search",
+		"blogsearch.google.ru":                    "search",
+		"blogsearch.google.rw":                    "search",
+		"blogsearch.google.sc":                    "search",
+		"blogsearch.google.se":                    "search",
+		"blogsearch.google.sh":                    "search",
+		"blogsearch.google.si":                    "search",
+		"blogsearch.google.sk":                    "search",
+		"blogsearch.google.sm":                    "search",
+		"blogsearch.google.sn":                    "search",
+		"blogsearch.google.so":                    "search",
+		"blogsearch.google.st":                    "search",
+		"blogsearch.google.td":                    "search",
+		"blogsearch.google.tg":                    "search",
+		"blogsearch.google.tk":                    "search",
+		"blogsearch.google.tl":                    "search",
+		"blogsearch.google.tm":                    "search",
+		"blogsearch.google.to":                    "search",
+		"blogsearch.google.tt":                    "search",
+		"blogsearch.google.us":                    "search",
+		"blogsearch.google.vg":                    "search",
+		"blogsearch.google.vu":                    "search",
+		"blogsearch.google.ws":                    "search",
+		"bluewin.ch":                              "mail",
+		"br.search.yahoo.com":                     "search",
+		"br.yahoo.com":                            "search",
+		"brisbane.t-online.de":                    "search",
+		"bs.navigator.gmx.net":                    "mail",
+		"bs.navigator.web.de":                     "mail",
+		"bs.serving-sys.com":                      "paid",
+		"busca.orange.es":                         "search",
+		"busca.uol.com.br":                        "search",
+		"buscador.terra.cl":                       "search",
+		"buscador.terra.com.br":                   "search",
+		"buscador.terra.es":                       "search",
+		"business.facebook.com":                   "social",
+		"buzznet.com":                             "social",
+		"ca.pinterest.com":                        "social",
+		"ca.search.yahoo.com":                     "search",
+		"ca.yahoo.com":                            "search",
+		"cade.searchde.yahoo.com":                 "search",
+		"cade.yahoo.com":                          "search",
+		"cas.criteo.com":                          "paid",
+		"cas.jp.as.criteo.com":                    "paid",
+		"casalemedia.com":                         "paid",
+		"cc.bingj.com":                            "search",
+		"cdnx.tribalfusion.com":                   "paid",
+		"cercato.it":                              "search",
+		"cgi.search.biglobe.ne.jp":                "search",
+		"ch.indeed.com":                           "search",
+		"ch.pinterest.com":                        "social",
+		"ch.search.yahoo.com":                     "search",
+		"charter.net":                             "search",
+		"chinese.searchinese.yahoo.com":           "search",
+		"chinese.yahoo.com":                       "search",
+		"cl.pinterest.com":                        "social",
+		"cl.search.yahoo.com":                     "search",
+		"class.hit-parade.com":                    "search",
+		"classmates.com":                          "social",
+		"clck.yandex.by":                          "search",
+		"clck.yandex.com":                         "search",
+		"clck.yandex.ru":                          "search",
+		"clck.yandex.ua":                          "search",
+		"clusty.com":                              "search",
+		"cn.bing.com":                             "search",
+		"cn.search.yahoo.com":                     "search",
+		"cn.yahoo.com":                            "search",
+		"cnn.com":                                 "search",
+		"co.search.yahoo.com":                     "search",
+		"coccoc.com":                              "search",
+		"com.aol.mobile.aolapp":                   "email",
+		"com.earthlink.myearthlink":               "email",
+		"com.facebook.katana":                     "social",
+		"com.google.android.gm":                   "email",
+		"com.google.android.googlequicksearchbox": "search",
+		"com.instagram.android":                   "social",
+		"com.instagram.barcelona":                 "social",
+		"com.laurencedawson.reddit_sync":          "social",
+		"com.laurencedawson.reddit_sync.pro":      "social",
+		"com.linkedin.android":                    "social",
+		"com.mailchimp.mailchimp":                 "email",
+		"com.microsoft.office.outlook":            "email",
+		"com.pinterest":                           "social",
+		"com.slack":                               "social",
+		"com.snapchat.android":                    "social",
+		"com.talklittle.android.tildes":           "social",
+		"com.twitter.android":                     "social",
+		"com.whatsapp":                            "social",
+		"com.yahoo.mobile.client.android.mail":    "email",
+		"crawler.com":                             "search",
+		"cuil.com":                                "search",
+		"daemon-search.com":                       "search",
+		"dalesearch.com":                          "search",
+		"darkoogle.com":                           "search",
+		"dasoertliche.de":                         "search",
+		"de-de.facebook.com":                      "social",
+		"de.indeed.com":                           "search",
+		"de.pinterest.com":                        "social",
+		"de.quora.com":                            "social",
+		"de.search.yahoo.com":                     "search",
+		"de.yahoo.com":                            "search",
+		"delicious.com":                           "social",
+		"deref-gmx.net":                           "mail",
+		"deref-web.de":                            "mail",
+		"deref.gmx.net":                           "mail",
+		"deref.web.de":                            "mail",
+		"developers.facebook.com":                 "social",
+		"digg.com":                                "search",
+		"dir.gigablast.com":                       "search",
+		"disq.us":                                 "social",
+		"disqus.com":                              "social",
+		"dizionario.it.msn.com":                   "search",
+		"dk.pinterest.com":                        "social",
+		"dk.search.yahoo.com":                     "search",
+		"dk.yahoo.com":                            "search",
+		"dmoz.org":                                "search",
+		"dogpile.com":                             "search",
+		"donanimhaber.com":                        "social",
+		"douban.com":                              "social",
+		"dp.g.doubleclick.net":                    "paid",
+		"duckduckgo.com":                          "search",
+		"ducksports.com":                          "search",
+		"e.mail.ru":                               "email",
+		"ecosia.org":                              "search",
+		"edgeservices.bing.com":                   "search",
+		"editors.dmoz.org":                        "search",
+		"email.seznam.cz":                         "email",
+		"email.telstra.com":                       "email",
+		"encrypted.google.com":                    "search",
+		"eniro.se":                                "search",
+		"eo.st":                                   "search",
+		"es-es.facebook.com":                      "social",
+		"es.pinterest.com":                        "social",
+		"es.search.yahoo.com":                     "search",
+		"es.yahoo.com":                            "search",
+		"espanol.searchpanol.yahoo.com":           "search",
+		"espanol.yahoo.com":                       "search",
+		"eu.ixquick.com":                          "search",
+		"eurip.com":                               "search",
+		"euroseek.com":                            "search",
+		"everyclick.com":                          "search",
+		"exalead.com":                             "search",
+		"exalead.fr":                              "search",
+		"excite.co.jp":                            "search",
+		"exmail.qq.com":                           "email",
+		"eyeota.net":                              "paid",
+		"facebook.com":                            "social",
+		"farm.plista.com":                         "paid",
+		"fastbrowsersearch.com":                   "search",
+		"fastweb.it":                              "search",
+		"fb.me":                                   "social",
+		"fi.search.yahoo.com":                     "search",
+		"find.tdc.dk":                             "search",
+		"find.web.aol.com":                        "search",
+		"finderoo.com":                            "search",
+		"fireball.de":                             "search",
+		"firstsfind.com":                          "search",
+		"fixsuche.de":                             "search",
+		"flashtalking.com":                        "paid",
+		"flickr.com":                              "social",
+		"flix.de":                                 "search",
+		"flixster.com":                            "social",
+		"forestle.mobi":                           "search",
+		"forestle.org":                            "search",
+		"forums.whirlpool.net.au":                 "social",
+		"fotolog.com":                             "social",
+		"fr-fr.facebook.com":                      "social",
+		"fr-mg42.mail.yahoo.com":                  "search",
+		"fr.images.search.yahoo.com":              "search",
+		"fr.indeed.com":                           "search",
+		"fr.news.yahoo.com":                       "search",
+		"fr.pinterest.com":                        "social",
+		"fr.search.yahoo.com":                     "search",
+		"fr.style.yahoo.com":                      "search",
+		"fr.yahoo.com":                            "search",
+		"fr2.rpmfind.net":                         "search",
+		"fresh-weather.com":                       "search",
+		"friendfeed.com":                          "search",
+		"friendsreunited.com":                     "social",
+		"friendster.com":                          "social",
+		"gaiaonline.com":                          "social",
+		"gais.cs.ccu.edu.tw":                      "search",
+		"geni.com":                                "social",
+		"geona.net":                               "search",
+		"getpocket.com":                           "social",
+		"gigablast.com":                           "search",
+		"github.com":                              "social",
+		"global.cyworld.com":                      "social",
+		"gnadenmeer.de":                           "search",
+		"go.mail.ru":                              "search",
+		"gomeo.com":                               "search",
+		"google.ac":                               "search",
+		"google.ac/imgres":                        "search",
+		"google.ac/products":                      "search",
+		"google.ad":                               "search",
+		"google.ad/imgres":                        "search",
+		"google.ad/products":                      "search",
+		"google.ae":                               "search",
+		"google.ae/imgres":                        "search",
+		"google.ae/products":                      "search",
+		"google.am":                               "search",
+		"google.am/imgres":                        "search",
+		"google.am/products":                      "search",
+		"google.as":                               "search",
+		"google.as/imgres":                        "search",
+		"google.as/products":                      "search",
+		"google.at":                               "search",
+		"google.at/imgres":                        "search",
+		"google.at/products":                      "search",
+		"google.az":                               "search",
+		"google.az/imgres":                        "search",
+		"google.az/products":                      "search",
+		"google.ba":                               "search",
+		"google.ba/imgres":                        "search",
+		"google.ba/products":                      "search",
+		"google.be":                               "search",
+		"google.be/imgres":                        "search",
+		"google.be/products":                      "search",
+		"google.bf":                               "search",
+		"google.bf/imgres":                        "search",
+		"google.bf/products":                      "search",
+		"google.bg":                               "search",
+		"google.bg/imgres":                        "search",
+		"google.bg/products":                      "search",
+		"google.bi":                               "search",
+		"google.bi/imgres":                        "search",
+		"google.bi/products":                      "search",
+		"google.bj":                               "search",
+		"google.bj/imgres":                        "search",
+		"google.bj/products":                      "search",
+		"google.bs":                               "search",
+		"google.bs/imgres":                        "search",
+		"google.bs/products":                      "search",
+		"google.by":                               "search",
+		"google.by/imgres":                        "search",
+		"google.by/products":                      "search",
+		"google.ca":                               "search",
+		"google.ca/imgres":                        "search",
+		"google.ca/products":                      "search",
+		"google.cat":                              "search",
+		"google.cat/imgres":                       "search",
+		"google.cat/products":                     "search",
+		"google.cc":                               "search",
+		"google.cc/imgres":                        "search",
+		"google.cc/products":                      "search",
+		"google.cd":                               "search",
+		"google.cd/imgres":                        "search",
+		"google.cd/products":                      "search",
+		"google.cf":                               "search",
+		"google.cf/imgres":                        "search",
+		"google.cf/products":                      "search",
+		"google.cg":                               "search",
+		"google.cg/imgres":                        "search",
+		"google.cg/products":                      "search",
+		"google.ch":                               "search",
+		"google.ch/imgres":                        "search",
+		"google.ch/products":                      "search",
+		"google.ci":                               "search",
+		"google.ci/imgres":                        "search",
+		"google.ci/products":                      "search",
+		"google.cl":                               "search",
+		"google.cl/imgres":                        "search",
+		"google.cl/products":                      "search",
+		"google.cm":                               "search",
+		"google.cm/imgres":                        "search",
+		"google.cm/products":                      "search",
+		"google.cn":                               "search",
+		"google.cn/imgres":                        "search",
+		"google.cn/products":                      "search",
+		"google.co.bw":                            "search",
+		"google.co.bw/imgres":                     "search",
+		"google.co.bw/products":                   "search",
+		"google.co.ck":                            "search",
+		"google.co.ck/imgres":                     "search",
+		"google.co.ck/products":                   "search",
+		"google.co.cr":                            "search",
+		"google.co.cr/imgres":                     "search",
+		"google.co.cr/products":                   "search",
+		"google.co.id":                            "search",
+		"google.co.id/imgres":                     "search",
+		"google.co.id/products":                   "search",
+		"google.co.il":                            "search",
+		"google.co.il/imgres":                     "search",
+		"google.co.il/products":                   "search",
+		"google.co.in":                            "search",
+		"google.co.in/imgres":                     "search",
+		"google.co.in/products":                   "search",
+		"google.co.jp":                            "search",
+		"google.co.jp/imgres":                     "search",
+		"google.co.jp/products":                   "search",
+		"google.co.ke":                            "search",
+		"google.co.ke/imgres":                     "search",
+		"google.co.ke/products":                   "search",
+		"google.co.kr":                            "search",
+		"google.co.kr/imgres":                     "search",
+		"google.co.kr/products":                   "search",
+		"google.co.ls":                            "search",
+		"google.co.ls/imgres":                     "search",
+		"google.co.ls/products":                   "search",
+		"google.co.ma":                            "search",
+		"google.co.ma/imgres":                     "search",
+		"google.co.ma/products":                   "search",
+		"google.co.mz":                            "search",
+		"google.co.mz/imgres":                     "search",
+		"google.co.mz/products":                   "search",
+		"google.co.nz":                            "search",
+		"google.co.nz/imgres":                     "search",
+		"google.co.nz/products":                   "search",
+		"google.co.th":                            "search",
+		"google.co.th/imgres":                     "search",
+		"google.co.th/products":                   "search",
+		"google.co.tz":                            "search",
+		"google.co.tz/imgres":                     "search",
+		"google.co.tz/products":                   "search",
+		"google.co.ug":                            "search",
+		"google.co.ug/imgres":                     "search",
+		"google.co.ug/products":                   "search",
+		"google.co.uk":                            "search",
+		"google.co.uk/imgres":                     "search",
+		"google.co.uk/products":                   "search",
+		"google.co.uz":                            "search",
+		"google.co.uz/imgres":                     "search",
+		"google.co.uz/products":                   "search",
+		"google.co.ve":                            "search",
+		"google.co.ve/imgres":                     "search",
+		"google.co.ve/products":                   "search",
+		"google.co.vi":                            "search",
+		"google.co.vi/imgres":                     "search",
+		"google.co.vi/products":                   "search",
+		"google.co.za":                            "search",
+		"google.co.za/imgres":                     "search",
+		"google.co.za/products":                   "search",
+		"google.co.zm":                            "search",
+		"google.co.zm/imgres":                     "search",
+		"google.co.zm/products":                   "search",
+		"google.co.zw":                            "search",
+		"google.co.zw/imgres":                     "search",
+		"google.co.zw/products":                   "search",
+		"google.com":                              "search",
+		"google.com.af":                           "search",
+		"google.com.af/imgres":                    "search",
+		"google.com.af/products":                  "search",
+		"google.com.ag":                           "search",
+		"google.com.ag/imgres":                    "search",
+		"google.com.ag/products":                  "search",
+		"google.com.ai":                           "search",
+		"google.com.ai/imgres":                    "search",
+		"google.com.ai/products":                  "search",
+		"google.com.ar":                           "search",
+		"google.com.ar/imgres":                    "search",
+		"google.com.ar/products":                  "search",
+		"google.com.au":                           "search",
+		"google.com.au/imgres":                    "search",
+		"google.com.au/products":                  "search",
+		"google.com.bd":                           "search",
+		"google.com.bd/imgres":                    "search",
+		"google.com.bd/products":                  "search",
+		"google.com.bh":                           "search",
+		"google.com.bh/imgres":                    "search",
+		"google.com.bh/products":                  "search",
+		"google.com.bn":                           "search",
+		"google.com.bn/imgres":                    "search",
+		"google.com.bn/products":                  "search",
+		"google.com.bo":                           "search",
+		"google.com.bo/imgres":                    "search",
+		"google.com.bo/products":                  "search",
+		"google.com.br":                           "search",
+		"google.com.br/imgres":                    "search",
+		"google.com.br/products":                  "search",
+		"google.com.by":                           "search",
+		"google.com.by/imgres":                    "search",
+		"google.com.by/products":                  "search",
+		"google.com.bz":                           "search",
+		"google.com.bz/imgres":                    "search",
+		"google.com.bz/products":                  "search",
+		"google.com.co":                           "search",
+		"google.com.co/imgres":                    "search",
+		"google.com.co/products":                  "search",
+		"google.com.cu":                           "search",
+		"google.com.cu/imgres":                    "search",
+		"google.com.cu/products":                  "search",
+		"google.com.cy":                           "search",
+		"google.com.cy/imgres":                    "search",
+		"google.com.cy/products":                  "search",
+		"google.com.do":                           "search",
+		"google.com.do/imgres":                    "search",
+		"google.com.do/products":                  "search",
+		"google.com.ec":                           "search",
+		"google.com.ec/imgres":                    "search",
+		"google.com.ec/products":                  "search",
+		"google.com.eg":                           "search",
+		"google.com.eg/imgres":                    "search",
+		"google.com.eg/products":                  "search",
+		"google.com.et":                           "search",
+		"google.com.et/imgres":                    "search",
+		"google.com.et/products":                  "search",
+		"google.com.fj":                           "search",
+		"google.com.fj/imgres":                    "search",
+		"google.com.fj/products":                  "search",
+		"google.com.gh":                           "search",
+		"google.com.gh/imgres":                    "search",
+		"google.com.gh/products":                  "search",
+		"google.com.gi":                           "search",
+		"google.com.gi/imgres":                    "search",
+		"google.com.gi/products":                  "search",
+		"google.com.gt":                           "search",
+		"google.com.gt/imgres":                    "search",
+		"google.com.gt/products":                  "search",
+		"google.com.hk":                           "search",
+		"google.com.hk/imgres":                    "search",
+		"google.com.hk/products":                  "search",
+		"google.com.jm":                           "search",
+		"google.com.jm/imgres":                    "search",
+		"google.com.jm/products":                  "search",
+		"google.com.kh":                           "search",
+		"google.com.kh/imgres":                    "search",
+		"google.com.kh/products":                  "search",
+		"google.com.kw":                           "search",
+		"google.com.kw/imgres":                    "search",
+		"google.com.kw/products":                  "search",
+		"google.com.lb":                           "search",
+		"google.com.lb/imgres":                    "search",
+		"google.com.lb/products":                  "search",
+		"google.com.lc":                           "search",
+		"google.com.lc/imgres":                    "search",
+		"google.com.lc/products":                  "search",
+		"google.com.ly":                           "search",
+		"google.com.ly/imgres":                    "search",
+		"google.com.ly/products":                  "search",
+		"google.com.mt":                           "search",
+		"google.com.mt/imgres":                    "search",
+		"google.com.mt/products":                  "search",
+		"google.com.mx":                           "search",
+		"google.com.mx/imgres":                    "search",
+		"google.com.mx/products":                  "search",
+		"google.com.my":                           "search",
+		"google.com.my/imgres":                    "search",
+		"google.com.my/products":                  "search",
+		"google.com.na":                           "search",
+		"google.com.na/imgres":                    "search",
+		"google.com.na/products":                  "search",
+		"google.com.nf":                           "search",
+		"google.com.nf/imgres":                    "search",
+		"google.com.nf/products":                  "search",
+		"google.com.ng":                           "search",
+		"google.com.ng/imgres":                    "search",
+		"google.com.ng/products":                  "search",
+		"google.com.ni":                           "search",
+		"google.com.ni/imgres":                    "search",
+		"google.com.ni/products":                  "search",
+		"google.com.np":                           "search",
+		"google.com.np/imgres":                    "search",
+		"google.com.np/products":                  "search",
+		"google.com.om":                           "search",
+		"google.com.om/imgres":                    "search",
+		"google.com.om/products":                  "search",
+		"google.com.pa":                           "search",
+		"google.com.pa/imgres":                    "search",
+		"google.com.pa/products":                  "search",
+		"google.com.pe":                           "search",
+		"google.com.pe/imgres":                    "search",
+		"google.com.pe/products":                  "search",
+		"google.com.ph":                           "search",
+		"google.com.ph/imgres":                    "search",
+		"google.com.ph/products":                  "search",
+		"google.com.pk":                           "search",
+		"google.com.pk/imgres":                    "search",
+		"google.com.pk/products":                  "search",
+		"google.com.pr":                           "search",
+		"google.com.pr/imgres":                    "search",
+		"google.com.pr/products":                  "search",
+		"google.com.py":                           "search",
+		"google.com.py/imgres":                    "search",
+		"google.com.py/products":                  "search",
+		"google.com.qa":                           "search",
+		"google.com.qa/imgres":                    "search",
+		"google.com.qa/products":                  "search",
+		"google.com.sa":                           "search",
+		"google.com.sa/imgres":                    "search",
+		"google.com.sa/products":                  "search",
+		"google.com.sb":                           "search",
+		"google.com.sb/imgres":                    "search",
+		"google.com.sb/products":                  "search",
+		"google.com.sg":                           "search",
+		"google.com.sg/imgres":                    "search",
+		"google.com.sg/products":                  "search",
+		"google.com.sl":                           "search",
+		"google.com.sl/imgres":                    "search",
+		"google.com.sl/products":                  "search",
+		"google.com.sv":                           "search",
+		"google.com.sv/imgres":                    "search",
+		"google.com.sv/products":                  "search",
+		"google.com.tj":                           "search",
+		"google.com.tj/imgres":                    "search",
+		"google.com.tj/products":                  "search",
+		"google.com.tn":                           "search",
+		"google.com.tn/imgres":                    "search",
+		"google.com.tn/products":                  "search",
+		"google.com.tr":                           "search",
+		"google.com.tr/imgres":                    "search",
+		"google.com.tr/products":                  "search",
+		"google.com.tw":                           "search",
+		"google.com.tw/imgres":                    "search",
+		"google.com.tw/products":                  "search",
+		"google.com.ua":                           "search",
+		"google.com.ua/imgres":                    "search",
+		"google.com.ua/products":                  "search",
+		"google.com.uy":                           "search",
+		"google.com.uy/imgres":                    "search",
+		"google.com.uy/products":                  "search",
+		"google.com.vc":                           "search",
+		"google.com.vc/imgres":                    "search",
+		"google.com.vc/products":                  "search",
+		"google.com.vn":                           "search",
+		"google.com.vn/imgres":                    "search",
+		"google.com.vn/products":                  "search",
+		"google.com/imgres":                       "search",
+		"google.com/products":                     "search",
+		"google.cv":                               "search",
+		"google.cv/imgres":                        "search",
+		"google.cv/products":                      "search",
+		"google.cz":                               "search",
+		"google.cz/imgres":                        "search",
+		"google.cz/products":                      "search",
+		"google.de":                               "search",
+		"google.de/imgres":                        "search",
+		"google.de/products":                      "search",
+		"google.dj":                               "search",
+		"google.dj/imgres":                        "search",
+		"google.dj/products":                      "search",
+		"google.dk":                               "search",
+		"google.dk/imgres":                        "search",
+		"google.dk/products":                      "search",
+		"google.dm":                               "search",
+		"google.dm/imgres":                        "search",
+		"google.dm/products":                      "search",
+		"google.dodo.com.au":                      "search",
+		"google.dz":                               "search",
+		"google.dz/imgres":                        "search",
+		"google.dz/products":                      "search",
+		"google.ee":                               "search",
+		"google.ee/imgres":                        "search",
+		"google.ee/products":                      "search",
+		"google.es":                               "search",
+		"google.es/imgres":                        "search",
+		"google.es/products":                      "search",
+		"google.fi":                               "search",
+		"google.fi/imgres":                        "search",
+		"google.fi/products":                      "search",
+		"google.fm":                               "search",
+		"google.fm/imgres":                        "search",
+		"google.fm/products":                      "search",
+		"google.fr":                               "search",
+		"google.fr/imgres":                        "search",
+		"google.fr/products":                      "search",
+		"google.ga":                               "search",
+		"google.ga/imgres":                        "search",
+		"google.ga/products":                      "search",
+		"google.gd":                               "search",
+		"google.gd/imgres":                        "search",
+		"google.gd/products":                      "search",
+		"google.ge":                               "search",
+		"google.ge/imgres":                        "search",
+		"google.ge/products":                      "search",
+		"google.gf":                               "search",
+		"google.gf/imgres":                        "search",
+		"google.gf/products":                      "search",
+		"google.gg":                               "search",
+		"google.gg/imgres":                        "search",
+		"google.gg/products":                      "search",
+		"google.gl":                               "search",
+		"google.gl/imgres":                        "search",
+		"google.gl/products":                      "search",
+		"google.gm":                               "search",
+		"google.gm/imgres":                        "search",
+		"google.gm/products":                      "search",
+		"google.gp":                               "search",
+		"google.gp/imgres":                        "search",
+		"google.gp/products":                      "search",
+		"google.gr":                               "search",
+		"google.gr/imgres":                        "search",
+		"google.gr/products":                      "search",
+		"google.gy":                               "search",
+		"google.gy/imgres":                        "search",
+		"google.gy/products":                      "search",
+		"google.hn":                               "search",
+		"google.hn/imgres":                        "search",
+		"google.hn/products":                      "search",
+		"google.hr":                               "search",
+		"google.hr/imgres":                        "search",
+		"google.hr/products":                      "search",
+		"google.ht":                               "search",
+		"google.ht/imgres":                        "search",
+		"google.ht/products":                      "search",
+		"google.hu":                               "search",
+		"google.hu/imgres":                        "search",
+		"google.hu/products":                      "search",
+		"google.ie":                               "search",
+		"google.ie/imgres":                        "search",
+		"google.ie/products":                      "search",
+		"google.im":                               "search",
+		"google.im/imgres":                        "search",
+		"google.im/products":                      "search",
+		"google.interia.pl":                       "search",
+		"google.io":                               "search",
+		"google.io/imgres":                        "search",
+		"google.io/products":                      "search",
+		"google.iq":                               "search",
+		"google.iq/imgres":                        "search",
+		"google.iq/products":                      "search",
+		"google.is":                               "search",
+		"google.is/imgres":                        "search",
+		"google.is/products":                      "search",
+		"google.it":                               "search",
+		"google.it.ao":                            "search",
+		"google.it.ao/imgres":                     "search",
+		"google.it.ao/products":                   "search",
+		"google.it/imgres":                        "search",
+		"google.it/products":                      "search",
+		"google.je":                               "search",
+		"google.je/imgres":                        "search",
+		"google.je/products":                      "search",
+		"google.jo":                               "search",
+		"google.jo/imgres":                        "search",
+		"google.jo/products":                      "search",
+		"google.kg":                               "search",
+		"google.kg/imgres":                        "search",
+		"google.kg/products":                      "search",
+		"google.ki":                               "search",
+		"google.ki/imgres":                        "search",
+		"google.ki/products":                      "search",
+		"google.kz":                               "search",
+		"google.kz/imgres":                        "search",
+		"google.kz/products":                      "search",
+		"google.la":                               "search",
+		"google.la/imgres":                        "search",
+		"google.la/products":                      "search",
+		"google.lens":                             "search",
+		"google.li":                               "search",
+		"google.li/imgres":                        "search",
+		"google.li/products":                      "search",
+		"google.lk":                               "search",
+		"google.lk/imgres":                        "search",
+		"google.lk/products":                      "search",
+		"google.lt":                               "search",
+		"google.lt/imgres":                        "search",
+		"google.lt/products":                      "search",
+		"google.lu":                               "search",
+		"google.lu/imgres":                        "search",
+		"google.lu/products":                      "search",
+		"google.lv":                               "search",
+		"google.lv/imgres":                        "search",
+		"google.lv/products":                      "search",
+		"google.md":                               "search",
+		"google.md/imgres":                        "search",
+		"google.md/products":                      "search",
+		"google.me":                               "search",
+		"google.me/imgres":                        "search",
+		"google.me/products":                      "search",
+		"google.mg":                               "search",
+		"google.mg/imgres":                        "search",
+		"google.mg/products":                      "search",
+		"google.mk":                               "search",
+		"google.mk/imgres":                        "search",
+		"google.mk/products":                      "search",
+		"google.ml":                               "search",
+		"google.ml/imgres":                        "search",
+		"google.ml/products":                      "search",
+		"google.mn":                               "search",
+		"google.mn/imgres":                        "search",
+		"google.mn/products":                      "search",
+		"google.ms":                               "search",
+		"google.ms/imgres":                        "search",
+		"google.ms/products":                      "search",
+		"google.mu":                               "search",
+		"google.mu/imgres":                        "search",
+		"google.mu/products":                      "search",
+		"google.mv":                               "search",
+		"google.mv/imgres":                        "search",
+		"google.mv/products":                      "search",
+		"google.mw":                               "search",
+		"google.mw/imgres":                        "search",
+		"google.mw/products":                      "search",
+		"google.ne":                               "search",
+		"google.ne/imgres":                        "search",
+		"google.ne/products":                      "search",
+		"google.nl":                               "search",
+		"google.nl/imgres":                        "search",
+		"google.nl/products":                      "search",
+		"google.no":                               "search",
+		"google.no/imgres":                        "search",
+		"google.no/products":                      "search",
+		"google.nr":                               "search",
+		"google.nr/imgres":                        "search",
+		"google.nr/products":                      "search",
+		"google.nu":                               "search",
+		"google.nu/imgres":                        "search",
+		"google.nu/products":                      "search",
+		"google.pl":                               "search",
+		"google.pl/imgres":                        "search",
+		"google.pl/products":                      "search",
+		"google.pn":                               "search",
+		"google.pn/imgres":                        "search",
+		"google.pn/products":                      "search",
+		"google.ps":                               "search",
+		"google.ps/imgres":                        "search",
+		"google.ps/products":                      "search",
+		"google.pt":                               "search",
+		"google.pt/imgres":                        "search",
+		"google.pt/products":                      "search",
+		"google.ro":                               "search",
+		"google.ro/imgres":                        "search",
+		"google.ro/products":                      "search",
+		"google.rs":                               "search",
+		"google.rs/imgres":                        "search",
+		"google.rs/products":                      "search",
+		"google.ru":                               "search",
+		"google.ru/imgres":                        "search",
+		"google.ru/products":                      "search",
+		"google.rw":                               "search",
+		"google.rw/imgres":                        "search",
+		"google.rw/products":                      "search",
+		"google.sc":                               "search",
+		"google.sc/imgres":                        "search",
+		"google.sc/products":                      "search",
+		"google.se":                               "search",
+		"google.se/imgres":                        "search",
+		"google.se/products":                      "search",
+		"google.sh":                               "search",
+		"google.sh/imgres":                        "search",
+		"google.sh/products":                      "search",
+		"google.si":                               "search",
+		"google.si/imgres":                        "search",
+		"google.si/products":                      "search",
+		"google.sk":                               "search",
+		"google.sk/imgres":                        "search",
+		"google.sk/products":                      "search",
+		"google.sm":                               "search",
+		"google.sm/imgres":                        "search",
+		"google.sm/products":                      "search",
+		"google.sn":                               "search",
+		"google.sn/imgres":                        "search",
+		"google.sn/products":                      "search",
+		"google.so":                               "search",
+		"google.so/imgres":                        "search",
+		"google.so/products":                      "search",
+		"google.st":                               "search",
+		"google.st/imgres":                        "search",
+		"google.st/products":                      "search",
+		"google.td":                               "search",
+		"google.td/imgres":                        "search",
+		"google.td/products":                      "search",
+		"google.tg":                               "search",
+		"google.tg/imgres":                        "search",
+		"google.tg/products":                      "search",
+		"google.tk":                               "search",
+		"google.tk/imgres":                        "search",
+		"google.tk/products":                      "search",
+		"google.tl":                               "search",
+		"google.tl/imgres":                        "search",
+		"google.tl/products":                      "search",
+		"google.tm":                               "search",
+		"google.tm/imgres":                        "search",
+		"google.tm/products":                      "search",
+		"google.tn":                               "search",
+		"google.to":                               "search",
+		"google.to/imgres":                        "search",
+		"google.to/products":                      "search",
+		"google.tt":                               "search",
+		"google.tt/imgres":                        "search",
+		"google.tt/products":                      "search",
+		"google.us":                               "search",
+		"google.us/imgres":                        "search",
+		"google.us/products":                      "search",
+		"google.vg":                               "search",
+		"google.vg/imgres":                        "search",
+		"google.vg/products":                      "search",
+		"google.vu":                               "search",
+		"google.vu/imgres":                        "search",
+		"google.vu/products":                      "search",
+		"google.ws":                               "search",
+		"google.ws/products":                      "search",
+		"googleads.g.doubleclick.net":             "paid",
+		"googleadservices.com":                    "paid",
+		"googleearth.de":                          "search",
+		"googleearth.fr":                          "search",
+		"googlesyndicatedsearch.com":              "search",
+		"gooofullsearch.com":                      "search",
+		"goyellow.de":                             "search",
+		"gulesider.no":                            "search",
+		"habbo.com":                               "social",
+		"hi5.com":                                 "social",
+		"highbeam.com":                            "search",
+		"hit-parade.com":                          "search",
+		"hk.search.yahoo.com":                     "search",
+		"hk.yahoo.com":                            "search",
+		"hledani.tiscali.cz":                      "search",
+		"hocam.com":                               "social",
+		"holmes.ge":                               "search",
+		"hooseek.com":                             "search",
+		"hotbot.com":                              "search",
+		"html.duckduckgo.com":                     "search",
+		"hyves.nl":                                "social",
+		"ib.adnxs.com":                            "paid",
+		"icq.com":                                 "search",
+		"id.search.yahoo.com":                     "search",
+		"identi.ca":                               "social",
+		"ie.pinterest.com":                        "social",
+		"ie.search.yahoo.com":                     "search",
+		"ie.yahoo.com":                            "search",
+		"ilse.nl":                                 "search",
+		"image.search.naver.com":                  "search",
+		"image.yahoo.cn":                          "search",
+		"images.ask.com":                          "search",
+		"images.google.ac":                        "search",
+		"images.google.ad":                        "search",
+		"images.google.ae":                        "search",
+		"images.google.am":                        "search",
+		"images.google.as":                        "search",
+		"images.google.at":                        "search",
+		"images.google.az":                        "search",
+		"images.google.ba":                        "search",
+		"images.google.be":                        "search",
+		"images.google.bf":                        "search",
+		"images.google.bg":                        "search",
+		"images.google.bi":                        "search",
+		"images.google.bj":                        "search",
+		"images.google.bs":                        "search",
+		"images.google.by":                        "search",
+		"images.google.ca":                        "search",
+		"images.google.cat":                       "search",
+		"images.google.cc":                        "search",
+		"images.google.cd":                        "search",
+		"images.google.cf":                        "search",
+		"images.google.cg":                        "search",
+		"images.google.ch":                        "search",
+		"images.google.ci":                        "search",
+		"images.google.cl":                        "search",
+		"images.google.cm":                        "search",
+		"images.google.cn":                        "search",
+		"images.google.co.bw":                     "search",
+		"images.google.co.ck":                     "search",
+		"images.google.co.cr":                     "search",
+		"images.google.co.id":                     "search",
+		"images.google.co.il":                     "search",
+		"images.google.co.in":                     "search",
+		"images.google.co.jp":                     "search",
+		"images.google.co.ke":                     "search",
+		"images.google.co.kr":                     "search",
+		"images.google.co.ls":                     "search",
+		"images.google.co.ma":                     "search",
+		"images.google.co.mz":                     "search",
+		"images.google.co.nz":                     "search",
+		"images.google.co.th":                     "search",
+		"images.google.co.tz":                     "search",
+		"images.google.co.ug":                     "search",
+		"images.google.co.uk":                     "search",
+		"images.google.co.uz":                     "search",
+		"images.google.co.ve":                     "search",
+		"images.google.co.vi":                     "search",
+		"images.google.co.za":                     "search",
+		"images.google.co.zm":                     "search",
+		"images.google.co.zw":                     "search",
+		"images.google.com":                       "search",
+		"images.google.com.af":                    "search",
+		"images.google.com.ag":                    "search",
+		"images.google.com.ai":                    "search",
+		"images.google.com.ar":                    "search",
+		"images.google.com.au":                    "search",
+		"images.google.com.bd":                    "search",
+		"images.google.com.bh":                    "search",
+		"images.google.com.bn":                    "search",
+		"images.google.com.bo":                    "search",
+		"images.google.com.br":                    "search",
+		"images.google.com.by":                    "search",
+		"images.google.com.bz":                    "search",
+		"images.google.com.co":                    "search",
+		"images.google.com.cu":                    "search",
+		"images.google.com.cy":                    "search",
+		"images.google.com.do":                    "search",
+		"images.google.com.ec":                    "search",
+		"images.google.com.eg":                    "search",
+		"images.google.com.et":                    "search",
+		"images.google.com.fj":                    "search",
+		"images.google.com.gh":                    "search",
+		"images.google.com.gi":                    "search",
+		"images.google.com.gt":                    "search",
+		"images.google.com.hk":                    "search",
+		"images.google.com.jm":                    "search",
+		"images.google.com.kh":                    "search",
+		"images.google.com.kw":                    "search",
+		"images.google.com.lb":                    "search",
+		"images.google.com.lc":                    "search",
+		"images.google.com.ly":                    "search",
+		"images.google.com.mt":                    "search",
+		"images.google.com.mx":                    "search",
+		"images.google.com.my":                    "search",
+		"images.google.com.na":                    "search",
+		"images.google.com.nf":                    "search",
+		"images.google.com.ng":                    "search",
+		"images.google.com.ni":                    "search",
+		"images.google.com.np":                    "search",
+		"images.google.com.om":                    "search",
+		"images.google.com.pa":                    "search",
+		"images.google.com.pe":                    "search",
+		"images.google.com.ph":                    "search",
+		"images.google.com.pk":                    "search",
+		"images.google.com.pr":                    "search",
+		"images.google.com.py":                    "search",
+		"images.google.com.qa":                    "search",
+		"images.google.com.sa":                    "search",
+		"images.google.com.sb":                    "search",
+		"images.google.com.sg":                    "search",
+		"images.google.com.sl":                    "search",
+		"images.google.com.sv":                    "search",
+		"images.google.com.tj":                    "search",
+		"images.google.com.tn":                    "search",
+		"images.google.com.tr":                    "search",
+		"images.google.com.tw":                    "search",
+		"images.google.com.ua":                    "search",
+		"images.google.com.uy":                    "search",
+		"images.google.com.vc":                    "search",
+		"images.google.com.vn":                    "search",
+		"images.google.cv":                        "search",
+		"images.google.cz":                        "search",
+		"images.google.de":                        "search",
+		"images.google.dj":                        "search",
+		"images.google.dk":                        "search",
+		"images.google.dm":                        "search",
+		"images.google.dz":                        "search",
+		"images.google.ee":                        "search",
+		"images.google.es":                        "search",
+		"images.google.fi":                        "search",
+		"images.google.fm":                        "search",
+		"images.google.fr":                        "search",
+		"images.google.ga":                        "search",
+		"images.google.gd":                        "search",
+		"images.google.ge":                        "search",
+		"images.google.gf":                        "search",
+		"images.google.gg":                        "search",
+		"images.google.gl":                        "search",
+		"images.google.gm":                        "search",
+		"images.google.gp":                        "search",
+		"images.google.gr":                        "search",
+		"images.google.gy":                        "search",
+		"images.google.hn":                        "search",
+		"images.google.hr":                        "search",
+		"images.google.ht":                        "search",
+		"images.google.hu":                        "search",
+		"images.google.ie":                        "search",
+		"images.google.im":                        "search",
+		"images.google.io":                        "search",
+		"images.google.iq":                        "search",
+		"images.google.is":                        "search",
+		"images.google.it":                        "search",
+		"images.google.it.ao":                     "search",
+		"images.google.je":                        "search",
+		"images.google.jo":                        "search",
+		"images.google.kg":                        "search",
+		"images.google.ki":                        "search",
+		"images.google.kz":                        "search",
+		"images.google.la":                        "search",
+		"images.google.li":                        "search",
+		"images.google.lk":                        "search",
+		"images.google.lt":                        "search",
+		"images.google.lu":                        "search",
+		"images.google.lv":                        "search",
+		"images.google.md":                        "search",
+		"images.google.me":                        "search",
+		"images.google.mg":                        "search",
+		"images.google.mk":                        "search",
+		"images.google.ml":                        "search",
+		"images.google.mn":                        "search",
+		"images.google.ms":                        "search",
+		"images.google.mu":                        "search",
+		"images.google.mv":                        "search",
+		"images.google.mw":                        "search",
+		"images.google.ne":                        "search",
+		"images.google.nl":                        "search",
+		"images.google.no":                        "search",
+		"images.google.nr":                        "search",
+		"images.google.nu":                        "search",
+		"images.google.pl":                        "search",
+		"images.google.pn":                        "search",
+		"images.google.ps":                        "search",
+		"images.google.pt":                        "search",
+		"images.google.ro":                        "search",
+		"images.google.rs":                        "search",
+		"images.google.ru":                        "search",
+		"images.google.rw":                        "search",
+		"images.google.sc":                        "search",
+		"images.google.se":                        "search",
+		"images.google.sh":                        "search",
+		"images.google.si":                        "search",
+		"images.google.sk":                        "search",
+		"images.google.sm":                        "search",
+		"images.google.sn":                        "search",
+		"images.google.so":                        "search",
+		"images.google.st":                        "search",
+		"images.google.td":                        "search",
+		"images.google.tg":                        "search",
+		"images.google.tk":                        "search",
+		"images.google.tl":                        "search",
+		"images.google.tm":                        "search",
+		"images.google.to":                        "search",
+		"images.google.tt":                        "search",
+		"images.google.us":                        "search",
+		"images.google.vg":                        "search",
+		"images.google.vu":                        "search",
+		"images.google.ws":                        "search",
+		"images.search.yahoo.com":                 "search",
+		"images.yandex.by":                        "search",
+		"images.yandex.com":                       "search",
+		"images.yandex.ru":                        "search",
+		"images.yandex.ua":                        "search",
+		"imagesearch.naver.com":                   "search",
+		"imasdk.googleapis.com":                   "paid",
+		"in.pinterest.com":                        "social",
+		"in.search.yahoo.com":                     "search",
+		"in.yahoo.com":                            "search",
+		"inbox.com":                               "email",
+		"inbox.com/search/":                       "search",
+		"inbox.google.com":                        "email",
+		"inci.sozlukspot.com":                     "social",
+		"incisozluk.cc":                           "social",
+		"incisozluk.com":                          "social",
+		"infospace.com":                           "search",
+		"inspsearch.com":                          "search",
+		"instagram.com":                           "social",
+		"instela.com":                             "social",
+		"int.ask.com":                             "search",
+		"int.search-results.com":                  "search",
+		"int.search.tb.ask.com":                   "search",
+		"io.github.hidroh.materialistic":          "social",
+		"io.syncapps.lemmy_sync":                  "social",
+		"isearch.avg.com":                         "search",
+		"isearch.babylon.com":                     "search",
+		"it-it.facebook.com":                      "social",
+		"it.indeed.com":                           "search",
+		"it.pinterest.com":                        "social",
+		"it.search.yahoo.com":                     "search",
+		"it.yahoo.com":                            "search",
+		"itusozluk.com":                           "social",
+		"iwon.ask.com":                            "search",
+		"ixquick.com":                             "search",
+		"ixquick.de":                              "search",
+		"jivox.com":                               "paid",
+		"jp.pinterest.com":                        "social",
+		"jungle-spider.de":                        "search",
+		"junglekey.com":                           "search",
+		"junglekey.fr":                            "search",
+		"jyxo.1188.cz":                            "search",
+		"kataweb.it":                              "search",
+		"kf.mysearch.myway.com":                   "search",
+		"ki.mysearch.myway.com":                   "search",
+		"ko.search.need2find.com":                 "search",
+		"kr.pinterest.com":                        "social",
+		"kr.search.yahoo.com":                     "search",
+		"kr.yahoo.com":                            "search",
+		"kununu.com":                              "search",
+		"kvasir.no":                               "search",
+		"l.facebook.com":                          "social",
+		"l.instagram.com":                         "social",
+		"l.messenger.com":                         "social",
+		"l.threads.net":                           "social",
+		"lastfm.ru":                               "social",
+		"latne.lv":                                "search",
+		"lemoteur.fr":                             "search",
+		"lemoteur.orange.fr":                      "search",
+		"lens.google.com":                         "search",
+		"lfstmedia.com":                           "paid",
+		"lightmailer-bap.gmx.net":                 "mail",
+		"lightmailer-bap.web.de":                  "mail",
+		"lightmailer-bs.gmx.net":                  "mail",
+		"lightmailer-bs.web.de":                   "mail",
+		"lijit.com":                               "paid",
+		"lilo.org":                                "search",
+		"link.2gis.ru":                            "search",
+		"linkedin.com":                            "social",
+		"linktr.ee":                               "social",
+		"listings.altavista.com":                  "search",
+		"lite.qwant.com":                          "search",
+		"liveinternet.ru":                         "search",
+		"livejournal.ru":                          "social",
+		"lm.facebook.com":                         "social",
+		"lnkd.in":                                 "social",
+		"lo.st":                                   "search",
+		"login.live.com":                          "social",
+		"login.tagged.com":                        "social",
+		"looksmart.com":                           "search",
+		"lowermybills.com":                        "paid",
+		"lycos.com":                               "search",
+		"m.baidu.com":                             "search",
+		"m.bing.com":                              "search",
+		"m.facebook.com":                          "social",
+		"m.mail.ru":                               "search",
+		"m.market.yandex.ru":                      "paid",
+		"m.mastermail.ru":                         "email",
+		"m.sm.cn":                                 "search",
+		"m.sp.sm.cn":                              "search",
+		"m.twitch.tv":                             "social",
+		"m.vk.com":                                "social",
+		"m.youtube.com":                           "social",
+		"m.yz.sm.cn":                              "search",
+		"m.yz2.sm.cn":                             "search",
+		"maailm.com":                              "search",
+		"mail.126.com":                            "email",
+		"mail.163.com":                            "email",
+		"mail.aol.com":                            "email",
+		"mail.daum.net":                           "email",
+		"mail.e1.ru":                              "email",
+		"mail.google.com":                         "email",
+		"mail.iinet.net.au":                       "email",
+		"mail.live.com":                           "email",
+		"mail.mynet.com":                          "email",
+		"mail.naver.com":                          "email",
+		"mail.qip.ru":                             "email",
+		"mail.qq.com":                             "email",
+		"mail.rambler.ru":                         "email",
+		"mail.ru":                                 "search",
+		"mail.ukr.net":                            "email",
+		"mail.yahoo.co.jp":                        "email",
+		"mail.yahoo.co.uk":                        "email",
+		"mail.yahoo.com":                          "email",
+		"mail.yahoo.net":                          "email",
+		"mail.yandex.by":                          "email",
+		"mail.yandex.com":                         "email",
+		"mail.yandex.kz":                          "email",
+		"mail.yandex.ru":                          "email",
+		"mail.yandex.ua":                          "email",
+		"mail.zoho.com":                           "email",
+		"mail2.daum.net":                          "email",
+		"malaysia.search.yahoo.com":               "search",
+		"mamma.com":                               "search",
+		"mamma75.mamma.com":                       "search",
+		"market.yandex.ru":                        "paid",
+		"marktplaats.nl":                          "search",
+		"mastermail.ru":                           "email",
+		"maxwebsearch.com":                        "search",
+		"meinestadt.de":                           "search",
+		"messenger.com":                           "social",
+		"meta.rrzn.uni-hannover.de":               "search",
+		"meta.ua":                                 "search",
+		"metacrawler.com":                         "search",
+		"metager.de":                              "search",
+		"metager2.de":                             "search",
+		"microad.jp":                              "paid",
+		"mister-wong.com":                         "search",
+		"mister-wong.de":                          "search",
+		"mixi.jp":                                 "social",
+		"mixpo.com":                               "paid",
+		"mobile.virgilio.it":                      "search",
+		"mobile.whitepages.com.au":                "paid",
+		"moikrug.ru":                              "social",
+		"monster.be":                              "search",
+		"monster.ch":                              "search",
+		"monster.co.uk":                           "search",
+		"monster.cz":                              "search",
+		"monster.de":                              "search",
+		"monster.fi":                              "search",
+		"monster.fr":                              "search",
+		"monster.ie":                              "search",
+		"monster.it":                              "search",
+		"monster.lu":                              "search",
+		"monstercrawler.com":                      "search",
+		"morfeo.centrum.cz":                       "search",
+		"mozbot.co.uk":                            "search",
+		"mozbot.com":                              "search",
+		"mozbot.fr":                               "search",
+		"mozo.com.au":                             "paid",
+		"ms114.mysearch.com":                      "search",
+		"ms146.mysearch.com":                      "search",
+		"msnbc.msn.com":                           "search",
+		"msxml.excite.com":                        "search",
+		"multiply.com":                            "social",
+		"mws.ask.com":                             "search",
+		"mx.pinterest.com":                        "social",
+		"mx.search.yahoo.com":                     "search",
+		"mx.yahoo.com":                            "search",
+		"my.daemon-search.com":                    "search",
+		"my.mail.ru":                              "social",
+		"myheritage.com":                          "social",
+		"mylife.ru":                               "social",
+		"mysearch.com":                            "search",
+		"myspace.com":                             "social",
+		"myyearbook.com":                          "social",
+		"najdi.si":                                "search",
+		"navigationshilfe.t-online.de":            "search",
+		"navigator.gmx.net":                       "mail",
+		"navigator.web.de":                        "mail",
+		"neti.ee":                                 "search",
+		"netlog.com":                              "social",
+		"news.baidu.com":                          "search",
+		"news.google.ac":                          "search",
+		"news.google.ad":                          "search",
+		"news.google.ae":                          "search",
+		"news.google.am":                          "search",
+		"news.google.as":                          "search",
+		"news.google.at":                          "search",
+		"news.google.az":                          "search",
+		"news.google.ba":                          "search",
+		"news.google.be":                          "search",
+		"news.google.bf":                          "search",
+		"news.google.bg":                          "search",
+		"news.google.bi":                          "search",
+		"news.google.bj":                          "search",
+		"news.google.bs":                          "search",
+		"news.google.by":                          "search",
+		"news.google.ca":                          "search",
+		"news.google.cat":                         "search",
+		"news.google.cc":                          "search",
+		"news.google.cd":                          "search",
+		"news.google.cf":                          "search",
+		"news.google.cg":                          "search",
+		"news.google.ch":                          "search",
+		"news.google.ci":                          "search",
+		"news.google.cl":                          "search",
+		"news.google.cm":                          "search",
+		"news.google.cn":                          "search",
+		"news.google.co.bw":                       "search",
+		"news.google.co.ck":                       "search",
+		"news.google.co.cr":                       "search",
+		"news.google.co.id":                       "search",
+		"news.google.co.il":                       "search",
+		"news.google.co.in":                       "search",
+		"news.google.co.jp":                       "search",
+		"news.google.co.ke":                       "search",
+		"news.google.co.kr":                       "search",
+		"news.google.co.ls":                       "search",
+		"news.google.co.ma":                       "search",
+		"news.google.co.mz":                       "search",
+		"news.google.co.nz":                       "search",
+		"news.google.co.th":                       "search",
+		"news.google.co.tz":                       "search",
+		"news.google.co.ug":                       "search",
+		"news.google.co.uk":                       "search",
+		"news.google.co.uz":                       "search",
+		"news.google.co.ve":                       "search",
+		"news.google.co.vi":                       "search",
+		"news.google.co.za":                       "search",
+		"news.google.co.zm":                       "search",
+		"news.google.co.zw":                       "search",
+		"news.google.com":                         "search",
+		"news.google.com.af":                      "search",
+		"news.google.com.ag":                      "search",
+		"news.google.com.ai":                      "search",
+		"news.google.com.ar":                      "search",
+		"news.google.com.au":                      "search",
+		"news.google.com.bd":                      "search",
+		"news.google.com.bh":                      "search",
+		"news.google.com.bn":                      "search",
+		"news.google.com.bo":                      "search",
+		"news.google.com.br":                      "search",
+		"news.google.com.by":                      "search",
+		"news.google.com.bz":                      "search",
+		"news.google.com.co":                      "search",
+		"news.google.com.cu":                      "search",
+		"news.google.com.cy":                      "search",
+		"news.google.com.do":                      "search",
+		"news.google.com.ec":                      "search",
+		"news.google.com.eg":                      "search",
+		"news.google.com.et":                      "search",
+		"news.google.com.fj":                      "search",
+		"news.google.com.gh":                      "search",
+		"news.google.com.gi":                      "search",
+		"news.google.com.gt":                      "search",
+		"news.google.com.hk":                      "search",
+		"news.google.com.jm":                      "search",
+		"news.google.com.kh":                      "search",
+		"news.google.com.kw":                      "search",
+		"news.google.com.lb":                      "search",
+		"news.google.com.lc":                      "search",
+		"news.google.com.ly":                      "search",
+		"news.google.com.mt":                      "search",
+		"news.google.com.mx":                      "search",
+		"news.google.com.my":                      "search",
+		"news.google.com.na":                      "search",
+		"news.google.com.nf":                      "search",
+		"news.google.com.ng":                      "search",
+		"news.google.com.ni":                      "search",
+		"news.google.com.np":                      "search",
+		"news.google.com.om":                      "search",
+		"news.google.com.pa":                      "search",
+		"news.google.com.pe":                      "search",
+		"news.google.com.ph":                      "search",
+		"news.google.com.pk":                      "search",
+		"news.google.com.pr":                      "search",
+		"news.google.com.py":                      "search",
+		"news.google.com.qa":                      "search",
+		"news.google.com.sa":                      "search",
+		"news.google.com.sb":                      "search",
+		"news.google.com.sg":                      "search",
+		"news.google.com.sl":                      "search",
+		"news.google.com.sv":                      "search",
+		"news.google.com.tj":                      "search",
+		"news.google.com.tn":                      "search",
+		"news.google.com.tr":                      "search",
+		"news.google.com.tw":                      "search",
+		"news.google.com.ua":                      "search",
+		"news.google.com.uy":                      "search",
+		"news.google.com.vc":                      "search",
+		"news.google.com.vn":                      "search",
+		"news.google.cv":                          "search",
+		"news.google.cz":                          "search",
+		"news.google.de":                          "search",
+		"news.google.dj":                          "search",
+		"news.google.dk":                          "search",
+		"news.google.dm":                          "search",
+		"news.google.dz":                          "search",
+		"news.google.ee":                          "search",
+		"news.google.es":                          "search",
+		"news.google.fi":                          "search",
+		"news.google.fm":                          "search",
+		"news.google.fr":                          "search",
+		"news.google.ga":                          "search",
+		"news.google.gd":                          "search",
+		"news.google.ge":                          "search",
+		"news.google.gf":                          "search",
+		"news.google.gg":                          "search",
+		"news.google.gl":                          "search",
+		"news.google.gm":                          "search",
+		"news.google.gp":                          "search",
+		"news.google.gr":                          "search",
+		"news.google.gy":                          "search",
+		"news.google.hn":                          "search",
+		"news.google.hr":                          "search",
+		"news.google.ht":                          "search",
+		"news.google.hu":                          "search",
+		"news.google.ie":                          "search",
+		"news.google.im":                          "search",
+		"news.google.io":                          "search",
+		"news.google.iq":                          "search",
+		"news.google.is":                          "search",
+		"news.google.it":                          "search",
+		"news.google.it.ao":                       "search",
+		"news.google.je":                          "search",
+		"news.google.jo":                          "search",
+		"news.google.kg":                          "search",
+		"news.google.ki":                          "search",
+		"news.google.kz":                          "search",
+		"news.google.la":                          "search",
+		"news.google.li":                          "search",
+		"news.google.lk":                          "search",
+		"news.google.lt":                          "search",
+		"news.google.lu":                          "search",
+		"news.google.lv":                          "search",
+		"news.google.md":                          "search",
+		"news.google.me":                          "search",
+		"news.google.mg":                          "search",
+		"news.google.mk":                          "search",
+		"news.google.ml":                          "search",
+		"news.google.mn":                          "search",
+		"news.google.ms":                          "search",
+		"news.google.mu":                          "search",
+		"news.google.mv":                          "search",
+		"news.google.mw":                          "search",
+		"news.google.ne":                          "search",
+		"news.google.nl":                          "search",
+		"news.google.no":                          "search",
+		"news.google.nr":                          "search",
+		"news.google.nu":                          "search",
+		"news.google.pl":                          "search",
+		"news.google.pn":                          "search",
+		"news.google.ps":                          "search",
+		"news.google.pt":                          "search",
+		"news.google.ro":                          "search",
+		"news.google.rs":                          "search",
+		"news.google.ru":                          "search",
+		"news.google.rw":                          "search",
+		"news.google.sc":                          "search",
+		"news.google.se":                          "search",
+		"news.google.sh":                          "search",
+		"news.google.si":                          "search",
+		"news.google.sk":                          "search",
+		"news.google.sm":                          "search",
+		"news.google.sn":                          "search",
+		"news.google.so":                          "search",
+		"news.google.st":                          "search",
+		"news.google.td":                          "search",
+		"news.google.tg":                          "search",
+		"news.google.tk":                          "search",
+		"news.google.tl":                          "search",
+		"news.google.tm":                          "search",
+		"news.google.to":                          "search",
+		"news.google.tt":                          "search",
+		"news.google.us":                          "search",
+		"news.google.vg":                          "search",
+		"news.google.vu":                          "search",
+		"news.google.ws":                          "search",
+		"news.ycombinator.com":                    "social",
+		"nexage.com":                              "paid",
+		"next.duckduckgo.com":                     "search",
+		"nigma.ru":                                "search",
+		"nk.pl":                                   "social",
+		"nl-nl.facebook.com":                      "social",
+		"nl.search.yahoo.com":                     "search",
+		"nl.viamichelin.be":                       "search",
+		"no.search.yahoo.com":                     "search",
+		"no.yahoo.com":                            "search",
+		"nova.rambler.ru":                         "search",
+		"nz.pinterest.com":                        "social",
+		"nz.search.yahoo.com":                     "search",
+		"nz.yahoo.com":                            "search",
+		"ocnsearch.goo.ne.jp":                     "search",
+		"odnoklassniki.ru":                        "social",
+		"ok.ru":                                   "social",
+		"old.reddit.com":                          "social",
+		"one.cn.yahoo.com":                        "search",
+		"one.searchn.yahoo.com":                   "search",
+		"online.no":                               "search",
+		"openx.net":                               "paid",
+		"openxenterprise.com":                     "paid",
+		"optimized-by.rubiconproject.com":         "paid",
+		"orange.fr/webmail":                       "email",
+		"org.aka.messenger":                       "social",
+		"org.qwant.com":                           "search",
+		"org.telegram.biftogram":                  "social",
+		"org.telegram.messenger":                  "social",
+		"org.telegram.messenger.web":              "social",
+		"org.telegram.plus":                       "social",
+		"orkut.com":                               "social",
+		"otsing.delfi.ee":                         "search",
+		"otvet.mail.ru":                           "search",
+		"outlook.live.com":                        "email",
+		"p.zhongsou.com":                          "search",
+		"paid.outbrain.com":                       "paid",
+		"paper.li":                                "social",
+		"paperball.de":                            "search",
+		"partner.googleadservices.com":            "paid",
+		"pe.search.yahoo.com":                     "search",
+		"pesquisa.clix.pt":                        "search",
+		"pesquisa.sapo.pt":                        "search",
+		"ph.pinterest.com":                        "social",
+		"ph.search.yahoo.com":                     "search",
+		"picsearch.com":                           "search",
+		"pin.it":                                  "social",
+		"pinboard.opera.com":                      "search",
+		"pinterest.at":                            "social",
+		"pinterest.ca":                            "social",
+		"pinterest.ch":                            "social",
+		"pinterest.cl":                            "social",
+		"pinterest.co.kr":                         "social",
+		"pinterest.co.uk":                         "social",
+		"pinterest.com":                           "social",
+		"pinterest.com.au":                        "social",
+		"pinterest.com.mx":                        "social",
+		"pinterest.de":                            "social",
+		"pinterest.dk":                            "social",
+		"pinterest.es":                            "social",
+		"pinterest.fr":                            "social",
+		"pinterest.ie":                            "social",
+		"pinterest.in":                            "social",
+		"pinterest.info":                          "social",
+		"pinterest.it":                            "social",
+		"pinterest.jp":                            "social",
+		"pinterest.net":                           "social",
+		"pinterest.nz":                            "social",
+		"pinterest.ph":                            "social",
+		"pinterest.pt":                            "social",
+		"pinterest.ru":                            "social",
+		"pinterest.se":                            "social",
+		"pinterest.sk":                            "social",
+		"pl.pinterest.com":                        "social",
+		"pl.search.yahoo.com":                     "search",
+		"plaxo.com":                               "social",
+		"plazoo.com":                              "search",
+		"plus.google.com":                         "social",
+		"plusperformance.com":                     "paid",
+		"poisk.ru":                                "search",
+		"post.ru":                                 "email",
+		"price.ru":                                "paid",
+		"pricerunner.co.uk":                       "search",
+		"pt.pinterest.com":                        "social",
+		"pubads.g.doubleclick.net":                "paid",
+		"qbyrd.com":                               "search",
+		"qc.search.yahoo.com":                     "search",
+		"qc.yahoo.com":                            "search",
+		"qualigo.at":                              "search",
+		"qualigo.ch":                              "search",
+		"qualigo.de":                              "search",
+		"qualigo.nl":                              "search",
+		"quark.sm.cn":                             "search",
+		"quora.com":                               "social",
+		"qwant.com":                               "search",
+		"qzone.qq.com":                            "social",
+		"r.duckduckgo.com":                        "search",
+		"r.search.yahoo.com":                      "search",
+		"recherche.aol.ca":                        "search",
+		"recherche.aol.fr":                        "search",
+		"recherche.francite.com":                  "search",
+		"rechercher.aliceadsl.fr":                 "search",
+		"reddit.com":                              "social",
+		"redirect.disqus.com":                     "social",
+		"renren.com":                              "social",
+		"req.-hit-parade.com":                     "search",
+		"ricerca.virgilio.it":                     "search",
+		"ricercaimmagini.virgilio.it":             "search",
+		"ricercanews.virgilio.it":                 "search",
+		"ricercavideo.virgilio.it":                "search",
+		"rich-v01.bluewin.ch":                     "mail",
+		"rich-v02.bluewin.ch":                     "mail",
+		"rpmfind.net":                             "search",
+		"rtbcity.com":                             "paid",
+		"ru.pinterest.com":                        "social",
+		"ru.search.yahoo.com":                     "search",
+		"ru.yahoo.com":                            "search",
+		"s0.2mdn.net":                             "paid",
+		"s1-eu.ixquick.de":                        "search",
+		"s1.2mdn.net":                             "paid",
+		"s1.metacrawler.de":                       "search",
+		"s1.us.ixquick.com":                       "search",
+		"s2.metacrawler.de":                       "search",
+		"s2.us.ixquick.com":                       "search",
+		"s3.metacrawler.de":                       "search",
+		"s3.us.ixquick.com":                       "search",
+		"s4.us.ixquick.com":                       "search",
+		"s5.us.ixquick.com":                       "search",
+		"s8-eu.ixquick.com":                       "search",
+		"safe.duckduckgo.com":                     "search",
+		"se.abacho.com":                           "search",
+		"se.pinterest.com":                        "social",
+		"se.search.yahoo.com":                     "search",
+		"se.yahoo.com":                            "search",
+		"search-dyn.tiscali.it":                   "search",
+		"search-intl.netscape.com":                "search",
+		"search-results.com":                      "search",
+		"search.1and1.com":                        "search",
+		"search.1und1.de":                         "search",
+		"search.alot.com":                         "search",
+		"search.altavista.com":                    "search",
+		"search.aol.co.uk":                        "search",
+		"search.aol.com":                          "search",
+		"search.aol.it":                           "search",
+		"search.avg.com":                          "search",
+		"search.babylon.com":                      "search",
+		"search.bluewin.ch":                       "search",
+		"search.brave.com":                        "search",
+		"search.bt.com":                           "search",
+		"search.certified-toolbar.com":            "search",
+		"search.ch":                               "search",
+		"search.com":                              "search",
+		"search.conduit.com":                      "search",
+		"search.darkoogle.com":                    "search",
+		"search.daum.net":                         "search",
+		"search.earthlink.net":                    "search",
+		"search.excite.co.uk":                     "search",
+		"search.excite.de":                        "search",
+		"search.excite.fr":                        "search",
+		"search.excite.it":                        "search",
+		"search.excite.nl":                        "search",
+		"search.findwide.com":                     "search",
+		"search.foxtab.com":                       "search",
+		"search.free.fr":                          "search",
+		"search.freecause.com":                    "search",
+		"search.genieo.com":                       "search",
+		"search.globososo.com":                    "search",
+		"search.goo.ne.jp":                        "search",
+		"search.hiyo.com":                         "search",
+		"search.hp.my.aol.com.au":                 "search",
+		"search.hp.my.aol.de":                     "search",
+		"search.hp.my.aol.it":                     "search",
+		"search.i.ua":                             "search",
+		"search.icq.com":                          "search",
+		"search.incredibar.com":                   "search",
+		"search.incredimail.com":                  "search",
+		"search.juno.com":                         "search",
+		"search.ke.voila.fr":                      "search",
+		"search.kiwee.com":                        "search",
+		"search.lilo.org":                         "search",
+		"search.lycos.com":                        "search",
+		"search.magnetic.com":                     "search",
+		"search.media.telstra.com.au":             "search",
+		"search.myway.com":                        "search",
+		"search.mywebsearch.com":                  "search",
+		"search.nate.com":                         "search",
+		"search.naver.com":                        "search",
+		"search.nifty.com":                        "search",
+		"search.offerbox.com":                     "search",
+		"search.opera.com":                        "search",
+		"search.orange.co.uk":                     "search",
+		"search.peoplepc.com":                     "search",
+		"search.qip.ru":                           "search",
+		"search.rr.com":                           "search",
+		"search.searcharch.yahoo.com":             "search",
+		"search.searchcompletion.com":             "search",
+		"search.seznam.cz":                        "search",
+		"search.snapdo.com":                       "search",
+		"search.softonic.com":                     "search",
+		"search.sosodesktop.com":                  "search",
+		"search.sweetim.com":                      "search",
+		"search.tb.ask.com":                       "search",
+		"search.tiscali.it":                       "search",
+		"search.toolbars.alexa.com":               "search",
+		"search.tut.by":                           "search",
+		"search.ukr.net":                          "search",
+		"search.uselilo.org":                      "search",
+		"search.vindex.nl":                        "search",
+		"search.walla.co.il":                      "search",
+		"search.winamp.com":                       "search",
+		"search.www.ee":                           "search",
+		"search.yahoo.co.jp":                      "search",
+		"search.yahoo.com":                        "search",
+		"search.yam.com":                          "search",
+		"search.yippy.com":                        "search",
+		"search1-1.free.fr":                       "search",
+		"search1-2.free.fr":                       "search",
+		"search1.incredimail.com":                 "search",
+		"search2.incredimail.com":                 "search",
+		"search3.incredimail.com":                 "search",
+		"search4.incredimail.com":                 "search",
+		"searchalot.com":                          "search",
+		"searchassist.babylon.com":                "search",
+		"searchatlas.centrum.cz":                  "search",
+		"searchcanvas.com":                        "search",
+		"searches.globososo.com":                  "search",
+		"searchresults.verizon.com":               "search",
+		"searchthis.com":                          "search",
+		"searchy.co.uk":                           "search",
+		"serach.centrum.cz":                       "search",
+		"serach.comcast.net":                      "search",
+		"serach.excite.es":                        "search",
+		"servedby.flashtalking.com":               "paid",
+		"servedbyopenx.com":                       "paid",
+		"sfx.stickyadstv.com":                     "paid",
+		"sg.search.yahoo.com":                     "search",
+		"sharelook.fr":                            "search",
+		"sibmail.com":                             "email",
+		"sk.pinterest.com":                        "social",
+		"skynet.be":                               "search",
+		"skyrock.com":                             "social",
+		"sm.aport.ru":                             "search",
+		"smart.delfi.lv":                          "search",
+		"snapchat.com":                            "social",
+		"so.360.cn":                               "search",
+		"so.com":                                  "search",
+		"so.m.sm.cn":                              "search",
+		"sociomantic.com":                         "paid",
+		"sonico.com":                              "social",
+		"sonobi.com":                              "paid",
+		"soso.com":                                "search",
+		"sosodesktop.com":                         "search",
+		"sougou.com":                              "search",
+		"sourceforge.net":                         "social",
+		"sourtimes.org":                           "social",
+		"sozluk.com":                              "social",
+		"sshowads.pubmatic.com":                   "paid",
+		"stackoverflow.com":                       "social",
+		"start.duckduckgo.com":                    "search",
+		"start.facemoods.com":                     "search",
+		"start.iplay.com":                         "search",
+		"startgoogle.startpagina.nl":              "search",
+		"startpage.com":                           "search",
+		"startsiden.no":                           "search",
+		"steelhousemedia.com":                     "paid",
+		"stepstone.at":                            "search",
+		"stepstone.be":                            "search",
+		"stepstone.de":                            "search",
+		"stepstone.dk":                            "search",
+		"stepstone.fr":                            "search",
+		"stepstone.nl":                            "search",
+		"stepstone.se":                            "search",
+		"stickyadstv.com":                         "paid",
+		"studivz.net":                             "social",
+		"stumbleupon.com":                         "social",
+		"suche.aol.de":                            "search",
+		"suche.aolsvc.de":                         "search",
+		"suche.freenet.de":                        "search",
+		"suche.gmx.net":                           "search",
+		"suche.info":                              "search",
+		"suche.t-online.de":                       "search",
+		"suche.web.de":                            "search",
+		"sucheaol.aol.de":                         "search",
+		"suchet2.aol.de":                          "search",
+		"suchmaschine.com":                        "search",
+		"suchnase.de":                             "search",
+		"szukaj.onet.pl":                          "search",
+		"szukaj.wp.pl":                            "search",
+		"t.cn":                                    "social",
+		"t.co":                                    "social",
+		"t.umblr.com":                             "social",
+		"taboola.com":                             "paid",
+		"talktalk.co.uk":                          "search",
+		"taringa.net":                             "social",
+		"technorati.com":                          "search",
+		"telegram.org":                            "social",
+		"teoma.com":                               "search",
+		"th.search.yahoo.com":                     "search",
+		"thesmartsearch.net":                      "search",
+		"threads.net":                             "social",
+		"tieba.baidu.com":                         "search",
+		"tiktok.com":                              "social",
+		"tildes.net":                              "social",
+		"tixuma.de":                               "search",
+		"toile.com":                               "search",
+		"toolbarhome.com":                         "search",
+		"torg.mail.ru":                            "paid",
+		"touch.mail.ru":                           "email",
+		"tpc.googlesyndication.com":               "paid",
+		"tr.abacho.com":                           "search",
+		"tr.search.yahoo.com":                     "search",
+		"translate.google.com":                    "search",
+		"trc.taboola.com":                         "paid",
+		"trouvez.com":                             "search",
+		"trovarapido.com":                         "search",
+		"trusted--search.com":                     "search",
+		"tuenti.com":                              "social",
+		"tumblr.com":                              "social",
+		"tw.search.yahoo.com":                     "search",
+		"tw.yahoo.com":                            "search",
+		"twingly.com":                             "search",
+		"twitch.tv":                               "social",
+		"twitter.com":                             "social",
+		"uk.ask.com":                              "search",
+		"uk.pinterest.com":                        "social",
+		"uk.search-results.com":                   "search",
+		"uk.search.yahoo.com":                     "search",
+		"uk.yahoo.com":                            "search",
+		"uk.zapmeta.com":                          "search",
+		"uludagsozluk.com":                        "social",
+		"ulusozluk.com":                           "social",
+		"url.google.com":                          "social",
+		"url.org":                                 "search",
+		"us-ads.openx.net":                        "paid",
+		"us.ixquick.com":                          "search",
+		"us.search.yahoo.com":                     "search",
+		"us.yahoo.com":                            "search",
+		"v.price.ru":                              "paid",
+		"verden.abcsok.no":                        "search",
+		"viadeo.com":                              "social",
+		"viamichelin.co.uk":                       "search",
+		"viamichelin.de":                          "search",
+		"viamichelin.it":                          "search",
+		"viamichelin.nl":                          "search",
+		"video.google.com":                        "search",
+		"vimeo.com":                               "social",
+		"vinden.nl":                               "search",
+		"vindex.nl":                               "search",
+		"viview.inspsearch.com":                   "search",
+		"vk.com":                                  "social",
+		"vkontakte.ru":                            "social",
+		"vkrugudruzei.ru":                         "social",
+		"vn.search.yahoo.com":                     "search",
+		"vshare.toolbarhome.com":                  "search",
+		"walhello.com":                            "search",
+		"walhello.de":                             "search",
+		"walhello.info":                           "search",
+		"walhello.nl":                             "search",
+		"wayn.com":                                "social",
+		"web.ask.com":                             "search",
+		"web.canoe.ca":                            "search",
+		"web.facebook.com":                        "social",
+		"web.flow.opera.com":                      "search",
+		"web.gougou.com":                          "search",
+		"web.nl":                                  "search",
+		"web.skype.com":                           "social",
+		"web.telegram.org":                        "social",
+		"web.toile.com":                           "search",
+		"web.volny.cz":                            "search",
+		"web.whatsapp.com":                        "social",
+		"webcache.googleusercontent.com":          "search",
+		"webcrawler.com":                          "search",
+		"webfetch.com":                            "search",
+		"webmail.2degreesbroadband.co.nz":         "email",
+		"webmail.adam.com.au":                     "email",
+		"webmail.bigpond.com":                     "email",
+		"webmail.commander.net.au":                "email",
+		"webmail.dodo.com.au":                     "email",
+		"webmail.freenet.de":                      "email",
+		"webmail.iinet.net.au":                    "email",
+		"webmail.iprimus.com.au":                  "email",
+		"webmail.netspace.net.au":                 "email",
+		"webmail.optusnet.com.au":                 "email",
+		"webmail.optuszoo.com.au":                 "email",
+		"webmail.virginbroadband.com.au":          "email",
+		"webmail.vodafone.co.nz":                  "email",
+		"webmail.westnet.com.au":                  "email",
+		"webmail2.bigpond.com":                    "email",
+		"weborama.com":                            "search",
+		"websearch.com":                           "search",
+		"websearch.cs.com":                        "search",
+		"websearch.rakuten.co.jp":                 "search",
+		"weeworld.com":                            "social",
+		"weibo.com":                               "social",
+		"whitepages.com.au":                       "paid",
+		"witch.de":                                "search",
+		"wunderloop.net":                          "paid",
+		"www1.astronaut.at":                       "search",
+		"www1.baidu.com":                          "search",
+		"www1.dastelefonbuch.de":                  "search",
+		"www2.austronaut.at":                      "search",
+		"www2.bing.com":                           "search",
+		"www3.zoek.nl":                            "search",
+		"www4.bing.com":                           "search",
+		"x-recherche.com":                         "search",
+		"xanga.com":                               "social",
+		"xing.com":                                "social",
+		"ya.ru":                                   "search",
+		"yabs.yandex.by":                          "paid",
+		"yabs.yandex.com":                         "paid",
+		"yabs.yandex.ru":                          "paid",
+		"yabs.yandex.ua":                          "paid",
+		"yahoo.co.jp":                             "search",
+		"yahoo.com":                               "search",
+		"yandex.by":                               "search",
+		"yandex.com":                              "search",
+		"yandex.com.tr":                           "search",
+		"yandex.kz":                               "search",
+		"yandex.ru":                               "search",
+		"yandex.tr":                               "search",
+		"yandex.ua":                               "search",
+		"yandex.uz":                               "search",
+		"yasni.at":                                "search",
+		"yasni.ch":                                "search",
+		"yasni.co.uk":                             "search",
+		"yasni.com":                               "search",
+		"yasni.de":                                "search",
+		"yatedo.com":                              "search",
+		"yatedo.fr":                               "search",
+		"yieldmo.com":                             "paid",
+		"yougoo.fr":                               "search",
+		"youtu.be":                                "social",
+		"youtube-nocookie.com":                    "social",
+		"youtube.ae":                              "social",
+		"youtube.al":                              "social",
+		"youtube.am":                              "social",
+		"youtube.at":                              "social",
+		"youtube.az":                              "social",
+		"youtube.ba":                              "social",
+		"youtube.be":                              "social",
+		"youtube.bg":                              "social",
+		"youtube.bh":                              "social",
+		"youtube.bo":                              "social",
+		"youtube.by":                              "social",
+		"youtube.ca":                              "social",
+		"youtube.cat":                             "social",
+		"youtube.ch":                              "social",
+		"youtube.cl":                              "social",
+		"youtube.co":                              "social",
+		"youtube.co.ae":                           "social",
+		"youtube.co.at":                           "social",
+		"youtube.co.cr":                           "social",
+		"youtube.co.hu":                           "social",
+		"youtube.co.id":                           "social",
+		"youtube.co.il":                           "social",
+		"youtube.co.in":                           "social",
+		"youtube.co.jp":                           "social",
+		"youtube.co.ke":                           "social",
+		"youtube.co.kr":                           "social",
+		"youtube.co.ma":                           "social",
+		"youtube.co.nz":                           "social",
+		"youtube.co.th":                           "social",
+		"youtube.co.tz":                           "social",
+		"youtube.co.ug":                           "social",
+		"youtube.co.uk":                           "social",
+		"youtube.co.ve":                           "social",
+		"youtube.co.za":                           "social",
+		"youtube.co.zw":                           "social",
+		"youtube.com":                             "social",
+		"youtube.com.ar":                          "social",
+		"youtube.com.au":                          "social",
+		"youtube.com.az":                          "social",
+		"youtube.com.bd":                          "social",
+		"youtube.com.bh":                          "social",
+		"youtube.com.bo":                          "social",
+		"youtube.com.br":                          "social",
+		"youtube.com.by":                          "social",
+		"youtube.com.co":                          "social",
+		"youtube.com.do":                          "social",
+		"youtube.com.ec":                          "social",
+		"youtube.com.ee":                          "social",
+		"youtube.com.eg":                          "social",
+		"youtube.com.es":                          "social",
+		"youtube.com.gh":                          "social",
+		"youtube.com.gr":                          "social",
+		"youtube.com.gt":                          "social",
+		"youtube.com.hk":                          "social",
+		"youtube.com.hn":                          "social",
+		"youtube.com.hr":                          "social",
+		"youtube.com.jm":                          "social",
+		"youtube.com.jo":                          "social",
+		"youtube.com.kw":                          "social",
+		"youtube.com.lb":                          "social",
+		"youtube.com.lv":                          "social",
+		"youtube.com.ly":                          "social",
+		"youtube.com.mk":                          "social",
+		"youtube.com.mt":                          "social",
+		"youtube.com.mx":                          "social",
+		"youtube.com.my":                          "social",
+		"youtube.com.ng":                          "social",
+		"youtube.com.ni":                          "social",
+		"youtube.com.om":                          "social",
+		"youtube.com.pa":                          "social",
+		"youtube.com.pe":                          "social",
+		"youtube.com.ph":                          "social",
+		"youtube.com.pk":                          "social",
+		"youtube.com.pt":                          "social",
+		"youtube.com.py":                          "social",
+		"youtube.com.qa":                          "social",
+		"youtube.com.ro":                          "social",
+		"youtube.com.sa":                          "social",
+		"youtube.com.sg":                          "social",
+		"youtube.com.sv":                          "social",
+		"youtube.com.tn":                          "social",
+		"youtube.com.tr":                          "social",
+		"youtube.com.tw":                          "social",
+		"youtube.com.ua":                          "social",
+		"youtube.com.uy":                          "social",
+		"youtube.com.ve":                          "social",
+		"youtube.cr":                              "social",
+		"youtube.cz":                              "social",
+		"youtube.de":                              "social",
+		"youtube.dk":                              "social",
+		"youtube.ee":                              "social",
+		"youtube.es":                              "social",
+		"youtube.fi":                              "social",
+		"youtube.fr":                              "social",
+		"youtube.ge":                              "social",
+		"youtube.gr":                              "social",
+		"youtube.gt":                              "social",
+		"youtube.hk":                              "social",
+		"youtube.hr":                              "social",
+		"youtube.hu":                              "social",
+		"youtube.ie":                              "social",
+		"youtube.in":                              "social",
+		"youtube.iq":                              "social",
+		"youtube.is":                              "social",
+		"youtube.it":                              "social",
+		"youtube.jo":                              "social",
+		"youtube.jp":                              "social",
+		"youtube.kr":                              "social",
+		"youtube.kz":                              "social",
+		"youtube.la":                              "social",
+		"youtube.lk":                              "social",
+		"youtube.lt":                              "social",
+		"youtube.lu":                              "social",
+		"youtube.lv":                              "social",
+		"youtube.ly":                              "social",
+		"youtube.ma":                              "social",
+		"youtube.md":                              "social",
+		"youtube.me":                              "social",
+		"youtube.mk":                              "social",
+		"youtube.mn":                              "social",
+		"youtube.mx":                              "social",
+		"youtube.my":                              "social",
+		"youtube.ng":                              "social",
+		"youtube.ni":                              "social",
+		"youtube.nl":                              "social",
+		"youtube.no":                              "social",
+		"youtube.pa":                              "social",
+		"youtube.pe":                              "social",
+		"youtube.ph":                              "social",
+		"youtube.pk":                              "social",
+		"youtube.pl":                              "social",
+		"youtube.pr":                              "social",
+		"youtube.pt":                              "social",
+		"youtube.qa":                              "social",
+		"youtube.ro":                              "social",
+		"youtube.rs":                              "social",
+		"youtube.ru":                              "social",
+		"youtube.sa":                              "social",
+		"youtube.se":                              "social",
+		"youtube.sg":                              "social",
+		"youtube.si":                              "social",
+		"youtube.sk":                              "social",
+		"youtube.sn":                              "social",
+		"youtube.soy":                             "social",
+		"youtube.sv":                              "social",
+		"youtube.tn":                              "social",
+		"youtube.tv":                              "social",
+		"youtube.ua":                              "social",
+		"youtube.ug":                              "social",
+		"youtube.uy":                              "social",
+		"youtube.vn":                              "social",
+		"youtubekids.com":                         "social",
+		"ys.mirostart.com":                        "search",
+		"yt.be":                                   "social",
+		"yz.m.sm.cn":                              "search",
+		"z1.zedo.com":                             "paid",
+		"zapmeta.com":                             "search",
+		"zapmeta.de":                              "search",
+		"zapmeta.nl":                              "search",
+		"zedo.com":                                "paid",
+		"zhidao.baidu.com":                        "search",
+		"zoeken.nl":                               "search",
+		"zoohoo.cz":                               "search",
+	}
+
+	searchParameters = map[string][]string{
+		"1.cz":                                    {"q"},
+		"1881.no":                                 {"Query"},
+		"abacho.at":                               {"q"},
+		"abacho.ch":                               {"q"},
+		"abacho.co.uk":                            {"q"},
+		"abacho.com":                              {"q"},
+		"abacho.de":                               {"q"},
+		"abacho.es":                               {"q"},
+		"abacho.fr":                               {"q"},
+		"abacho.it":                               {"q"},
+		"abcsolk.no":                              {"q"},
+		"acoon.de":                                {"begriff"},
+		"aim.search.aol.com":                      {"q", "query"},
+		"alexa.com":                               {"q"},
+		"alicesuche.aol.de":                       {"q", "query"},
+		"alicesuchet.aol.de":                      {"q", "query"},
+		"all.by":                                  {"query"},
+		"alltheweb.com":                           {"q"},
+		"altavista.com":                           {"q"},
+		"altavista.de":                            {"q"},
+		"altavista.fr":                            {"q"},
+		"amazon.com":                              {"keywords"},
+		"aolbusqueda.aol.com.mx":                  {"q", "query"},
+		"aolimages.aol.fr":                        {"q", "query"},
+		"aolrecherche.aol.fr":                     {"q", "query"},
+		"aolrecherches.aol.fr":                    {"q", "query"},
+		"aolsearch.aol.co.uk":                     {"q", "query"},
+		"aolsearch.aol.com":                       {"q", "query"},
+		"aolsearch.com":                           {"q", "query"},
+		"apollo.lv/portal/search/":                {"q"},
+		"apollo7.de":                              {"query"},
+		"apontador.com.br":                        {"q"},
+		"ar.search.yahoo.com":                     {"p", "q"},
+		"ar.yahoo.com":                            {"p", "q"},
+		"arama.com":                               {"q"},
+		"arcor.de":                                {"Keywords"},
+		"ariadna.elmundo.es":                      {"q"},
+		"arianna.com":                             {"query"},
+		"arianna.libero.it":                       {"query"},
+		"ask.co.uk":                               {"q"},
+		"ask.com":                                 {"q"},
+		"ask.reference.com":                       {"q"},
+		"askkids.com":                             {"q"},
+		"au.search.yahoo.com":                     {"p", "q"},
+		"au.yahoo.com":                            {"p", "q"},
+		"baidu.com":                               {"wd", "word"},
+		"be-fr.altavista.com":                     {"q"},
+		"be-nl.altavista.com":                     {"q"},
+		"bing.com":                                {"q", "Q"},
+		"bing.com/images/search":                  {"q", "Q"},
+		"blekko.com":                              {"q"},
+		"blogdigger.com":                          {"q"},
+		"blogpulse.com":                           {"query"},
+		"blogs.icerocket.com":                     {"q"},
+		"blogsearch.google.ac":                    {"q"},
+		"blogsearch.google.ad":                    {"q"},
+		"blogsearch.google.ae":                    {"q"},
+		"blogsearch.google.am":                    {"q"},
+		"blogsearch.google.as":                    {"q"},
+		"blogsearch.google.at":                    {"q"},
+		"blogsearch.google.az":                    {"q"},
+		"blogsearch.google.ba":                    {"q"},
+		"blogsearch.google.be":                    {"q"},
+		"blogsearch.google.bf":                    {"q"},
+		"blogsearch.google.bg":                    {"q"},
+		"blogsearch.google.bi":                    {"q"},
+		"blogsearch.google.bj":                    {"q"},
+		"blogsearch.google.bs":                    {"q"},
+		"blogsearch.google.by":                    {"q"},
+		"blogsearch.google.ca":                    {"q"},
+		"blogsearch.google.cat":                   {"q"},
+		"blogsearch.google.cc":                    {"q"},
+		"blogsearch.google.cd":                    {"q"},
+		"blogsearch.google.cf":                    {"q"},
+		"blogsearch.google.cg":                    {"q"},
+		"blogsearch.google.ch":                    {"q"},
+		"blogsearch.google.ci":                    {"q"},
+		"blogsearch.google.cl":                    {"q"},
+		"blogsearch.google.cm":                    {"q"},
+		"blogsearch.google.cn":                    {"q"},
+		"blogsearch.google.co.bw":                 {"q"},
+		"blogsearch.google.co.ck":                 {"q"},
+		"blogsearch.google.co.cr":                 {"q"},
+		"blogsearch.google.co.id":                 {"q"},
+		"blogsearch.google.co.il":                 {"q"},
+		"blogsearch.google.co.in":                 {"q"},
+		"blogsearch.google.co.jp":                 {"q"},
+		"blogsearch.google.co.ke":                 {"q"},
+		"blogsearch.google.co.kr":                 {"q"},
+		"blogsearch.google.co.ls":                 {"q"},
+		"blogsearch.google.co.ma":                 {"q"},
+		"blogsearch.google.co.mz":                 {"q"},
+		"blogsearch.google.co.nz":                 {"q"},
+		"blogsearch.google.co.th":                 {"q"},
+		"blogsearch.google.co.tz":                 {"q"},
+		"blogsearch.google.co.ug":                 {"q"},
+		"blogsearch.google.co.uk":                 {"q"},
+		"blogsearch.google.co.uz":                 {"q"},
+		"blogsearch.google.co.ve":                 {"q"},
+		"blogsearch.google.co.vi":                 {"q"},
+		"blogsearch.google.co.za":                 {"q"},
+		"blogsearch.google.co.zm":                 {"q"},
+		"blogsearch.google.co.zw":                 {"q"},
+		"blogsearch.google.com":                   {"q"},
+		"blogsearch.google.com.af":                {"q"},
+		"blogsearch.google.com.ag":                {"q"},
+		"blogsearch.google.com.ai":                {"q"},
+		"blogsearch.google.com.ar":                {"q"},
+		"blogsearch.google.com.au":                {"q"},
+		"blogsearch.google.com.bd":                {"q"},
+		"blogsearch.google.com.bh":                {"q"},
+		"blogsearch.google.com.bn":                {"q"},
+		"blogsearch.google.com.bo":                {"q"},
+		"blogsearch.google.com.br":                {"q"},
+		"blogsearch.google.com.by":                {"q"},
+		"blogsearch.google.com.bz":                {"q"},
+		"blogsearch.google.com.co":                {"q"},
+		"blogsearch.google.com.cu":                {"q"},
+		"blogsearch.google.com.cy":                {"q"},
+		"blogsearch.google.com.do":                {"q"},
+		"blogsearch.google.com.ec":                {"q"},
+		"blogsearch.google.com.eg":                {"q"},
+		"blogsearch.google.com.et":                {"q"},
+		"blogsearch.google.com.fj":                {"q"},
+		"blogsearch.google.com.gh":                {"q"},
+		"blogsearch.google.com.gi":                {"q"},
+		"blogsearch.google.com.gt":                {"q"},
+		"blogsearch.google.com.hk":                {"q"},
+		"blogsearch.google.com.jm":                {"q"},
+		"blogsearch.google.com.kh":                {"q"},
+		"blogsearch.google.com.kw":                {"q"},
+		"blogsearch.google.com.lb":                {"q"},
+		"blogsearch.google.com.lc":                {"q"},
+		"blogsearch.google.com.ly":                {"q"},
+		"blogsearch.google.com.mt":                {"q"},
+		"blogsearch.google.com.mx":                {"q"},
+		"blogsearch.google.com.my":                {"q"},
+		"blogsearch.google.com.na":                {"q"},
+		"blogsearch.google.com.nf":                {"q"},
+		"blogsearch.google.com.ng":                {"q"},
+		"blogsearch.google.com.ni":                {"q"},
+		"blogsearch.google.com.np":                {"q"},
+		"blogsearch.google.com.om":                {"q"},
+		"blogsearch.google.com.pa":                {"q"},
+		"blogsearch.google.com.pe":                {"q"},
+		"blogsearch.google.com.ph":                {"q"},
+		"blogsearch.google.com.pk":                {"q"},
+		"blogsearch.google.com.pr":                {"q"},
+		"blogsearch.google.com.py":                {"q"},
+		"blogsearch.google.com.qa":                {"q"},
+		"blogsearch.google.com.sa":                {"q"},
+		"blogsearch.google.com.sb":                {"q"},
+		"blogsearch.google.com.sg":                {"q"},
+		"blogsearch.google.com.sl":                {"q"},
+		"blogsearch.google.com.sv":                {"q"},
+		"blogsearch.google.com.tj":                {"q"},
+		"blogsearch.google.com.tn":                {"q"},
+		"blogsearch.google.com.tr":                {"q"},
+		"blogsearch.google.com.tw":                {"q"},
+		"blogsearch.google.com.ua":                {"q"},
+		"blogsearch.google.com.uy":                {"q"},
+		"blogsearch.google.com.vc":                {"q"},
+		"blogsearch.google.com.vn":                {"q"},
+		"blogsearch.google.cv":                    {"q"},
+		"blogsearch.google.cz":                    {"q"},
+		"blogsearch.google.de":                    {"q"},
+		"blogsearch.google.dj":                    {"q"},
+		"blogsearch.google.dk":                    {"q"},
+		"blogsearch.google.dm":                    {"q"},
+		"blogsearch.google.dz":                    {"q"},
+		"blogsearch.google.ee":                    {"q"},
+		"blogsearch.google.es":                    {"q"},
+		"blogsearch.google.fi":                    {"q"},
+		"blogsearch.google.fm":                    {"q"},
+		"blogsearch.google.fr":                    {"q"},
+		"blogsearch.google.ga":                    {"q"},
+		"blogsearch.google.gd":                    {"q"},
+		"blogsearch.google.ge":                    {"q"},
+		"blogsearch.google.gf":                    {"q"},
+		"blogsearch.google.gg":                    {"q"},
+		"blogsearch.google.gl":                    {"q"},
+		"blogsearch.google.gm":                    {"q"},
+		"blogsearch.google.gp":                    {"q"},
+		"blogsearch.google.gr":                    {"q"},
+		"blogsearch.google.gy":                    {"q"},
+		"blogsearch.google.hn":                    {"q"},
+		"blogsearch.google.hr":                    {"q"},
+		"blogsearch.google.ht":                    {"q"},
+		"blogsearch.google.hu":                    {"q"},
+		"blogsearch.google.ie":                    {"q"},
+		"blogsearch.google.im":                    {"q"},
+		"blogsearch.google.io":                    {"q"},
+		"blogsearch.google.iq":                    {"q"},
+		"blogsearch.google.is":                    {"q"},
+		"blogsearch.google.it":                    {"q"},
+		"blogsearch.google.it.ao":                 {"q"},
+		"blogsearch.google.je":                    {"q"},
+		"blogsearch.google.jo":                    {"q"},
+		"blogsearch.google.kg":                    {"q"},
+		"blogsearch.google.ki":                    {"q"},
+		"blogsearch.google.kz":                    {"q"},
+		"blogsearch.google.la":                    {"q"},
+		"blogsearch.google.li":                    {"q"},
+		"blogsearch.google.lk":                    {"q"},
+		"blogsearch.google.lt":                    {"q"},
+		"blogsearch.google.lu":                    {"q"},
+		"blogsearch.google.lv":                    {"q"},
+		"blogsearch.google.md":                    {"q"},
+		"blogsearch.google.me":                    {"q"},
+		"blogsearch.google.mg":                    {"q"},
+		"blogsearch.google.mk":                    {"q"},
+		"blogsearch.google.ml":                    {"q"},
+		"blogsearch.google.mn":                    {"q"},
+		"blogsearch.google.ms":                    {"q"},
+		"blogsearch.google.mu":                    {"q"},
+		"blogsearch.google.mv":                    {"q"},
+		"blogsearch.google.mw":                    {"q"},
+		"blogsearch.google.ne":                    {"q"},
+		"blogsearch.google.nl":                    {"q"},
+		"blogsearch.google.no":                    {"q"},
+		"blogsearch.google.nr":                    {"q"},
+		"blogsearch.google.nu":                    {"q"},
+		"blogsearch.google.pl":                    {"q"},
+		"blogsearch.google.pn":                    {"q"},
+		"blogsearch.google.ps":                    {"q"},
+		"blogsearch.google.pt":                    {"q"},
+		"blogsearch.google.ro":                    {"q"},
+		"blogsearch.google.rs":                    {"q"},
+		"blogsearch.google.ru":                    {"q"},
+		"blogsearch.google.rw":                    {"q"},
+		"blogsearch.google.sc":                    {"q"},
+		"blogsearch.google.se":                    {"q"},
+		"blogsearch.google.sh":                    {"q"},
+		"blogsearch.google.si":                    {"q"},
+		"blogsearch.google.sk":                    {"q"},
+		"blogsearch.google.sm":                    {"q"},
+		"blogsearch.google.sn":                    {"q"},
+		"blogsearch.google.so":                    {"q"},
+		"blogsearch.google.st":                    {"q"},
+		"blogsearch.google.td":                    {"q"},
+		"blogsearch.google.tg":                    {"q"},
+		"blogsearch.google.tk":                    {"q"},
+		"blogsearch.google.tl":                    {"q"},
+		"blogsearch.google.tm":                    {"q"},
+		"blogsearch.google.to":                    {"q"},
+		"blogsearch.google.tt":                    {"q"},
+		"blogsearch.google.us":                    {"q"},
+		"blogsearch.google.vg":                    {"q"},
+		"blogsearch.google.vu":                    {"q"},
+		"blogsearch.google.ws":                    {"q"},
+		"br.search.yahoo.com":                     {"p", "q"},
+		"br.yahoo.com":                            {"p", "q"},
+		"brisbane.t-online.de":                    {"q"},
+		"busca.orange.es":                         {"q", "kw"},
+		"busca.uol.com.br":                        {"q"},
+		"buscador.terra.cl":                       {"query"},
+		"buscador.terra.com.br":                   {"query"},
+		"buscador.terra.es":                       {"query"},
+		"ca.search.yahoo.com":                     {"p", "q"},
+		"ca.yahoo.com":                            {"p", "q"},
+		"cade.searchde.yahoo.com":                 {"p", "q"},
+		"cade.yahoo.com":                          {"p", "q"},
+		"cc.bingj.com":                            {"q", "Q"},
+		"cercato.it":                              {"p", "q"},
+		"cgi.search.biglobe.ne.jp":                {"q"},
+		"charter.net":                             {"q"},
+		"chinese.searchinese.yahoo.com":           {"p", "q"},
+		"chinese.yahoo.com":                       {"p", "q"},
+		"class.hit-parade.com":                    {"p7"},
+		"clck.yandex.by":                          {"text"},
+		"clck.yandex.com":                         {"text"},
+		"clck.yandex.ru":                          {"text"},
+		"clck.yandex.ua":                          {"text"},
+		"clusty.com":                              {"q", "s"},
+		"cn.search.yahoo.com":                     {"p", "q"},
+		"cn.yahoo.com":                            {"p", "q"},
+		"cnn.com":                                 {"q", "query", "Keywords"},
+		"coccoc.com":                              {"q"},
+		"com.google.android.googlequicksearchbox": {"q", "query", "Keywords"},
+		"crawler.com":                             {"q"},
+		"cuil.com":                                {"q"},
+		"daemon-search.com":                       {"q"},
+		"dalesearch.com":                          {"q"},
+		"darkoogle.com":                           {"q", "query", "Keywords"},
+		"dasoertliche.de":                         {"kw"},
+		"de.search.yahoo.com":                     {"p", "q"},
+		"de.yahoo.com":                            {"p", "q"},
+		"digg.com":                                {"s"},
+		"dir.gigablast.com":                       {"q"},
+		"dizionario.it.msn.com":                   {"q", "Q"},
+		"dk.search.yahoo.com":                     {"p", "q"},
+		"dk.yahoo.com":                            {"p", "q"},
+		"dmoz.org":                                {"q"},
+		"dogpile.com":                             {"q", "s"},
+		"duckduckgo.com":                          {"q"},
+		"ducksports.com":                          {"q"},
+		"ecosia.org":                              {"q"},
+		"editors.dmoz.org":                        {"q"},
+		"encrypted.google.com":                    {"q", "query", "Keywords"},
+		"eniro.se":                                {"q", "search_word"},
+		"eo.st":                                   {"x_query"},
+		"es.search.yahoo.com":                     {"p", "q"},
+		"es.yahoo.com":                            {"p", "q"},
+		"espanol.searchpanol.yahoo.com":           {"p", "q"},
+		"espanol.yahoo.com":                       {"p", "q"},
+		"eu.ixquick.com":                          {"query"},
+		"eurip.com":                               {"q"},
+		"euroseek.com":                            {"string"},
+		"everyclick.com":                          {"keyword"},
+		"exalead.com":                             {"q"},
+		"exalead.fr":                              {"q"},
+		"excite.co.jp":                            {"q", "search"},
+		"fastbrowsersearch.com":                   {"q"},
+		"fastweb.it":                              {"q", "query", "Keywords"},
+		"find.tdc.dk":                             {"q", "query", "Keywords"},
+		"find.web.aol.com":                        {"q", "query"},
+		"finderoo.com":                            {"q"},
+		"fireball.de":                             {"q"},
+		"firstsfind.com":                          {"qry"},
+		"fixsuche.de":                             {"q"},
+		"flix.de":                                 {"keyword"},
+		"forestle.mobi":                           {"q"},
+		"forestle.org":                            {"q"},
+		"fr.search.yahoo.com":                     {"p", "q"},
+		"fr.yahoo.com":                            {"p", "q"},
+		"fr2.rpmfind.net":                         {"query"},
+		"fresh-weather.com":                       {"q"},
+		"friendfeed.com":                          {"q"},
+		"gais.cs.ccu.edu.tw":                      {"q"},
+		"geona.net":                               {"q"},
+		"gigablast.com":                           {"q"},
+		"gnadenmeer.de":                           {"keyword"},
+		"go.mail.ru":                              {"q"},
+		"gomeo.com":                               {"Keywords"},
+		"google.ac":                               {"q", "query", "Keywords"},
+		"google.ac/imgres":                        {"q"},
+		"google.ac/products":                      {"q"},
+		"google.ad":                               {"q", "query", "Keywords"},
+		"google.ad/imgres":                        {"q"},
+		"google.ad/products":                      {"q"},
+		"google.ae":                               {"q", "query", "Keywords"},
+		"google.ae/imgres":                        {"q"},
+		"google.ae/products":                      {"q"},
+		"google.am":                               {"q", "query", "Keywords"},
+		"google.am/imgres":                        {"q"},
+		"google.am/products":                      {"q"},
+		"google.as":                               {"q", "query", "Keywords"},
+		"google.as/imgres":                        {"q"},
+		"google.as/products":                      {"q"},
+		"google.at":                               {"q", "query", "Keywords"},
+		"google.at/imgres":                        {"q"},
+		"google.at/products":                      {"q"},
+		"google.az":                               {"q", "query", "Keywords"},
+		"google.az/imgres":                        {"q"},
+		"google.az/products":                      {"q"},
+		"google.ba":                               {"q", "query", "Keywords"},
+		"google.ba/imgres":                        {"q"},
+		"google.ba/products":                      {"q"},
+		"google.be":                               {"q", "query", "Keywords"},
+		"google.be/imgres":                        {"q"},
+		"google.be/products":                      {"q"},
+		"google.bf":                               {"q", "query", "Keywords"},
+		"google.bf/imgres":                        {"q"},
+		"google.bf/products":                      {"q"},
+		"google.bg":                               {"q", "query", "Keywords"},
+		"google.bg/imgres":                        {"q"},
+		"google.bg/products":                      {"q"},
+		"google.bi":                               {"q", "query", "Keywords"},
+		"google.bi/imgres":                        {"q"},
+		"google.bi/products":                      {"q"},
+		"google.bj":                               {"q", "query", "Keywords"},
+		"google.bj/imgres":                        {"q"},
+		"google.bj/products":                      {"q"},
+		"google.bs":                               {"q", "query", "Keywords"},
+		"google.bs/imgres":                        {"q"},
+		"google.bs/products":                      {"q"},
+		"google.by":                               {"q", "query", "Keywords"},
+		"google.by/imgres":                        {"q"},
+		"google.by/products":                      {"q"},
+		"google.ca":                               {"q", "query", "Keywords"},
+		"google.ca/imgres":                        {"q"},
+		"google.ca/products":                      {"q"},
+		"google.cat":                              {"q", "query", "Keywords"},
+		"google.cat/imgres":                       {"q"},
+		"google.cat/products":                     {"q"},
+		"google.cc":                               {"q", "query", "Keywords"},
+		"google.cc/imgres":                        {"q"},
+		"google.cc/products":                      {"q"},
+		"google.cd":                               {"q", "query", "Keywords"},
+		"google.cd/imgres":                        {"q"},
+		"google.cd/products":                      {"q"},
+		"google.cf":                               {"q", "query", "Keywords"},
+		"google.cf/imgres":                        {"q"},
+		"google.cf/products":                      {"q"},
+		"google.cg":                               {"q", "query", "Keywords"},
+		"google.cg/imgres":                        {"q"},
+		"google.cg/products":                      {"q"},
+		"google.ch":                               {"q", "query", "Keywords"},
+		"google.ch/imgres":                        {"q"},
+		"google.ch/products":                      {"q"},
+		"google.ci":                               {"q", "query", "Keywords"},
+		"google.ci/imgres":                        {"q"},
+		"google.ci/products":                      {"q"},
+		"google.cl":                               {"q", "query", "Keywords"},
+		"google.cl/imgres":                        {"q"},
+		"google.cl/products":                      {"q"},
+		"google.cm":                               {"q", "query", "Keywords"},
+		"google.cm/imgres":                        {"q"},
+		"google.cm/products":                      {"q"},
+		"google.cn":                               {"q", "query", "Keywords"},
+		"google.cn/imgres":                        {"q"},
+		"google.cn/products":                      {"q"},
+		"google.co.bw":                            {"q", "query", "Keywords"},
+		"google.co.bw/imgres":                     {"q"},
+		"google.co.bw/products":                   {"q"},
+		"google.co.ck":                            {"q", "query", "Keywords"},
+		"google.co.ck/imgres":                     {"q"},
+		"google.co.ck/products":                   {"q"},
+		"google.co.cr":                            {"q", "query", "Keywords"},
+		"google.co.cr/imgres":                     {"q"},
+		"google.co.cr/products":                   {"q"},
+		"google.co.id":                            {"q", "query", "Keywords"},
+		"google.co.id/imgres":                     {"q"},
+		"google.co.id/products":                   {"q"},
+		"google.co.il":                            {"q", "query", "Keywords"},
+		"google.co.il/imgres":                     {"q"},
+		"google.co.il/products":                   {"q"},
+		"google.co.in":                            {"q", "query", "Keywords"},
+		"google.co.in/imgres":                     {"q"},
+		"google.co.in/products":                   {"q"},
+		"google.co.jp":                            {"q", "query", "Keywords"},
+		"google.co.jp/imgres":                     {"q"},
+		"google.co.jp/products":                   {"q"},
+		"google.co.ke":                            {"q", "query", "Keywords"},
+		"google.co.ke/imgres":                     {"q"},
+		"google.co.ke/products":                   {"q"},
+		"google.co.kr":                            {"q", "query", "Keywords"},
+		"google.co.kr/imgres":                     {"q"},
+		"google.co.kr/products":                   {"q"},
+		"google.co.ls":                            {"q", "query", "Keywords"},
+		"google.co.ls/imgres":                     {"q"},
+		"google.co.ls/products":                   {"q"},
+		"google.co.ma":                            {"q", "query", "Keywords"},
+		"google.co.ma/imgres":                     {"q"},
+		"google.co.ma/products":                   {"q"},
+		"google.co.mz":                            {"q", "query", "Keywords"},
+		"google.co.mz/imgres":                     {"q"},
+		"google.co.mz/products":                   {"q"},
+		"google.co.nz":                            {"q", "query", "Keywords"},
+		"google.co.nz/imgres":                     {"q"},
+		"google.co.nz/products":                   {"q"},
+		"google.co.th":                            {"q", "query", "Keywords"},
+		"google.co.th/imgres":                     {"q"},
+		"google.co.th/products":                   {"q"},
+		"google.co.tz":                            {"q", "query", "Keywords"},
+		"google.co.tz/imgres":                     {"q"},
+		"google.co.tz/products":                   {"q"},
+		"google.co.ug":                            {"q", "query", "Keywords"},
+		"google.co.ug/imgres":                     {"q"},
+		"google.co.ug/products":                   {"q"},
+		"google.co.uk":                            {"q", "query", "Keywords"},
+		"google.co.uk/imgres":                     {"q"},
+		"google.co.uk/products":                   {"q"},
+		"google.co.uz":                            {"q", "query", "Keywords"},
+		"google.co.uz/imgres":                     {"q"},
+		"google.co.uz/products":                   {"q"},
+		"google.co.ve":                            {"q", "query", "Keywords"},
+		"google.co.ve/imgres":                     {"q"},
+		"google.co.ve/products":                   {"q"},
+		"google.co.vi":                            {"q", "query", "Keywords"},
+		"google.co.vi/imgres":                     {"q"},
+		"google.co.vi/products":                   {"q"},
+		"google.co.za":                            {"q", "query", "Keywords"},
+		"google.co.za/imgres":                     {"q"},
+		"google.co.za/products":                   {"q"},
+		"google.co.zm":                            {"q", "query", "Keywords"},
+		"google.co.zm/imgres":                     {"q"},
+		"google.co.zm/products":                   {"q"},
+		"google.co.zw":                            {"q", "query", "Keywords"},
+		"google.co.zw/imgres":                     {"q"},
+		"google.co.zw/products":                   {"q"},
+		"google.com":                              {"q", "query", "Keywords"},
+		"google.com.af":                           {"q", "query", "Keywords"},
+		"google.com.af/imgres":                    {"q"},
+		"google.com.af/products":                  {"q"},
+		"google.com.ag":                           {"q", "query", "Keywords"},
+		"google.com.ag/imgres":                    {"q"},
+		"google.com.ag/products":                  {"q"},
+		"google.com.ai":                           {"q", "query", "Keywords"},
+		"google.com.ai/imgres":                    {"q"},
+		"google.com.ai/products":                  {"q"},
+		"google.com.ar":                           {"q", "query", "Keywords"},
+		"google.com.ar/imgres":                    {"q"},
+		"google.com.ar/products":                  {"q"},
+		"google.com.au":                           {"q", "query", "Keywords"},
+		"google.com.au/imgres":                    {"q"},
+		"google.com.au/products":                  {"q"},
+		"google.com.bd":                           {"q", "query", "Keywords"},
+		"google.com.bd/imgres":                    {"q"},
+		"google.com.bd/products":                  {"q"},
+		"google.com.bh":                           {"q", "query", "Keywords"},
+		"google.com.bh/imgres":                    {"q"},
+		"google.com.bh/products":                  {"q"},
+		"google.com.bn":                           {"q", "query", "Keywords"},
+		"google.com.bn/imgres":                    {"q"},
+		"google.com.bn/products":                  {"q"},
+		"google.com.bo":                           {"q", "query", "Keywords"},
+		"google.com.bo/imgres":                    {"q"},
+		"google.com.bo/products":                  {"q"},
+		"google.com.br":                           {"q", "query", "Keywords"},
+		"google.com.br/imgres":                    {"q"},
+		"google.com.br/products":                  {"q"},
+		"google.com.by":                           {"q", "query", "Keywords"},
+		"google.com.by/imgres":                    {"q"},
+		"google.com.by/products":                  {"q"},
+		"google.com.bz":                           {"q", "query", "Keywords"},
+		"google.com.bz/imgres":                    {"q"},
+		"google.com.bz/products":                  {"q"},
+		"google.com.co":                           {"q", "query", "Keywords"},
+		"google.com.co/imgres":                    {"q"},
+		"google.com.co/products":                  {"q"},
+		"google.com.cu":                           {"q", "query", "Keywords"},
+		"google.com.cu/imgres":                    {"q"},
+		"google.com.cu/products":                  {"q"},
+		"google.com.cy":                           {"q", "query", "Keywords"},
+		"google.com.cy/imgres":                    {"q"},
+		"google.com.cy/products":                  {"q"},
+		"google.com.do":                           {"q", "query", "Keywords"},
+		"google.com.do/imgres":                    {"q"},
+		"google.com.do/products":                  {"q"},
+		"google.com.ec":                           {"q", "query", "Keywords"},
+		"google.com.ec/imgres":                    {"q"},
+		"google.com.ec/products":                  {"q"},
+		"google.com.eg":                           {"q", "query", "Keywords"},
+		"google.com.eg/imgres":                    {"q"},
+		"google.com.eg/products":                  {"q"},
+		"google.com.et":                           {"q", "query", "Keywords"},
+		"google.com.et/imgres":                    {"q"},
+		"google.com.et/products":                  {"q"},
+		"google.com.fj":                           {"q", "query", "Keywords"},
+		"google.com.fj/imgres":                    {"q"},
+		"google.com.fj/products":                  {"q"},
+		"google.com.gh":                           {"q", "query", "Keywords"},
+		"google.com.gh/imgres":                    {"q"},
+		"google.com.gh/products":                  {"q"},
+		"google.com.gi":                           {"q", "query", "Keywords"},
+		"google.com.gi/imgres":                    {"q"},
+		"google.com.gi/products":                  {"q"},
+		"google.com.gt":                           {"q", "query", "Keywords"},
+		"google.com.gt/imgres":                    {"q"},
+		"google.com.gt/products":                  {"q"},
+		"google.com.hk":                           {"q", "query", "Keywords"},
+		"google.com.hk/imgres":                    {"q"},
+		"google.com.hk/products":                  {"q"},
+		"google.com.jm":                           {"q", "query", "Keywords"},
+		"google.com.jm/imgres":                    {"q"},
+		"google.com.jm/products":                  {"q"},
+		"google.com.kh":                           {"q", "query", "Keywords"},
+		"google.com.kh/imgres":                    {"q"},
+		"google.com.kh/products":                  {"q"},
+		"google.com.kw":                           {"q", "query", "Keywords"},
+		"google.com.kw/imgres":                    {"q"},
+		"google.com.kw/products":                  {"q"},
+		"google.com.lb":                           {"q", "query", "Keywords"},
+		"google.com.lb/imgres":                    {"q"},
+		"google.com.lb/products":                  {"q"},
+		"google.com.lc":                           {"q", "query", "Keywords"},
+		"google.com.lc/imgres":                    {"q"},
+		"google.com.lc/products":                  {"q"},
+		"google.com.ly":                           {"q", "query", "Keywords"},
+		"google.com.ly/imgres":                    {"q"},
+		"google.com.ly/products":                  {"q"},
+		"google.com.mt":                           {"q", "query", "Keywords"},
+		"google.com.mt/imgres":                    {"q"},
+		"google.com.mt/products":                  {"q"},
+		"google.com.mx":                           {"q", "query", "Keywords"},
+		"google.com.mx/imgres":                    {"q"},
+		"google.com.mx/products":                  {"q"},
+		"google.com.my":                           {"q", "query", "Keywords"},
+		"google.com.my/imgres":                    {"q"},
+		"google.com.my/products":                  {"q"},
+		"google.com.na":                           {"q", "query", "Keywords"},
+		"google.com.na/imgres":                    {"q"},
+		"google.com.na/products":                  {"q"},
+		"google.com.nf":                           {"q", "query", "Keywords"},
+		"google.com.nf/imgres":                    {"q"},
+		"google.com.nf/products":                  {"q"},
+		"google.com.ng":                           {"q", "query", "Keywords"},
+		"google.com.ng/imgres":                    {"q"},
+		"google.com.ng/products":                  {"q"},
+		"google.com.ni":                           {"q", "query", "Keywords"},
+		"google.com.ni/imgres":                    {"q"},
+		"google.com.ni/products":                  {"q"},
+		"google.com.np":                           {"q", "query", "Keywords"},
+		"google.com.np/imgres":                    {"q"},
+		"google.com.np/products":                  {"q"},
+		"google.com.om":                           {"q", "query", "Keywords"},
+		"google.com.om/imgres":                    {"q"},
+		"google.com.om/products":                  {"q"},
+		"google.com.pa":                           {"q", "query", "Keywords"},
+		"google.com.pa/imgres":                    {"q"},
+		"google.com.pa/products":                  {"q"},
+		"google.com.pe":                           {"q", "query", "Keywords"},
+		"google.com.pe/imgres":                    {"q"},
+		"google.com.pe/products":                  {"q"},
+		"google.com.ph":                           {"q", "query", "Keywords"},
+		"google.com.ph/imgres":                    {"q"},
+		"google.com.ph/products":                  {"q"},
+		"google.com.pk":                           {"q", "query", "Keywords"},
+		"google.com.pk/imgres":                    {"q"},
+		"google.com.pk/products":                  {"q"},
+		"google.com.pr":                           {"q", "query", "Keywords"},
+		"google.com.pr/imgres":                    {"q"},
+		"google.com.pr/products":                  {"q"},
+		"google.com.py":                           {"q", "query", "Keywords"},
+		"google.com.py/imgres":                    {"q"},
+		"google.com.py/products":                  {"q"},
+		"google.com.qa":                           {"q", "query", "Keywords"},
+		"google.com.qa/imgres":                    {"q"},
+		"google.com.qa/products":                  {"q"},
+		"google.com.sa":                           {"q", "query", "Keywords"},
+		"google.com.sa/imgres":                    {"q"},
+		"google.com.sa/products":                  {"q"},
+		"google.com.sb":                           {"q", "query", "Keywords"},
+		"google.com.sb/imgres":                    {"q"},
+		"google.com.sb/products":                  {"q"},
+		"google.com.sg":                           {"q", "query", "Keywords"},
+		"google.com.sg/imgres":                    {"q"},
+		"google.com.sg/products":                  {"q"},
+		"google.com.sl":                           {"q", "query", "Keywords"},
+		"google.com.sl/imgres":                    {"q"},
+		"google.com.sl/products":                  {"q"},
+		"google.com.sv":                           {"q", "query", "Keywords"},
+		"google.com.sv/imgres":                    {"q"},
+		"google.com.sv/products":                  {"q"},
+		"google.com.tj":                           {"q", "query", "Keywords"},
+		"google.com.tj/imgres":                    {"q"},
+		"google.com.tj/products":                  {"q"},
+		"google.com.tn":                           {"q", "query", "Keywords"},
+		"google.com.tn/imgres":                    {"q"},
+		"google.com.tn/products":                  {"q"},
+		"google.com.tr":                           {"q", "query", "Keywords"},
+		"google.com.tr/imgres":                    {"q"},
+		"google.com.tr/products":                  {"q"},
+		"google.com.tw":                           {"q", "query", "Keywords"},
+		"google.com.tw/imgres":                    {"q"},
+		"google.com.tw/products":                  {"q"},
+		"google.com.ua":                           {"q", "query", "Keywords"},
+		"google.com.ua/imgres":                    {"q"},
+		"google.com.ua/products":                  {"q"},
+		"google.com.uy":                           {"q", "query", "Keywords"},
+		"google.com.uy/imgres":                    {"q"},
+		"google.com.uy/products":                  {"q"},
+		"google.com.vc":                           {"q", "query", "Keywords"},
+		"google.com.vc/imgres":                    {"q"},
+		"google.com.vc/products":                  {"q"},
+		"google.com.vn":                           {"q", "query", "Keywords"},
+		"google.com.vn/imgres":                    {"q"},
+		"google.com.vn/products":                  {"q"},
+		"google.com/imgres":                       {"q"},
+		"google.com/products":                     {"q"},
+		"google.cv":                               {"q", "query", "Keywords"},
+		"google.cv/imgres":                        {"q"},
+		"google.cv/products":                      {"q"},
+		"google.cz":                               {"q", "query", "Keywords"},
+		"google.cz/imgres":                        {"q"},
+		"google.cz/products":                      {"q"},
+		"google.de":                               {"q", "query", "Keywords"},
+		"google.de/imgres":                        {"q"},
+		"google.de/products":                      {"q"},
+		"google.dj":                               {"q", "query", "Keywords"},
+		"google.dj/imgres":                        {"q"},
+		"google.dj/products":                      {"q"},
+		"google.dk":                               {"q", "query", "Keywords"},
+		"google.dk/imgres":                        {"q"},
+		"google.dk/products":                      {"q"},
+		"google.dm":                               {"q", "query", "Keywords"},
+		"google.dm/imgres":                        {"q"},
+		"google.dm/products":                      {"q"},
+		"google.dodo.com.au":                      {"q"},
+		"google.dz":                               {"q", "query", "Keywords"},
+		"google.dz/imgres":                        {"q"},
+		"google.dz/products":                      {"q"},
+		"google.ee":                               {"q", "query", "Keywords"},
+		"google.ee/imgres":                        {"q"},
+		"google.ee/products":                      {"q"},
+		"google.es":                               {"q", "query", "Keywords"},
+		"google.es/imgres":                        {"q"},
+		"google.es/products":                      {"q"},
+		"google.fi":                               {"q", "query", "Keywords"},
+		"google.fi/imgres":                        {"q"},
+		"google.fi/products":                      {"q"},
+		"google.fm":                               {"q", "query", "Keywords"},
+		"google.fm/imgres":                        {"q"},
+		"google.fm/products":                      {"q"},
+		"google.fr":                               {"q", "query", "Keywords"},
+		"google.fr/imgres":                        {"q"},
+		"google.fr/products":                      {"q"},
+		"google.ga":                               {"q", "query", "Keywords"},
+		"google.ga/imgres":                        {"q"},
+		"google.ga/products":                      {"q"},
+		"google.gd":                               {"q", "query", "Keywords"},
+		"google.gd/imgres":                        {"q"},
+		"google.gd/products":                      {"q"},
+		"google.ge":                               {"q", "query", "Keywords"},
+		"google.ge/imgres":                        {"q"},
+		"google.ge/products":                      {"q"},
+		"google.gf":                               {"q", "query", "Keywords"},
+		"google.gf/imgres":                        {"q"},
+		"google.gf/products":                      {"q"},
+		"google.gg":                               {"q", "query", "Keywords"},
+		"google.gg/imgres":                        {"q"},
+		"google.gg/products":                      {"q"},
+		"google.gl":                               {"q", "query", "Keywords"},
+		"google.gl/imgres":                        {"q"},
+		"google.gl/products":                      {"q"},
+		"google.gm":                               {"q", "query", "Keywords"},
+		"google.gm/imgres":                        {"q"},
+		"google.gm/products":                      {"q"},
+		"google.gp":                               {"q", "query", "Keywords"},
+		"google.gp/imgres":                        {"q"},
+		"google.gp/products":                      {"q"},
+		"google.gr":                               {"q", "query", "Keywords"},
+		"google.gr/imgres":                        {"q"},
+		"google.gr/products":                      {"q"},
+		"google.gy":                               {"q", "query", "Keywords"},
+		"google.gy/imgres":                        {"q"},
+		"google.gy/products":                      {"q"},
+		"google.hn":                               {"q", "query", "Keywords"},
+		"google.hn/imgres":                        {"q"},
+		"google.hn/products":                      {"q"},
+		"google.hr":                               {"q", "query", "Keywords"},
+		"google.hr/imgres":                        {"q"},
+		"google.hr/products":                      {"q"},
+		"google.ht":                               {"q", "query", "Keywords"},
+		"google.ht/imgres":                        {"q"},
+		"google.ht/products":                      {"q"},
+		"google.hu":                               {"q", "query", "Keywords"},
+		"google.hu/imgres":                        {"q"},
+		"google.hu/products":                      {"q"},
+		"google.ie":                               {"q", "query", "Keywords"},
+		"google.ie/imgres":                        {"q"},
+		"google.ie/products":                      {"q"},
+		"google.im":                               {"q", "query", "Keywords"},
+		"google.im/imgres":                        {"q"},
+		"google.im/products":                      {"q"},
+		"google.interia.pl":                       {"q"},
+		"google.io":                               {"q", "query", "Keywords"},
+		"google.io/imgres":                        {"q"},
+		"google.io/products":                      {"q"},
+		"google.iq":                               {"q", "query", "Keywords"},
+		"google.iq/imgres":                        {"q"},
+		"google.iq/products":                      {"q"},
+		"google.is":                               {"q", "query", "Keywords"},
+		"google.is/imgres":                        {"q"},
+		"google.is/products":                      {"q"},
+		"google.it":                               {"q", "query", "Keywords"},
+		"google.it.ao":                            {"q", "query", "Keywords"},
+		"google.it.ao/imgres":                     {"q"},
+		"google.it.ao/products":                   {"q"},
+		"google.it/imgres":                        {"q"},
+		"google.it/products":                      {"q"},
+		"google.je":                               {"q", "query", "Keywords"},
+		"google.je/imgres":                        {"q"},
+		"google.je/products":                      {"q"},
+		"google.jo":                               {"q", "query", "Keywords"},
+		"google.jo/imgres":                        {"q"},
+		"google.jo/products":                      {"q"},
+		"google.kg":                               {"q", "query", "Keywords"},
+		"google.kg/imgres":                        {"q"},
+		"google.kg/products":                      {"q"},
+		"google.ki":                               {"q", "query", "Keywords"},
+		"google.ki/imgres":                        {"q"},
+		"google.ki/products":                      {"q"},
+		"google.kz":                               {"q", "query", "Keywords"},
+		"google.kz/imgres":                        {"q"},
+		"google.kz/products":                      {"q"},
+		"google.la":                               {"q", "query", "Keywords"},
+		"google.la/imgres":                        {"q"},
+		"google.la/products":                      {"q"},
+		"google.li":                               {"q", "query", "Keywords"},
+		"google.li/imgres":                        {"q"},
+		"google.li/products":                      {"q"},
+		"google.lk":                               {"q", "query", "Keywords"},
+		"google.lk/imgres":                        {"q"},
+		"google.lk/products":                      {"q"},
+		"google.lt":                               {"q", "query", "Keywords"},
+		"google.lt/imgres":                        {"q"},
+		"google.lt/products":                      {"q"},
+		"google.lu":                               {"q", "query", "Keywords"},
+		"google.lu/imgres":                        {"q"},
+		"google.lu/products":                      {"q"},
+		"google.lv":                               {"q", "query", "Keywords"},
+		"google.lv/imgres":                        {"q"},
+		"google.lv/products":                      {"q"},
+		"google.md":                               {"q", "query", "Keywords"},
+		"google.md/imgres":                        {"q"},
+		"google.md/products":                      {"q"},
+		"google.me":                               {"q", "query", "Keywords"},
+		"google.me/imgres":                        {"q"},
+		"google.me/products":                      {"q"},
+		"google.mg":                               {"q", "query", "Keywords"},
+		"google.mg/imgres":                        {"q"},
+		"google.mg/products":                      {"q"},
+		"google.mk":                               {"q", "query", "Keywords"},
+		"google.mk/imgres":                        {"q"},
+		"google.mk/products":                      {"q"},
+		"google.ml":                               {"q", "query", "Keywords"},
+		"google.ml/imgres":                        {"q"},
+		"google.ml/products":                      {"q"},
+		"google.mn":                               {"q", "query", "Keywords"},
+		"google.mn/imgres":                        {"q"},
+		"google.mn/products":                      {"q"},
+		"google.ms":                               {"q", "query", "Keywords"},
+		"google.ms/imgres":                        {"q"},
+		"google.ms/products":                      {"q"},
+		"google.mu":                               {"q", "query", "Keywords"},
+		"google.mu/imgres":                        {"q"},
+		"google.mu/products":                      {"q"},
+		"google.mv":                               {"q", "query", "Keywords"},
+		"google.mv/imgres":                        {"q"},
+		"google.mv/products":                      {"q"},
+		"google.mw":                               {"q", "query", "Keywords"},
+		"google.mw/imgres":                        {"q"},
+		"google.mw/products":                      {"q"},
+		"google.ne":                               {"q", "query", "Keywords"},
+		"google.ne/imgres":                        {"q"},
+		"google.ne/products":                      {"q"},
+		"google.nl":                               {"q", "query", "Keywords"},
+		"google.nl/imgres":                        {"q"},
+		"google.nl/products":                      {"q"},
+		"google.no":                               {"q", "query", "Keywords"},
+		"google.no/imgres":                        {"q"},
+		"google.no/products":                      {"q"},
+		"google.nr":                               {"q", "query", "Keywords"},
+		"google.nr/imgres":                        {"q"},
+		"google.nr/products":                      {"q"},
+		"google.nu":                               {"q", "query", "Keywords"},
+		"google.nu/imgres":                        {"q"},
+		"google.nu/products":                      {"q"},
+		"google.pl":                               {"q", "query", "Keywords"},
+		"google.pl/imgres":                        {"q"},
+		"google.pl/products":                      {"q"},
+		"google.pn":                               {"q", "query", "Keywords"},
+		"google.pn/imgres":                        {"q"},
+		"google.pn/products":                      {"q"},
+		"google.ps":                               {"q", "query", "Keywords"},
+		"google.ps/imgres":                        {"q"},
+		"google.ps/products":                      {"q"},
+		"google.pt":                               {"q", "query", "Keywords"},
+		"google.pt/imgres":                        {"q"},
+		"google.pt/products":                      {"q"},
+		"google.ro":                               {"q", "query", "Keywords"},
+		"google.ro/imgres":                        {"q"},
+		"google.ro/products":                      {"q"},
+		"google.rs":                               {"q", "query", "Keywords"},
+		"google.rs/imgres":                        {"q"},
+		"google.rs/products":                      {"q"},
+		"google.ru":                               {"q", "query", "Keywords"},
+		"google.ru/imgres":                        {"q"},
+		"google.ru/products":                      {"q"},
+		"google.rw":                               {"q", "query", "Keywords"},
+		"google.rw/imgres":                        {"q"},
+		"google.rw/products":                      {"q"},
+		"google.sc":                               {"q", "query", "Keywords"},
+		"google.sc/imgres":                        {"q"},
+		"google.sc/products":                      {"q"},
+		"google.se":                               {"q", "query", "Keywords"},
+		"google.se/imgres":                        {"q"},
+		"google.se/products":                      {"q"},
+		"google.sh":                               {"q", "query", "Keywords"},
+		"google.sh/imgres":                        {"q"},
+		"google.sh/products":                      {"q"},
+		"google.si":                               {"q", "query", "Keywords"},
+		"google.si/imgres":                        {"q"},
+		"google.si/products":                      {"q"},
+		"google.sk":                               {"q", "query", "Keywords"},
+		"google.sk/imgres":                        {"q"},
+		"google.sk/products":                      {"q"},
+		"google.sm":                               {"q", "query", "Keywords"},
+		"google.sm/imgres":                        {"q"},
+		"google.sm/products":                      {"q"},
+		"google.sn":                               {"q", "query", "Keywords"},
+		"google.sn/imgres":                        {"q"},
+		"google.sn/products":                      {"q"},
+		"google.so":                               {"q", "query", "Keywords"},
+		"google.so/imgres":                        {"q"},
+		"google.so/products":                      {"q"},
+		"google.st":                               {"q", "query", "Keywords"},
+		"google.st/imgres":                        {"q"},
+		"google.st/products":                      {"q"},
+		"google.td":                               {"q", "query", "Keywords"},
+		"google.td/imgres":                        {"q"},
+		"google.td/products":                      {"q"},
+		"google.tg":                               {"q", "query", "Keywords"},
+		"google.tg/imgres":                        {"q"},
+		"google.tg/products":                      {"q"},
+		"google.tk":                               {"q", "query", "Keywords"},
+		"google.tk/imgres":                        {"q"},
+		"google.tk/products":                      {"q"},
+		"google.tl":                               {"q", "query", "Keywords"},
+		"google.tl/imgres":                        {"q"},
+		"google.tl/products":                      {"q"},
+		"google.tm":                               {"q", "query", "Keywords"},
+		"google.tm/imgres":                        {"q"},
+		"google.tm/products":                      {"q"},
+		"google.tn":                               {"q", "query", "Keywords"},
+		"google.to":                               {"q", "query", "Keywords"},
+		"google.to/imgres":                        {"q"},
+		"google.to/products":                      {"q"},
+		"google.tt":                               {"q", "query", "Keywords"},
+		"google.tt/imgres":                        {"q"},
+		"google.tt/products":                      {"q"},
+		"google.us":                               {"q", "query", "Keywords"},
+		"google.us/imgres":                        {"q"},
+		"google.us/products":                      {"q"},
+		"google.vg":                               {"q", "query", "Keywords"},
+		"google.vg/imgres":                        {"q"},
+		"google.vg/products":                      {"q"},
+		"google.vu":                               {"q", "query", "Keywords"},
+		"google.vu/imgres":                        {"q"},
+		"google.vu/products":                      {"q"},
+		"google.ws":                               {"q", "query", "Keywords"},
+		"google.ws/products":                      {"q"},
+		"googleearth.de":                          {"q", "query", "Keywords"},
+		"googleearth.fr":                          {"q", "query", "Keywords"},
+		"googlesyndicatedsearch.com":              {"q", "query", "Keywords"},
+		"gooofullsearch.com":                      {"q", "query", "Keywords"},
+		"goyellow.de":                             {"MDN"},
+		"gulesider.no":                            {"q"},
+		"highbeam.com":                            {"q"},
+		"hit-parade.com":                          {"p7"},
+		"hk.search.yahoo.com":                     {"p", "q"},
+		"hk.yahoo.com":                            {"p", "q"},
+		"hledani.tiscali.cz":                      {"q", "key"},
+		"holmes.ge":                               {"q"},
+		"hooseek.com":                             {"recherche"},
+		"hotbot.com":                              {"query"},
+		"html.duckduckgo.com":                     {"q"},
+		"icq.com":                                 {"q"},
+		"ie.search.yahoo.com":                     {"p", "q"},
+		"ie.yahoo.com":                            {"p", "q"},
+		"ilse.nl":                                 {"search_for"},
+		"image.search.naver.com":                  {"query"},
+		"image.yahoo.cn":                          {"p", "q"},
+		"images.ask.com":                          {"q"},
+		"images.google.ac":                        {"q"},
+		"images.google.ad":                        {"q"},
+		"images.google.ae":                        {"q"},
+		"images.google.am":                        {"q"},
+		"images.google.as":                        {"q"},
+		"images.google.at":                        {"q"},
+		"images.google.az":                        {"q"},
+		"images.google.ba":                        {"q"},
+		"images.google.be":                        {"q"},
+		"images.google.bf":                        {"q"},
+		"images.google.bg":                        {"q"},
+		"images.google.bi":                        {"q"},
+		"images.google.bj":                        {"q"},
+		"images.google.bs":                        {"q"},
+		"images.google.by":                        {"q"},
+		"images.google.ca":                        {"q"},
+		"images.google.cat":                       {"q"},
+		"images.google.cc":                        {"q"},
+		"images.google.cd":                        {"q"},
+		"images.google.cf":                        {"q"},
+		"images.google.cg":                        {"q"},
+		"images.google.ch":                        {"q"},
+		"images.google.ci":                        {"q"},
+		"images.google.cl":                        {"q"},
+		"images.google.cm":                        {"q"},
+		"images.google.cn":                        {"q"},
+		"images.google.co.bw":                     {"q"},
+		"images.google.co.ck":                     {"q"},
+		"images.google.co.cr":                     {"q"},
+		"images.google.co.id":                     {"q"},
+		"images.google.co.il":                     {"q"},
+		"images.google.co.in":                     {"q"},
+		"images.google.co.jp":                     {"q"},
+		"images.google.co.ke":                     {"q"},
+		"images.google.co.kr":                     {"q"},
+		"images.google.co.ls":                     {"q"},
+		"images.google.co.ma":                     {"q"},
+		"images.google.co.mz":                     {"q"},
+		"images.google.co.nz":                     {"q"},
+		"images.google.co.th":                     {"q"},
+		"images.google.co.tz":                     {"q"},
+		"images.google.co.ug":                     {"q"},
+		"images.google.co.uk":                     {"q"},
+		"images.google.co.uz":                     {"q"},
+		"images.google.co.ve":                     {"q"},
+		"images.google.co.vi":                     {"q"},
+		"images.google.co.za":                     {"q"},
+		"images.google.co.zm":                     {"q"},
+		"images.google.co.zw":                     {"q"},
+		"images.google.com":                       {"q"},
+		"images.google.com.af":                    {"q"},
+		"images.google.com.ag":                    {"q"},
+		"images.google.com.ai":                    {"q"},
+		"images.google.com.ar":                    {"q"},
+		"images.google.com.au":                    {"q"},
+		"images.google.com.bd":                    {"q"},
+		"images.google.com.bh":                    {"q"},
+		"images.google.com.bn":                    {"q"},
+		"images.google.com.bo":                    {"q"},
+		"images.google.com.br":                    {"q"},
+		"images.google.com.by":                    {"q"},
+		"images.google.com.bz":                    {"q"},
+		"images.google.com.co":                    {"q"},
+		"images.google.com.cu":                    {"q"},
+		"images.google.com.cy":                    {"q"},
+		"images.google.com.do":                    {"q"},
+		"images.google.com.ec":                    {"q"},
+		"images.google.com.eg":                    {"q"},
+		"images.google.com.et":                    {"q"},
+		"images.google.com.fj":                    {"q"},
+		"images.google.com.gh":                    {"q"},
+		"images.google.com.gi":                    {"q"},
+		"images.google.com.gt":                    {"q"},
+		"images.google.com.hk":                    {"q"},
+		"images.google.com.jm":                    {"q"},
+		"images.google.com.kh":                    {"q"},
+		"images.google.com.kw":                    {"q"},
+		"images.google.com.lb":                    {"q"},
+		"images.google.com.lc":                    {"q"},
+		"images.google.com.ly":                    {"q"},
+		"images.google.com.mt":                    {"q"},
+		"images.google.com.mx":                    {"q"},
+		"images.google.com.my":                    {"q"},
+		"images.google.com.na":                    {"q"},
+		"images.google.com.nf":                    {"q"},
+		"images.google.com.ng":                    {"q"},
+		"images.google.com.ni":                    {"q"},
+		"images.google.com.np":                    {"q"},
+		"images.google.com.om":                    {"q"},
+		"images.google.com.pa":                    {"q"},
+		"images.google.com.pe":                    {"q"},
+		"images.google.com.ph":                    {"q"},
+		"images.google.com.pk":                    {"q"},
+		"images.google.com.pr":                    {"q"},
+		"images.google.com.py":                    {"q"},
+		"images.google.com.qa":                    {"q"},
+		"images.google.com.sa":                    {"q"},
+		"images.google.com.sb":                    {"q"},
+		"images.google.com.sg":                    {"q"},
+		"images.google.com.sl":                    {"q"},
+		"images.google.com.sv":                    {"q"},
+		"images.google.com.tj":                    {"q"},
+		"images.google.com.tn":                    {"q"},
+		"images.google.com.tr":                    {"q"},
+		"images.google.com.tw":                    {"q"},
+		"images.google.com.ua":                    {"q"},
+		"images.google.com.uy":                    {"q"},
+		"images.google.com.vc":                    {"q"},
+		"images.google.com.vn":                    {"q"},
+		"images.google.cv":                        {"q"},
+		"images.google.cz":                        {"q"},
+		"images.google.de":                        {"q"},
+		"images.google.dj":                        {"q"},
+		"images.google.dk":                        {"q"},
+		"images.google.dm":                        {"q"},
+		"images.google.dz":                        {"q"},
+		"images.google.ee":                        {"q"},
+		"images.google.es":                        {"q"},
+		"images.google.fi":                        {"q"},
+		"images.google.fm":                        {"q"},
+		"images.google.fr":                        {"q"},
+		"images.google.ga":                        {"q"},
+		"images.google.gd":                        {"q"},
+		"images.google.ge":                        {"q"},
+		"images.google.gf":                        {"q"},
+		"images.google.gg":                        {"q"},
+		"images.google.gl":                        {"q"},
+		"images.google.gm":                        {"q"},
+		"images.google.gp":                        {"q"},
+		"images.google.gr":                        {"q"},
+		"images.google.gy":                        {"q"},
+		"images.google.hn":                        {"q"},
+		"images.google.hr":                        {"q"},
+		"images.google.ht":                        {"q"},
+		"images.google.hu":                        {"q"},
+		"images.google.ie":                        {"q"},
+		"images.google.im":                        {"q"},
+		"images.google.io":                        {"q"},
+		"images.google.iq":                        {"q"},
+		"images.google.is":                        {"q"},
+		"images.google.it":                        {"q"},
+		"images.google.it.ao":                     {"q"},
+		"images.google.je":                        {"q"},
+		"images.google.jo":                        {"q"},
+		"images.google.kg":                        {"q"},
+		"images.google.ki":                        {"q"},
+		"images.google.kz":                        {"q"},
+		"images.google.la":                        {"q"},
+		"images.google.li":                        {"q"},
+		"images.google.lk":                        {"q"},
+		"images.google.lt":                        {"q"},
+		"images.google.lu":                        {"q"},
+		"images.google.lv":                        {"q"},
+		"images.google.md":                        {"q"},
+		"images.google.me":                        {"q"},
+		"images.google.mg":                        {"q"},
+		"images.google.mk":                        {"q"},
+		"images.google.ml":                        {"q"},
+		"images.google.mn":                        {"q"},
+		"images.google.ms":                        {"q"},
+		"images.google.mu":                        {"q"},
+		"images.google.mv":                        {"q"},
+		"images.google.mw":                        {"q"},
+		"images.google.ne":                        {"q"},
+		"images.google.nl":                        {"q"},
+		"images.google.no":                        {"q"},
+		"images.google.nr":                        {"q"},
+		"images.google.nu":                        {"q"},
+		"images.google.pl":                        {"q"},
+		"images.google.pn":                        {"q"},
+		"images.google.ps":                        {"q"},
+		"images.google.pt":                        {"q"},
+		"images.google.ro":                        {"q"},
+		"images.google.rs":                        {"q"},
+		"images.google.ru":                        {"q"},
+		"images.google.rw":                        {"q"},
+		"images.google.sc":                        {"q"},
+		"images.google.se":                        {"q"},
+		"images.google.sh":                        {"q"},
+		"images.google.si":                        {"q"},
+		"images.google.sk":                        {"q"},
+		"images.google.sm":                        {"q"},
+		"images.google.sn":                        {"q"},
+		"images.google.so":                        {"q"},
+		"images.google.st":                        {"q"},
+		"images.google.td":                        {"q"},
+		"images.google.tg":                        {"q"},
+		"images.google.tk":                        {"q"},
+		"images.google.tl":                        {"q"},
+		"images.google.tm":                        {"q"},
+		"images.google.to":                        {"q"},
+		"images.google.tt":                        {"q"},
+		"images.google.us":                        {"q"},
+		"images.google.vg":                        {"q"},
+		"images.google.vu":                        {"q"},
+		"images.google.ws":                        {"q"},
+		"images.search.yahoo.com":                 {"p", "q"},
+		"images.yandex.by":                        {"text"},
+		"images.yandex.com":                       {"text"},
+		"images.yandex.ru":                        {"text"},
+		"images.yandex.ua":                        {"text"},
+		"imagesearch.naver.com":                   {"query"},
+		"in.search.yahoo.com":                     {"p", "q"},
+		"in.yahoo.com":                            {"p", "q"},
+		"inbox.com/search/":                       {"q"},
+		"infospace.com":                           {"q", "s"},
+		"inspsearch.com":                          {"q"},
+		"int.ask.com":                             {"q"},
+		"int.search-results.com":                  {"q"},
+		"isearch.avg.com":                         {"q", "query", "Keywords"},
+		"isearch.babylon.com":                     {"q", "s"},
+		"it.search.yahoo.com":                     {"p", "q"},
+		"it.yahoo.com":                            {"p", "q"},
+		"iwon.ask.com":                            {"q"},
+		"ixquick.com":                             {"query"},
+		"ixquick.de":                              {"query"},
+		"jungle-spider.de":                        {"q"},
+		"junglekey.com":                           {"query"},
+		"junglekey.fr":                            {"query"},
+		"jyxo.1188.cz":                            {"q"},
+		"kataweb.it":                              {"q"},
+		"kf.mysearch.myway.com":                   {"searchfor", "searchFor"},
+		"ki.mysearch.myway.com":                   {"searchfor", "searchFor"},
+		"ko.search.need2find.com":                 {"searchfor"},
+		"kr.search.yahoo.com":                     {"p", "q"},
+		"kr.yahoo.com":                            {"p", "q"},
+		"kununu.com":                              {"q"},
+		"kvasir.no":                               {"q"},
+		"latne.lv":                                {"q"},
+		"lemoteur.fr":                             {"rdata", "kw"},
+		"lemoteur.orange.fr":                      {"q", "kw"},
+		"listings.altavista.com":                  {"q"},
+		"lite.qwant.com":                          {"q"},
+		"liveinternet.ru":                         {"q"},
+		"lo.st":                                   {"x_query"},
+		"looksmart.com":                           {"key"},
+		"lowermybills.com":                        {"leadid"},
+		"lycos.com":                               {"query"},
+		"m.baidu.com":                             {"wd", "word"},
+		"m.bing.com":                              {"q", "Q"},
+		"m.mail.ru":                               {"q"},
+		"m.sm.cn":                                 {"q"},
+		"m.sp.sm.cn":                              {"q"},
+		"m.yz.sm.cn":                              {"q"},
+		"m.yz2.sm.cn":                             {"q"},
+		"maailm.com":                              {"tekst"},
+		"mail.ru":                                 {"q"},
+		"mamma.com":                               {"query"},
+		"mamma75.mamma.com":                       {"query"},
+		"maps.yandex.by":                          {"text"},
+		"maps.yandex.com":                         {"text"},
+		"maps.yandex.ru":                          {"text"},
+		"maps.yandex.ua":                          {"text"},
+		"marktplaats.nl":                          {"query"},
+		"maxwebsearch.com":                        {"query"},
+		"meinestadt.de":                           {"words"},
+		"meta.rrzn.uni-hannover.de":               {"eingabe"},
+		"meta.ua":                                 {"q"},
+		"metacrawler.com":                         {"q", "s"},
+		"metager.de":                              {"eingabe"},
+		"metager2.de":                             {"q"},
+		"mister-wong.com":                         {"Keywords"},
+		"mister-wong.de":                          {"Keywords"},
+		"mobile.virgilio.it":                      {"qs"},
+		"monster.be":                              {"q"},
+		"monster.ch":                              {"q"},
+		"monster.co.uk":                           {"q"},
+		"monster.cz":                              {"q"},
+		"monster.de":                              {"q"},
+		"monster.fi":                              {"q"},
+		"monster.fr":                              {"q"},
+		"monster.ie":                              {"q"},
+		"monster.it":                              {"q"},
+		"monster.lu":                              {"q"},
+		"monstercrawler.com":                      {"qry"},
+		"morfeo.centrum.cz":                       {"q"},
+		"mozbot.co.uk":                            {"q"},
+		"mozbot.com":                              {"q"},
+		"mozbot.fr":                               {"q"},
+		"ms114.mysearch.com":                      {"searchfor", "searchFor"},
+		"ms146.mysearch.com":                      {"searchfor", "searchFor"},
+		"msnbc.msn.com":                           {"q", "Q"},
+		"msxml.excite.com":                        {"q", "search"},
+		"mws.ask.com":                             {"q"},
+		"mx.search.yahoo.com":                     {"p", "q"},
+		"mx.yahoo.com":                            {"p", "q"},
+		"my.daemon-search.com":                    {"q"},
+		"mysearch.com":                            {"searchfor", "searchFor"},
+		"n.maps.yandex.ru":                        {"text"},
+		"najdi.si":                                {"q"},
+		"navigationshilfe.t-online.de":            {"q"},
+		"neti.ee":                                 {"query"},
+		"news.baidu.com":                          {"wd", "word", "kw", "k"},
+		"news.google.ac":                          {"q"},
+		"news.google.ad":                          {"q"},
+		"news.google.ae":                          {"q"},
+		"news.google.am":                          {"q"},
+		"news.google.as":                          {"q"},
+		"news.google.at":                          {"q"},
+		"news.google.az":                          {"q"},
+		"news.google.ba":                          {"q"},
+		"news.google.be":                          {"q"},
+		"news.google.bf":                          {"q"},
+		"news.google.bg":                          {"q"},
+		"news.google.bi":                          {"q"},
+		"news.google.bj":                          {"q"},
+		"news.google.bs":                          {"q"},
+		"news.google.by":                          {"q"},
+		"news.google.ca":                          {"q"},
+		"news.google.cat":                         {"q"},
+		"news.google.cc":                          {"q"},
+		"news.google.cd":                          {"q"},
+		"news.google.cf":                          {"q"},
+		"news.google.cg":                          {"q"},
+		"news.google.ch":                          {"q"},
+		"news.google.ci":                          {"q"},
+		"news.google.cl":                          {"q"},
+		"news.google.cm":                          {"q"},
+		"news.google.cn":                          {"q"},
+		"news.google.co.bw":                       {"q"},
+		"news.google.co.ck":                       {"q"},
+		"news.google.co.cr":                       {"q"},
+		"news.google.co.id":                       {"q"},
+		"news.google.co.il":                       {"q"},
+		"news.google.co.in":                       {"q"},
+		"news.google.co.jp":                       {"q"},
+		"news.google.co.ke":                       {"q"},
+		"news.google.co.kr":                       {"q"},
+		"news.google.co.ls":                       {"q"},
+		"news.google.co.ma":                       {"q"},
+		"news.google.co.mz":                       {"q"},
+		"news.google.co.nz":                       {"q"},
+		"news.google.co.th":                       {"q"},
+		"news.google.co.tz":                       {"q"},
+		"news.google.co.ug":                       {"q"},
+		"news.google.co.uk":                       {"q"},
+		"news.google.co.uz":                       {"q"},
+		"news.google.co.ve":                       {"q"},
+		"news.google.co.vi":                       {"q"},
+		"news.google.co.za":                       {"q"},
+		"news.google.co.zm":                       {"q"},
+		"news.google.co.zw":                       {"q"},
+		"news.google.com":                         {"q"},
+		"news.google.com.af":                      {"q"},
+		"news.google.com.ag":                      {"q"},
+		"news.google.com.ai":                      {"q"},
+		"news.google.com.ar":                      {"q"},
+		"news.google.com.au":                      {"q"},
+		"news.google.com.bd":                      {"q"},
+		"news.google.com.bh":                      {"q"},
+		"news.google.com.bn":                      {"q"},
+		"news.google.com.bo":                      {"q"},
+		"news.google.com.br":                      {"q"},
+		"news.google.com.by":                      {"q"},
+		"news.google.com.bz":                      {"q"},
+		"news.google.com.co":                      {"q"},
+		"news.google.com.cu":                      {"q"},
+		"news.google.com.cy":                      {"q"},
+		"news.google.com.do":                      {"q"},
+		"news.google.com.ec":                      {"q"},
+		"news.google.com.eg":                      {"q"},
+		"news.google.com.et":                      {"q"},
+		"news.google.com.fj":                      {"q"},
+		"news.google.com.gh":                      {"q"},
+		"news.google.com.gi":                      {"q"},
+		"news.google.com.gt":                      {"q"},
+		"news.google.com.hk":                      {"q"},
+		"news.google.com.jm":                      {"q"},
+		"news.google.com.kh":                      {"q"},
+		"news.google.com.kw":                      {"q"},
+		"news.google.com.lb":                      {"q"},
+		"news.google.com.lc":                      {"q"},
+		"news.google.com.ly":                      {"q"},
+		"news.google.com.mt":                      {"q"},
+		"news.google.com.mx":                      {"q"},
+		"news.google.com.my":                      {"q"},
+		"news.google.com.na":                      {"q"},
+		"news.google.com.nf":                      {"q"},
+		"news.google.com.ng":                      {"q"},
+		"news.google.com.ni":                      {"q"},
+		"news.google.com.np":                      {"q"},
+		"news.google.com.om":                      {"q"},
+		"news.google.com.pa":                      {"q"},
+		"news.google.com.pe":                      {"q"},
+		"news.google.com.ph":                      {"q"},
+		"news.google.com.pk":                      {"q"},
+		"news.google.com.pr":                      {"q"},
+		"news.google.com.py":                      {"q"},
+		"news.google.com.qa":                      {"q"},
+		"news.google.com.sa":                      {"q"},
+		"news.google.com.sb":                      {"q"},
+		"news.google.com.sg":                      {"q"},
+		"news.google.com.sl":                      {"q"},
+		"news.google.com.sv":                      {"q"},
+		"news.google.com.tj":                      {"q"},
+		"news.google.com.tn":                      {"q"},
+		"news.google.com.tr":                      {"q"},
+		"news.google.com.tw":                      {"q"},
+		"news.google.com.ua":                      {"q"},
+		"news.google.com.uy":                      {"q"},
+		"news.google.com.vc":                      {"q"},
+		"news.google.com.vn":                      {"q"},
+		"news.google.cv":                          {"q"},
+		"news.google.cz":                          {"q"},
+		"news.google.de":                          {"q"},
+		"news.google.dj":                          {"q"},
+		"news.google.dk":                          {"q"},
+		"news.google.dm":                          {"q"},
+		"news.google.dz":                          {"q"},
+		"news.google.ee":                          {"q"},
+		"news.google.es":                          {"q"},
+		"news.google.fi":                          {"q"},
+		"news.google.fm":                          {"q"},
+		"news.google.fr":                          {"q"},
+		"news.google.ga":                          {"q"},
+		"news.google.gd":                          {"q"},
+		"news.google.ge":                          {"q"},
+		"news.google.gf":                          {"q"},
+		"news.google.gg":                          {"q"},
+		"news.google.gl":                          {"q"},
+		"news.google.gm":                          {"q"},
+		"news.google.gp":                          {"q"},
+		"news.google.gr":                          {"q"},
+		"news.google.gy":                          {"q"},
+		"news.google.hn":                          {"q"},
+		"news.google.hr":                          {"q"},
+		"news.google.ht":                          {"q"},
+		"news.google.hu":                          {"q"},
+		"news.google.ie":                          {"q"},
+		"news.google.im":                          {"q"},
+		"news.google.io":                          {"q"},
+		"news.google.iq":                          {"q"},
+		"news.google.is":                          {"q"},
+		"news.google.it":                          {"q"},
+		"news.google.it.ao":                       {"q"},
+		"news.google.je":                          {"q"},
+		"news.google.jo":                          {"q"},
+		"news.google.kg":                          {"q"},
+		"news.google.ki":                          {"q"},
+		"news.google.kz":                          {"q"},
+		"news.google.la":                          {"q"},
+		"news.google.li":                          {"q"},
+		"news.google.lk":                          {"q"},
+		"news.google.lt":                          {"q"},
+		"news.google.lu":                          {"q"},
+		"news.google.lv":                          {"q"},
+		"news.google.md":                          {"q"},
+		"news.google.me":                          {"q"},
+		"news.google.mg":                          {"q"},
+		"news.google.mk":                          {"q"},
+		"news.google.ml":                          {"q"},
+		"news.google.mn":                          {"q"},
+		"news.google.ms":                          {"q"},
+		"news.google.mu":                          {"q"},
+		"news.google.mv":                          {"q"},
+		"news.google.mw":                          {"q"},
+		"news.google.ne":                          {"q"},
+		"news.google.nl":                          {"q"},
+		"news.google.no":                          {"q"},
+		"news.google.nr":                          {"q"},
+		"news.google.nu":                          {"q"},
+		"news.google.pl":                          {"q"},
+		"news.google.pn":                          {"q"},
+		"news.google.ps":                          {"q"},
+		"news.google.pt":                          {"q"},
+		"news.google.ro":                          {"q"},
+		"news.google.rs":                          {"q"},
+		"news.google.ru":                          {"q"},
+		"news.google.rw":                          {"q"},
+		"news.google.sc":                          {"q"},
+		"news.google.se":                          {"q"},
+		"news.google.sh":                          {"q"},
+		"news.google.si":                          {"q"},
+		"news.google.sk":                          {"q"},
+		"news.google.sm":                          {"q"},
+		"news.google.sn":                          {"q"},
+		"news.google.so":                          {"q"},
+		"news.google.st":                          {"q"},
+		"news.google.td":                          {"q"},
+		"news.google.tg":                          {"q"},
+		"news.google.tk":                          {"q"},
+		"news.google.tl":                          {"q"},
+		"news.google.tm":                          {"q"},
+		"news.google.to":                          {"q"},
+		"news.google.tt":                          {"q"},
+		"news.google.us":                          {"q"},
+		"news.google.vg":                          {"q"},
+		"news.google.vu":                          {"q"},
+		"news.google.ws":                          {"q"},
+		"next.duckduckgo.com":                     {"q"},
+		"nigma.ru":                                {"s"},
+		"no.search.yahoo.com":                     {"p", "q"},
+		"no.yahoo.com":                            {"p", "q"},
+		"nova.rambler.ru":                         {"query", "words"},
+		"nz.search.yahoo.com":                     {"p", "q"},
+		"nz.yahoo.com":                            {"p", "q"},
+		"ocnsearch.goo.ne.jp":                     {"MT"},
+		"one.cn.yahoo.com":                        {"p", "q"},
+		"one.searchn.yahoo.com":                   {"p", "q"},
+		"online.no":                               {"q"},
+		"otsing.delfi.ee":                         {"q"},
+		"p.zhongsou.com":                          {"w"},
+		"paperball.de":                            {"q"},
+		"pesquisa.clix.pt":                        {"question"},
+		"pesquisa.sapo.pt":                        {"q"},
+		"picsearch.com":                           {"q"},
+		"plazoo.com":                              {"q"},
+		"poisk.ru":                                {"q"},
+		"pricerunner.co.uk":                       {"q"},
+		"qbyrd.com":                               {"q"},
+		"qc.search.yahoo.com":                     {"p", "q"},
+		"qc.yahoo.com":                            {"p", "q"},
+		"qualigo.at":                              {"q"},
+		"qualigo.ch":                              {"q"},
+		"qualigo.de":                              {"q"},
+		"qualigo.nl":                              {"q"},
+		"quark.sm.cn":                             {"q"},
+		"qwant.com":                               {"q"},
+		"r.duckduckgo.com":                        {"q"},
+		"recherche.aol.ca":                        {"q", "query"},
+		"recherche.aol.fr":                        {"q", "query"},
+		"recherche.francite.com":                  {"name"},
+		"rechercher.aliceadsl.fr":                 {"q"},
+		"req.-hit-parade.com":                     {"p7"},
+		"ricerca.virgilio.it":                     {"qs"},
+		"ricercaimmagini.virgilio.it":             {"qs"},
+		"ricercanews.virgilio.it":                 {"qs"},
+		"ricercavideo.virgilio.it":                {"qs"},
+		"rpmfind.net":                             {"query"},
+		"ru.search.yahoo.com":                     {"p", "q"},
+		"ru.yahoo.com":                            {"p", "q"},
+		"s1-eu.ixquick.de":                        {"query"},
+		"s1.metacrawler.de":                       {"qry"},
+		"s1.us.ixquick.com":                       {"query"},
+		"s2.metacrawler.de":                       {"qry"},
+		"s2.us.ixquick.com":                       {"query"},
+		"s3.metacrawler.de":                       {"qry"},
+		"s3.us.ixquick.com":                       {"query"},
+		"s4.us.ixquick.com":                       {"query"},
+		"s5.us.ixquick.com":                       {"query"},
+		"s8-eu.ixquick.com":                       {"query"},
+		"safe.duckduckgo.com":                     {"q"},
+		"se.abacho.com":                           {"q"},
+		"se.search.yahoo.com":                     {"p", "q"},
+		"se.yahoo.com":                            {"p", "q"},
+		"search-dyn.tiscali.it":                   {"q", "key"},
+		"search-intl.netscape.com":                {"q", "query"},
+		"search-results.com":                      {"q"},
+		"search.1and1.com":                        {"q"},
+		"search.1und1.de":                         {"su"},
+		"search.alot.com":                         {"q", "query", "Keywords"},
+		"search.altavista.com":                    {"q"},
+		"search.aol.co.uk":                        {"q", "query"},
+		"search.aol.com":                          {"q", "query"},
+		"search.aol.it":                           {"q", "query"},
+		"search.avg.com":                          {"q", "query", "Keywords"},
+		"search.babylon.com":                      {"q"},
+		"search.bluewin.ch":                       {"searchTerm"},
+		"search.bt.com":                           {"p"},
+		"search.certified-toolbar.com":            {"q"},
+		"search.ch":                               {"q"},
+		"search.com":                              {"q"},
+		"search.conduit.com":                      {"q"},
+		"search.darkoogle.com":                    {"q", "query", "Keywords"},
+		"search.daum.net":                         {"q"},
+		"search.earthlink.net":                    {"q"},
+		"search.excite.co.uk":                     {"q", "search"},
+		"search.excite.de":                        {"q", "search"},
+		"search.excite.fr":                        {"q", "search"},
+		"search.excite.it":                        {"q", "search"},
+		"search.excite.nl":                        {"q", "search"},
+		"search.findwide.com":                     {"k"},
+		"search.foxtab.com":                       {"q", "query", "Keywords"},
+		"search.free.fr":                          {"q"},
+		"search.freecause.com":                    {"p"},
+		"search.genieo.com":                       {"q"},
+		"search.globososo.com":                    {"q"},
+		"search.goo.ne.jp":                        {"MT"},
+		"search.hiyo.com":                         {"q", "query", "Keywords"},
+		"search.hp.my.aol.com.au":                 {"q", "query"},
+		"search.hp.my.aol.de":                     {"q", "query"},
+		"search.hp.my.aol.it":                     {"q", "query"},
+		"search.i.ua":                             {"q"},
+		"search.icq.com":                          {"q"},
+		"search.incredibar.com":                   {"q", "query", "Keywords"},
+		"search.incredimail.com":                  {"q", "query", "Keywords"},
+		"search.juno.com":                         {"q", "query", "Keywords"},
+		"search.ke.voila.fr":                      {"rdata", "kw"},
+		"search.kiwee.com":                        {"q", "s"},
+		"search.lilo.org":                         {"q"},
+		"search.lycos.com":                        {"query"},
+		"search.magnetic.com":                     {"q", "s"},
+		"search.media.telstra.com.au":             {"find"},
+		"search.myway.com":                        {"searchfor", "searchFor"},
+		"search.mywebsearch.com":                  {"searchfor", "searchFor"},
+		"search.nate.com":                         {"q"},
+		"search.naver.com":                        {"query"},
+		"search.nifty.com":                        {"q"},
+		"search.offerbox.com":                     {"p", "q"},
+		"search.orange.co.uk":                     {"q", "kw"},
+		"search.peoplepc.com":                     {"q"},
+		"search.qip.ru":                           {"query"},
+		"search.rr.com":                           {"q"},
+		"search.searcharch.yahoo.com":             {"p", "q"},
+		"search.searchcompletion.com":             {"q", "s"},
+		"search.seznam.cz":                        {"q"},
+		"search.snapdo.com":                       {"q"},
+		"search.softonic.com":                     {"q"},
+		"search.sosodesktop.com":                  {"q"},
+		"search.sweetim.com":                      {"q", "query", "Keywords"},
+		"search.tb.ask.com":                       {"searchfor"},
+		"search.tiscali.it":                       {"q", "key"},
+		"search.toolbars.alexa.com":               {"q"},
+		"search.tut.by":                           {"query"},
+		"search.ukr.net":                          {"q"},
+		"search.vindex.nl":                        {"search_for"},
+		"search.walla.co.il":                      {"q", "query", "Keywords"},
+		"search.winamp.com":                       {"q"},
+		"search.www.ee":                           {"query"},
+		"search.yahoo.co.jp":                      {"p", "q"},
+		"search.yahoo.com":                        {"p", "q"},
+		"search.yam.com":                          {"k"},
+		"search.yippy.com":                        {"q", "query"},
+		"search1-1.free.fr":                       {"q"},
+		"search1-2.free.fr":                       {"q"},
+		"search1.incredimail.com":                 {"q", "query", "Keywords"},
+		"search2.incredimail.com":                 {"q", "query", "Keywords"},
+		"search3.incredimail.com":                 {"q", "query", "Keywords"},
+		"search4.incredimail.com":                 {"q", "query", "Keywords"},
+		"searchalot.com":                          {"q"},
+		"searchassist.babylon.com":                {"q"},
+		"searchatlas.centrum.cz":                  {"q"},
+		"searchcanvas.com":                        {"q"},
+		"searches.globososo.com":                  {"q"},
+		"searchresults.verizon.com":               {"q", "query", "Keywords"},
+		"searchthis.com":                          {"q"},
+		"searchy.co.uk":                           {"q"},
+		"serach.centrum.cz":                       {"q"},
+		"serach.comcast.net":                      {"q"},
+		"serach.excite.es":                        {"q", "search"},
+		"sharelook.fr":                            {"keyword"},
+		"skynet.be":                               {"q"},
+		"sm.aport.ru":                             {"r"},
+		"smart.delfi.lv":                          {"q"},
+		"so.360.cn":                               {"q"},
+		"so.com":                                  {"q"},
+		"so.m.sm.cn":                              {"q"},
+		"soso.com":                                {"query", "w"},
+		"sosodesktop.com":                         {"q"},
+		"sougou.com":                              {"query", "w"},
+		"start.duckduckgo.com":                    {"q"},
+		"start.facemoods.com":                     {"q", "s"},
+		"start.iplay.com":                         {"q"},
+		"startgoogle.startpagina.nl":              {"q"},
+		"startsiden.no":                           {"q"},
+		"suche.aol.de":                            {"q", "query"},
+		"suche.aolsvc.de":                         {"q", "query"},
+		"suche.freenet.de":                        {"query", "Keywords"},
+		"suche.gmx.net":                           {"su"},
+		"suche.info":                              {"q"},
+		"suche.t-online.de":                       {"q"},
+		"suche.web.de":                            {"su"},
+		"sucheaol.aol.de":                         {"q", "query"},
+		"suchet2.aol.de":                          {"q", "query"},
+		"suchmaschine.com":                        {"suchstr"},
+		"suchnase.de":                             {"q"},
+		"szukaj.onet.pl":                          {"qt"},
+		"szukaj.wp.pl":                            {"szukaj"},
+		"talktalk.co.uk":                          {"query"},
+		"technorati.com":                          {"q"},
+		"teoma.com":                               {"q"},
+		"thesmartsearch.net":                      {"q"},
+		"tieba.baidu.com":                         {"wd", "word", "kw", "k"},
+		"tixuma.de":                               {"sc"},
+		"toile.com":                               {"q"},
+		"toolbarhome.com":                         {"q"},
+		"tr.abacho.com":                           {"q"},
+		"trouvez.com":                             {"query"},
+		"trovarapido.com":                         {"q"},
+		"trusted--search.com":                     {"w"},
+		"tw.search.yahoo.com":                     {"p", "q"},
+		"tw.yahoo.com":                            {"p", "q"},
+		"twingly.com":                             {"q"},
+		"uk.ask.com":                              {"q"},
+		"uk.search-results.com":                   {"q"},
+		"uk.search.yahoo.com":                     {"p", "q"},
+		"uk.yahoo.com":                            {"p", "q"},
+		"uk.zapmeta.com":                          {"q", "query"},
+		"url.org":                                 {"q"},
+		"us.ixquick.com":                          {"query"},
+		"us.search.yahoo.com":                     {"p", "q"},
+		"us.yahoo.com":                            {"p", "q"},
+		"verden.abcsok.no":                        {"q"},
+		"video.google.com":                        {"q"},
+		"vinden.nl":                               {"q"},
+		"vindex.nl":                               {"search_for"},
+		"viview.inspsearch.com":                   {"q"},
+		"vshare.toolbarhome.com":                  {"q"},
+		"walhello.com":                            {"key"},
+		"walhello.de":                             {"key"},
+		"walhello.info":                           {"key"},
+		"walhello.nl":                             {"key"},
+		"web.ask.com":                             {"q"},
+		"web.canoe.ca":                            {"q"},
+		"web.gougou.com":                          {"wd", "word", "kw", "k"},
+		"web.nl":                                  {"zoekwoord"},
+		"web.toile.com":                           {"q"},
+		"web.volny.cz":                            {"search"},
+		"webcache.googleusercontent.com":          {"q", "query", "Keywords"},
+		"webcrawler.com":                          {"q", "s"},
+		"webfetch.com":                            {"q", "s"},
+		"weborama.com":                            {"QUERY"},
+		"websearch.com":                           {"qkw", "q"},
+		"websearch.cs.com":                        {"query"},
+		"websearch.rakuten.co.jp":                 {"qt"},
+		"witch.de":                                {"search"},
+		"www1.astronaut.at":                       {"q"},
+		"www1.baidu.com":                          {"wd", "word", "kw", "k"},
+		"www1.dastelefonbuch.de":                  {"kw"},
+		"www2.austronaut.at":                      {"q"},
+		"www3.zoek.nl":                            {"q"},
+		"x-recherche.com":                         {"MOTS"},
+		"ya.ru":                                   {"text"},
+		"yahoo.co.jp":                             {"p", "q"},
+		"yahoo.com":                               {"p", "q"},
+		"yandex.by":                               {"text"},
+		"yandex.com":                              {"text"},
+		"yandex.com.tr":                           {"text"},
+		"yandex.kz":                               {"text"},
+		"yandex.ru":                               {"text"},
+		"yandex.tr":                               {"text"},
+		"yandex.ua":                               {"text"},
+		"yandex.uz":                               {"text"},
+		"yasni.at":                                {"query"},
+		"yasni.ch":                                {"query"},
+		"yasni.co.uk":                             {"query"},
+		"yasni.com":                               {"query"},
+		"yasni.de":                                {"query"},
+		"yatedo.com":                              {"q"},
+		"yatedo.fr":                               {"q"},
+		"yougoo.fr":                               {"q"},
+		"ys.mirostart.com":                        {"p", "q"},
+		"yz.m.sm.cn":                              {"q"},
+		"zapmeta.com":                             {"q", "query"},
+		"zapmeta.de":                              {"q", "query"},
+		"zapmeta.nl":                              {"q", "query"},
+		"zhidao.baidu.com":                        {"wd", "word", "kw", "k"},
+		"zoeken.nl":                               {"q"},
+		"zoohoo.cz":                               {"q"},
+	}
 )
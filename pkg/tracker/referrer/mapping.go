@@ -0,0 +1,204 @@
+package referrer
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	mappings       atomic.Pointer[Mappings]
+	clientMappings sync.Map
+)
+
+// Mapping is the name and optional icon, category, and search parameters for a referrer.
+type Mapping struct {
+	Name     string
+	Icon     string
+	Category string
+
+	// Parameters are the query parameters containing the search term, like "q" for "https://duckduckgo.com/?q=pirsch".
+	Parameters []string
+}
+
+// Mappings maps referrer hostnames to a Mapping.
+// The key is the lowercase hostname without "www.", optionally followed by a path, like "example.com/blog".
+type Mappings map[string]Mapping
+
+// mappingFile is the format of mapping.json, with an optional icon.
+// The top-level key is the category.
+type mappingFile map[string]map[string]struct {
+	Domains    []string `json:"domains"`
+	Parameters []string `json:"parameters"`
+	Icon       string   `json:"icon"`
+}
+
+// LoadMappings reads the mappings from given JSON files.
+// Later files take precedence over earlier ones. The format is the same as for the embedded mapping.json:
+//
+//	{"search": {"Name": {"domains": ["example.com", "example.com/search"], "parameters": ["q"], "icon": "https://example.com/favicon.ico"}}}
+//
+// The category "unknown" is stored as an empty category.
+func LoadMappings(paths ...string) (Mappings, error) {
+	result := make(Mappings)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := ParseMappings(data)
+
+		if err != nil {
+			return nil, fmt.Errorf("referrer: error parsing mappings from %s: %w", path, err)
+		}
+
+		for domain, mapping := range m {
+			result[domain] = mapping
+		}
+	}
+
+	return result, nil
+}
+
+// ParseMappings parses the mappings from JSON. See LoadMappings for the format.
+func ParseMappings(data []byte) (Mappings, error) {
+	var file mappingFile
+
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	result := make(Mappings)
+
+	for category, names := range file {
+		if category == "unknown" {
+			category = ""
+		}
+
+		for name, entry := range names {
+			for _, domain := range entry.Domains {
+				result[domain] = Mapping{
+					Name:       name,
+					Icon:       entry.Icon,
+					Category:   category,
+					Parameters: entry.Parameters,
+				}
+			}
+		}
+	}
+
+	return result.normalize(), nil
+}
+
+// SetMappings sets additional mappings for all clients. They take precedence over the embedded list.
+// Passing nil removes them.
+func SetMappings(m Mappings) {
+	if m == nil {
+		mappings.Store(nil)
+		return
+	}
+
+	m = m.normalize()
+	mappings.Store(&m)
+}
+
+// SetClientMappings sets additional mappings for given client.
+// They take precedence over the mappings set using SetMappings and the embedded list. Passing nil removes them.
+func SetClientMappings(clientID uint64, m Mappings) {
+	if m == nil {
+		clientMappings.Delete(clientID)
+		return
+	}
+
+	clientMappings.Store(clientID, m.normalize())
+}
+
+// Name returns the name, icon, and category for a referrer URL as stored for sessions, like "https://example.com/blog".
+// It is empty for referrers that are not a URL.
+// Use it with db.Store.UpdateReferrers to update historic data after changing the mappings.
+func Name(clientID uint64, referrer string) (string, string, string) {
+	if strings.HasPrefix(strings.ToLower(referrer), androidAppPrefix) {
+		name, icon := androidAppResolver.Load().get(referrer)
+		return name, icon, ""
+	}
+
+	u, err := url.ParseRequestURI(referrer)
+
+	if err != nil || u.Hostname() == "" {
+		return "", "", ""
+	}
+
+	path := u.Path
+
+	if path == "/" {
+		path = ""
+	}
+
+	mapping := lookup(clientID, util.StripWWW(strings.ToLower(u.Hostname())), path)
+	return mapping.Name, mapping.Icon, mapping.Category
+}
+
+func lookup(clientID uint64, hostname, path string) Mapping {
+	if m, ok := clientMappings.Load(clientID); ok {
+		if mapping, found := m.(Mappings).find(hostname, path); found {
+			return mapping
+		}
+	}
+
+	if m := mappings.Load(); m != nil {
+		if mapping, found := m.find(hostname, path); found {
+			return mapping
+		}
+	}
+
+	key := hostname + path
+	name := groups[key]
+
+	if name == "" {
+		key = hostname
+		name = groups[key]
+
+		if name == "" {
+			return Mapping{Name: hostname}
+		}
+	}
+
+	return Mapping{
+		Name:       name,
+		Category:   categories[key],
+		Parameters: searchParameters[key],
+	}
+}
+
+func (m Mappings) find(hostname, path string) (Mapping, bool) {
+	if path != "" {
+		if mapping, found := m[hostname+strings.ToLower(path)]; found {
+			return mapping, true
+		}
+	}
+
+	mapping, found := m[hostname]
+	return mapping, found
+}
+
+func (m Mappings) normalize() Mappings {
+	result := make(Mappings, len(m))
+
+	for domain, mapping := range m {
+		domain = util.StripWWW(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "/"))
+
+		if domain != "" && mapping.Name != "" {
+			result[domain] = mapping
+		}
+	}
+
+	return result
+}
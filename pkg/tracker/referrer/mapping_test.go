@@ -0,0 +1,193 @@
+package referrer
+
+import (
+	"context"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/db"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseMappings(t *testing.T) {
+	m, err := ParseMappings([]byte(`{
+		"partner": {
+			"Partner": {"domains": ["www.Partner.com", "partner.io/"], "icon": "https://partner.com/icon.png"},
+			"Partner Blog": {"domains": ["partner.com/blog"]}
+		},
+		"search": {
+			"Wiki": {"domains": ["wiki.internal"], "parameters": ["search"]}
+		},
+		"unknown": {
+			"Empty": {"domains": [" "]},
+			"Other": {"domains": ["other.com"]}
+		}
+	}`))
+	assert.NoError(t, err)
+	assert.Len(t, m, 5)
+	assert.Equal(t, Mapping{Name: "Partner", Icon: "https://partner.com/icon.png", Category: "partner"}, m["partner.com"])
+	assert.Equal(t, Mapping{Name: "Partner", Icon: "https://partner.com/icon.png", Category: "partner"}, m["partner.io"])
+	assert.Equal(t, Mapping{Name: "Partner Blog", Category: "partner"}, m["partner.com/blog"])
+	assert.Equal(t, Mapping{Name: "Wiki", Category: pkg.ReferrerCategorySearch, Parameters: []string{"search"}}, m["wiki.internal"])
+	assert.Equal(t, Mapping{Name: "Other"}, m["other.com"])
+	_, err = ParseMappings([]byte(`invalid`))
+	assert.Error(t, err)
+}
+
+func TestLoadMappings(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.json")
+	second := filepath.Join(dir, "second.json")
+	assert.NoError(t, os.WriteFile(first, []byte(`{"a": {"First": {"domains": ["first.com", "both.com"]}}}`), 0644))
+	assert.NoError(t, os.WriteFile(second, []byte(`{"a": {"Second": {"domains": ["second.com", "both.com"]}}}`), 0644))
+	m, err := LoadMappings(first, second)
+	assert.NoError(t, err)
+	assert.Len(t, m, 3)
+	assert.Equal(t, "First", m["first.com"].Name)
+	assert.Equal(t, "Second", m["second.com"].Name)
+	assert.Equal(t, "Second", m["both.com"].Name)
+	_, err = LoadMappings(filepath.Join(dir, "does-not-exist.json"))
+	assert.Error(t, err)
+	_, err = LoadMappings("mapping.json", "mapping-snowplow.json")
+	assert.NoError(t, err)
+}
+
+func TestGetForClient(t *testing.T) {
+	defer func() {
+		SetMappings(nil)
+		SetClientMappings(1, nil)
+	}()
+	SetMappings(Mappings{
+		"partner.com":      {Name: "Partner", Icon: "https://partner.com/icon.png"},
+		"partner.com/blog": {Name: "Partner Blog"},
+		"google.com":       {Name: "Search"},
+	})
+	SetClientMappings(1, Mappings{
+		"www.partner.com": {Name: "Our Partner"},
+	})
+	input := []struct {
+		clientID uint64
+		referrer string
+		name     string
+		icon     string
+	}{
+		{0, "https://partner.com/", "Partner", "https://partner.com/icon.png"},
+		{0, "https://www.partner.com/Blog", "Partner Blog", ""},
+		{0, "https://google.com/", "Search", ""},
+		{0, "https://www.facebook.com/", "Facebook", ""},
+		{0, "https://unknown.com/", "unknown.com", ""},
+		{1, "https://partner.com/", "Our Partner", ""},
+		{1, "https://partner.com/blog", "Our Partner", ""},
+		{1, "https://google.com/", "Search", ""},
+		{2, "https://partner.com/", "Partner", "https://partner.com/icon.png"},
+	}
+
+	for _, in := range input {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Referer", in.referrer)
+		ref := GetForClient(r, in.clientID, "", "")
+		assert.Equal(t, in.name, ref.Name)
+		assert.Equal(t, in.icon, ref.Icon)
+		name, icon, _ := Name(in.clientID, in.referrer)
+		assert.Equal(t, in.name, name)
+		assert.Equal(t, in.icon, icon)
+	}
+
+	SetMappings(nil)
+	SetClientMappings(1, nil)
+	ref := GetForClient(httptest.NewRequest(http.MethodGet, "/?ref=https://partner.com", nil), 1, "", "")
+	assert.Equal(t, "partner.com", ref.Name)
+	assert.Empty(t, ref.Icon)
+}
+
+func TestName(t *testing.T) {
+	name, _, category := Name(0, "https://www.facebook.com/path")
+	assert.Equal(t, "Facebook", name)
+	assert.Equal(t, pkg.ReferrerCategorySocial, category)
+	name, _, _ = Name(0, androidAppPrefix+"com.Slack")
+	assert.Equal(t, "Slack", name)
+	name, _, _ = Name(0, "Newsletter")
+	assert.Empty(t, name)
+	name, _, _ = Name(0, "")
+	assert.Empty(t, name)
+}
+
+func TestUpdateReferrers(t *testing.T) {
+	defer SetClientMappings(1, nil)
+	client := db.NewClientMock()
+	now := time.Now()
+	assert.NoError(t, client.SaveSessions([]model.Session{
+		{ClientID: 1, Time: now, Referrer: "https://partner.com", ReferrerName: "partner.com"},
+		{ClientID: 1, Time: now.Add(time.Second), Referrer: "https://other.com", ReferrerName: "other.com"},
+		{ClientID: 1, Time: now.Add(time.Second * 2), Referrer: "Newsletter", ReferrerName: "Newsletter"},
+		{ClientID: 2, Time: now.Add(time.Second * 3), Referrer: "https://partner.com", ReferrerName: "partner.com"},
+	}))
+	assert.NoError(t, client.SavePageViews([]model.PageView{
+		{ClientID: 1, Referrer: "https://partner.com", ReferrerName: "partner.com"},
+	}))
+	assert.NoError(t, client.SaveEvents([]model.Event{
+		{ClientID: 1, Referrer: "https://partner.com", ReferrerName: "partner.com"},
+	}))
+	SetClientMappings(1, Mappings{"partner.com": {Name: "Partner", Icon: "https://partner.com/icon.png", Category: pkg.ReferrerCategorySocial}})
+	n, err := client.UpdateReferrers(context.Background(), 1, func(referrer string) (string, string, string) {
+		return Name(1, referrer)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	sessions := client.GetSessions()
+	assert.Equal(t, "Partner", sessions[0].ReferrerName)
+	assert.Equal(t, "https://partner.com/icon.png", sessions[0].ReferrerIcon)
+	assert.Equal(t, pkg.ReferrerCategorySocial, sessions[0].ReferrerCategory)
+	assert.Equal(t, "other.com", sessions[1].ReferrerName)
+	assert.Equal(t, "Newsletter", sessions[2].ReferrerName)
+	assert.Equal(t, "partner.com", sessions[3].ReferrerName)
+	assert.Equal(t, "Partner", client.GetPageViews()[0].ReferrerName)
+	assert.Equal(t, pkg.ReferrerCategorySocial, client.GetPageViews()[0].ReferrerCategory)
+	assert.Equal(t, "Partner", client.GetEvents()[0].ReferrerName)
+	assert.Equal(t, "https://partner.com/icon.png", client.GetEvents()[0].ReferrerIcon)
+}
+
+func TestGetForClientCategoryAndKeyword(t *testing.T) {
+	defer SetClientMappings(1, nil)
+	SetClientMappings(1, Mappings{
+		"wiki.internal": {Name: "Wiki", Category: pkg.ReferrerCategorySearch, Parameters: []string{"search"}},
+	})
+	input := []struct {
+		referrer string
+		name     string
+		category string
+		keyword  string
+	}{
+		{"https://duckduckgo.com/?q=pirsch+analytics&ia=web", "DuckDuckGo", pkg.ReferrerCategorySearch, "pirsch analytics"},
+		{"https://html.duckduckgo.com/html/?q=%22web%20analytics%22%20%26%20privacy", "DuckDuckGo", pkg.ReferrerCategorySearch, `"web analytics" & privacy`},
+		{"https://yandex.ru/search/?text=pirsch&lr=213", "Yandex", pkg.ReferrerCategorySearch, "pirsch"},
+		{"https://www.baidu.com/s?ie=utf-8&wd=%E7%BD%91%E7%AB%99%E5%88%86%E6%9E%90", "Baidu ", pkg.ReferrerCategorySearch, "网站分析"},
+		{"https://www.bing.com/search?Q=pirsch#anchor", "Bing", pkg.ReferrerCategorySearch, "pirsch"},
+		{"https://www.google.com/", "Google", pkg.ReferrerCategorySearch, ""},
+		{"https://www.google.com/search?q=++", "Google", pkg.ReferrerCategorySearch, ""},
+		{"https://www.facebook.com/", "Facebook", pkg.ReferrerCategorySocial, ""},
+		{"https://mail.yandex.ru/", "Yandex", pkg.ReferrerCategoryEmail, ""},
+		{"https://www.googleadservices.com/pagead/aclk?q=ignored", "Google", pkg.ReferrerCategoryPaid, ""},
+		{"https://support.google.com/?q=ignored", "Google", "", ""},
+		{"https://wiki.internal/index.php?search=pirsch", "Wiki", pkg.ReferrerCategorySearch, "pirsch"},
+		{"https://unknown.com/?q=ignored", "unknown.com", "", ""},
+	}
+
+	for _, in := range input {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Referer", in.referrer)
+		ref := GetForClient(r, 1, "", "")
+		assert.Equal(t, in.name, ref.Name, in.referrer)
+		assert.Equal(t, in.category, ref.Category, in.referrer)
+		assert.Equal(t, in.keyword, ref.Keyword, in.referrer)
+		assert.NotContains(t, ref.URL, "?")
+	}
+
+	ref := GetForClient(httptest.NewRequest(http.MethodGet, "/?utm_source=Newsletter", nil), 1, "", "")
+	assert.Equal(t, Referrer{Name: "Newsletter"}, ref)
+}
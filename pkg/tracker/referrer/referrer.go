@@ -9,7 +9,9 @@ import (
 	"net/netip"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
+	"sync/atomic"
 )
 
 // QueryParams is a list of query parameters to set the referrer.
@@ -24,7 +26,26 @@ var QueryParams = []struct {
 	{"utm_source", true},
 }
 
-var isDomain = regexp.MustCompile("^.*\\.[a-zA-Z]+$")
+var (
+	isDomain  = regexp.MustCompile("^.*\\.[a-zA-Z]+$")
+	blacklist atomic.Pointer[util.Matcher]
+)
+
+func init() {
+	blacklist.Store(util.NewMatcher(defaultBlacklist))
+}
+
+// SetBlacklist replaces the keywords used by Ignore, which is the DefaultBlacklist by default.
+// The keywords must be lowercase.
+func SetBlacklist(list []string) {
+	blacklist.Store(util.NewMatcher(list))
+}
+
+// DefaultBlacklist returns a copy of the referrer keywords ignored by default.
+// To extend it, append to the copy and pass it to SetBlacklist.
+func DefaultBlacklist() []string {
+	return slices.Clone(defaultBlacklist)
+}
 
 // Ignore returns whether a referrer should be ignored or not.
 func Ignore(r *http.Request) bool {
@@ -44,19 +65,38 @@ func Ignore(r *http.Request) bool {
 	_, found := hostnameBlacklist[referrer]
 
 	// filter for bot keywords
-	referrer = strings.ToLower(referrer)
+	return found || blacklist.Load().Contains(strings.ToLower(referrer))
+}
 
-	for _, botReferrer := range Blacklist {
-		if strings.Contains(referrer, botReferrer) {
-			return true
-		}
-	}
+// Referrer is the referrer for a request.
+type Referrer struct {
+	// URL is the referrer URL without query parameters and anchor, like "https://example.com/path".
+	// It is empty for referrers that are not a URL, like a utm_source.
+	URL string
+
+	// Name is the name of the referrer, like "Google".
+	Name string
+
+	// Icon is the optional icon of the referrer.
+	Icon string
 
-	return found
+	// Category is the category of the referrer, like pkg.ReferrerCategorySearch.
+	Category string
+
+	// Keyword is the search term for search engines that still pass it.
+	Keyword string
 }
 
-// Get returns the referrer for given request.
+// Get returns the referrer, name, and icon for given request.
 func Get(r *http.Request, ref, requestHostname string) (string, string, string) {
+	referrer := GetForClient(r, 0, ref, requestHostname)
+	return referrer.URL, referrer.Name, referrer.Icon
+}
+
+// GetForClient returns the Referrer for given request and client.
+// The name, icon, and category are taken from the mappings for the client first (see SetClientMappings),
+// then from the mappings set using SetMappings, and last from the embedded list.
+func GetForClient(r *http.Request, clientID uint64, ref, requestHostname string) Referrer {
 	referrer := ""
 
 	if ref != "" {
@@ -66,18 +106,27 @@ func Get(r *http.Request, ref, requestHostname string) (string, string, string)
 	}
 
 	if referrer == "" {
-		return "", "", ""
+		return Referrer{}
 	}
 
 	if strings.HasPrefix(strings.ToLower(referrer), androidAppPrefix) {
-		name, icon := androidAppCache.get(referrer)
-		return referrer, name, icon
+		name, icon := androidAppResolver.Load().get(referrer)
+		return Referrer{
+			URL:  referrer,
+			Name: name,
+			Icon: icon,
+		}
 	}
 
 	var u *url.URL
 	var err error
+	query := ""
 
 	if strings.HasPrefix(strings.ToLower(referrer), "http") {
+		if _, q, found := strings.Cut(referrer, "?"); found {
+			query = q
+		}
+
 		referrer, err = url.QueryUnescape(referrer)
 
 		if err == nil {
@@ -91,18 +140,18 @@ func Get(r *http.Request, ref, requestHostname string) (string, string, string)
 
 	if u == nil || err != nil {
 		if isIP(referrer) {
-			return "", "", ""
+			return Referrer{}
 		}
 
 		// accept non-url referrers (from utm_source for example)
-		return "", strings.TrimSpace(referrer), ""
+		return Referrer{Name: strings.TrimSpace(referrer)}
 	}
 
 	// the subdomain for requestHostname is already stripped at this point (any, not just www)
 	hostname := util.StripWWW(strings.ToLower(u.Hostname()))
 
 	if hostname == requestHostname {
-		return "", "", ""
+		return Referrer{}
 	}
 
 	if u.Path == "/" {
@@ -110,24 +159,22 @@ func Get(r *http.Request, ref, requestHostname string) (string, string, string)
 	}
 
 	if isIP(hostname) {
-		return "", "", ""
+		return Referrer{}
 	}
 
-	name := groups[hostname+u.Path]
-
-	if name == "" {
-		name = groups[hostname]
-
-		if name == "" {
-			name = hostname
-		}
-	}
+	mapping := lookup(clientID, hostname, u.Path)
 
 	// remove query parameters and anchor
 	u.Host = strings.ToLower(u.Host)
 	u.RawQuery = ""
 	u.Fragment = ""
-	return u.String(), name, ""
+	return Referrer{
+		URL:      u.String(),
+		Name:     mapping.Name,
+		Icon:     mapping.Icon,
+		Category: mapping.Category,
+		Keyword:  getKeyword(query, mapping.Parameters),
+	}
 }
 
 func getFromHeaderOrQuery(r *http.Request) string {
@@ -144,6 +191,24 @@ func getFromHeaderOrQuery(r *http.Request) string {
 	return fromHeader
 }
 
+func getKeyword(query string, parameters []string) string {
+	if query == "" || len(parameters) == 0 {
+		return ""
+	}
+
+	// remove the anchor
+	query, _, _ = strings.Cut(query, "#")
+	values, _ := url.ParseQuery(query)
+
+	for _, param := range parameters {
+		if keyword := strings.Join(strings.Fields(values.Get(param)), " "); keyword != "" {
+			return keyword
+		}
+	}
+
+	return ""
+}
+
 func isIP(referrer string) bool {
 	referrer = strings.Trim(referrer, "/")
 
@@ -1,11 +1,13 @@
 package referrer
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestGet(t *testing.T) {
@@ -240,18 +242,53 @@ func TestStripSubdomain(t *testing.T) {
 }
 
 func TestGetAndroidApp(t *testing.T) {
+	SetAndroidAppResolver(NewAndroidAppResolver(AndroidAppResolverConfig{
+		Lookup: func(ctx context.Context, packageName string) (AndroidApp, error) {
+			switch packageName {
+			case "com.Slack":
+				return AndroidApp{Name: "Slack", Icon: "https://example.com/slack.png"}, nil
+			case "com.pinterest":
+				return AndroidApp{Name: "Pinterest", Icon: "https://example.com/pinterest.png"}, nil
+			case "com.example.app":
+				return AndroidApp{Name: "Example", Icon: "https://example.com/icon.png"}, nil
+			}
+
+			return AndroidApp{}, ErrAndroidAppNotFound
+		},
+	}))
+	defer SetAndroidAppResolver(nil)
 	r := httptest.NewRequest(http.MethodGet, "/", nil)
 	r.Header.Add("Referer", androidAppPrefix+"com.Slack")
+	assert.Eventually(t, func() bool {
+		_, _, icon := Get(r, "", "")
+		return icon != ""
+	}, time.Second, time.Millisecond*10)
 	_, name, icon := Get(r, "", "")
 	assert.Equal(t, "Slack", name)
 	assert.NotEmpty(t, icon)
 	r = httptest.NewRequest(http.MethodGet, "/", nil)
 	r.Header.Add("Referer", androidAppPrefix+"com.pinterest/")
+	assert.Eventually(t, func() bool {
+		_, _, icon := Get(r, "", "")
+		return icon != ""
+	}, time.Second, time.Millisecond*10)
 	_, name, icon = Get(r, "", "")
 	assert.Equal(t, "Pinterest", name)
 	assert.NotEmpty(t, icon)
-	r.Header.Set("Referer", androidAppPrefix+"does-not-exist")
+	r.Header.Set("Referer", androidAppPrefix+"com.example.app")
 	ref, name, icon := Get(r, "", "")
+	assert.Equal(t, androidAppPrefix+"com.example.app", ref)
+	assert.Empty(t, name)
+	assert.Empty(t, icon)
+	assert.Eventually(t, func() bool {
+		_, name, _ = Get(r, "", "")
+		return name != ""
+	}, time.Second, time.Millisecond*10)
+	_, name, icon = Get(r, "", "")
+	assert.Equal(t, "Example", name)
+	assert.Equal(t, "https://example.com/icon.png", icon)
+	r.Header.Set("Referer", androidAppPrefix+"does-not-exist")
+	ref, name, icon = Get(r, "", "")
 	assert.Equal(t, androidAppPrefix+"does-not-exist", ref)
 	assert.Empty(t, name)
 	assert.Empty(t, icon)
@@ -313,3 +350,29 @@ func TestIgnore(t *testing.T) {
 
 	assert.Empty(t, acknowledged)
 }
+
+func TestSetBlacklist(t *testing.T) {
+	defer SetBlacklist(defaultBlacklist)
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("Referer", "https://partner.com")
+	assert.False(t, Ignore(req))
+	SetBlacklist([]string{"partner"})
+	assert.True(t, Ignore(req))
+	req.Header.Set("Referer", "-1 OR 3*2=6 AND 707=707")
+	assert.False(t, Ignore(req))
+	SetBlacklist(append(DefaultBlacklist(), "partner"))
+	assert.True(t, Ignore(req))
+	req.Header.Set("Referer", "https://partner.com")
+	assert.True(t, Ignore(req))
+}
+
+func BenchmarkIgnore(b *testing.B) {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("Referer", "https://www.google.com/search?q=pirsch")
+
+	for n := 0; n < b.N; n++ {
+		if Ignore(req) {
+			b.Fatal("unexpected match")
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package tracker
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"net/url"
+	"strings"
+)
+
+const (
+	maxSearchTermLength     = 200
+	maxSearchCategoryLength = 100
+)
+
+// SiteSearch configures the query parameters used to track the internal search of a site.
+type SiteSearch struct {
+	// Parameters are the query parameters containing the search term, like "q", "s", or "search".
+	// The first non-empty parameter is used.
+	Parameters []string
+
+	// CategoryParameters are the optional query parameters containing the search category, like "category".
+	CategoryParameters []string
+}
+
+// get returns the search term and category for given page URL.
+// The search term is lowercase and whitespace is collapsed, so that "Shoes " and "shoes" are counted as the same search.
+func (search *SiteSearch) get(pageURL string) (string, string) {
+	if search == nil || len(search.Parameters) == 0 {
+		return "", ""
+	}
+
+	u, err := url.ParseRequestURI(pageURL)
+
+	if err != nil || u.RawQuery == "" {
+		return "", ""
+	}
+
+	query := u.Query()
+	term := search.getParameter(query, search.Parameters)
+
+	if term == "" {
+		return "", ""
+	}
+
+	return util.ShortenString(strings.ToLower(term), maxSearchTermLength),
+		util.ShortenString(search.getParameter(query, search.CategoryParameters), maxSearchCategoryLength)
+}
+
+func (search *SiteSearch) getParameter(query url.Values, parameters []string) string {
+	for _, param := range parameters {
+		if value := strings.Join(strings.Fields(query.Get(param)), " "); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -2,12 +2,17 @@ package tracker
 
 import (
 	"context"
-	"github.com/dchest/siphash"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
 	"github.com/emvi/iso-639-1"
 	"github.com/google/uuid"
 	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/geodb"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ip"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/keys"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/referrer"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ua"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
@@ -15,8 +20,12 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -46,6 +55,9 @@ type screenClass struct {
 	class    string
 }
 
+// localeRegion matches the region subtag of a BCP 47 locale, which is either two letters or three digits.
+var localeRegion = regexp.MustCompile(`^([a-zA-Z]{2}|[0-9]{3})$`)
+
 var screenClasses = []screenClass{
 	{5120, "UHD 5K"},
 	{3840, "UHD 4K"},
@@ -64,30 +76,40 @@ type data struct {
 	pageView      *model.PageView
 	event         *model.Event
 	request       *model.Request
+	clientError   *model.ClientError
+	click         *model.Click
 }
 
 // Tracker tracks page views, events, and updates sessions.
 type Tracker struct {
-	config  Config
-	data    chan data
-	cancel  context.CancelFunc
-	done    chan bool
-	stopped atomic.Bool
+	config           Config
+	siteSearch       sync.Map
+	privacyMode      sync.Map
+	geoPrecision     sync.Map
+	prerenders       *prerenderCache
+	cancelPrerenders context.CancelFunc
+	data             chan data
+	cancel           context.CancelFunc
+	done             chan bool
+	stopped          atomic.Bool
 }
 
 // NewTracker creates a new tracker for given client, salt and config.
 func NewTracker(config Config) *Tracker {
 	config.validate()
 	tracker := &Tracker{
-		config: config,
-		data:   make(chan data, config.WorkerBufferSize),
-		done:   make(chan bool),
+		config:     config,
+		prerenders: newPrerenderCache(config.MaxPrerenders),
+		data:       make(chan data, config.WorkerBufferSize),
+		done:       make(chan bool),
 	}
 	tracker.startWorker()
+	tracker.startPrerenderExpiry()
 	return tracker
 }
 
 // PageView tracks a page view.
+// Prerendered page views are kept until they are activated if Config.PrerenderTimeout is set (see Activate).
 // Returns true if the page view has been accepted and false otherwise.
 func (tracker *Tracker) PageView(r *http.Request, clientID uint64, options Options) bool {
 	if tracker.stopped.Load() {
@@ -103,6 +125,11 @@ func (tracker *Tracker) PageView(r *http.Request, clientID uint64, options Optio
 	}
 
 	if ignoreReason == "" {
+		if mode := tracker.getPrivacyMode(r, clientID); mode != PrivacyModeTrack {
+			tracker.captureOptOut(now, clientID, r, options, "", mode)
+			return mode == PrivacyModeAggregate
+		}
+
 		session, cancelSession, timeOnPage, bounced := tracker.getSession(pageView, clientID, r, now, userAgent, ipAddress, options)
 		var saveRequest *model.Request
 
@@ -116,6 +143,8 @@ func (tracker *Tracker) PageView(r *http.Request, clientID uint64, options Optio
 			if !bounced {
 				tagKeys, tagValues := options.getTags()
 				pv = tracker.pageViewFromSession(session, timeOnPage, tagKeys, tagValues)
+				pv.SearchTerm, pv.SearchCategory = tracker.getSiteSearch(clientID).get(options.URL)
+				pv.StatusCode = options.StatusCode
 			}
 
 			tracker.data <- data{
@@ -126,6 +155,9 @@ func (tracker *Tracker) PageView(r *http.Request, clientID uint64, options Optio
 			}
 			return true
 		}
+	} else if ignoreReason == ignoreReasonPrerender && tracker.config.PrerenderTimeout > 0 {
+		tracker.deferPrerender(now, clientID, r, ipAddress, options)
+		return true
 	} else {
 		tracker.captureRequest(now, clientID, r, ipAddress, options.Path, "", userAgent, ignoreReason)
 	}
@@ -136,6 +168,27 @@ func (tracker *Tracker) PageView(r *http.Request, clientID uint64, options Optio
 // Event tracks an event.
 // Returns true if the event has been accepted and false otherwise.
 func (tracker *Tracker) Event(r *http.Request, clientID uint64, eventOptions EventOptions, options Options) bool {
+	return tracker.event(r, clientID, eventOptions, options, nil)
+}
+
+// OutboundLink tracks a click on a link to another site.
+// The query parameters and anchor are removed from the link before it's stored.
+// Returns true if the click has been accepted and false otherwise, for example if the link points to the same hostname.
+func (tracker *Tracker) OutboundLink(r *http.Request, clientID uint64, link string, options Options) bool {
+	return tracker.event(r, clientID, EventOptions{Name: pkg.EventNameOutboundLink}, options, func(options *Options) (eventTarget, bool) {
+		return getOutboundLink(link, options.Hostname)
+	})
+}
+
+// Download tracks a file download. The file can be an absolute URL or relative to the page.
+// Returns true if the download has been accepted and false otherwise.
+func (tracker *Tracker) Download(r *http.Request, clientID uint64, file string, options Options) bool {
+	return tracker.event(r, clientID, EventOptions{Name: pkg.EventNameDownload}, options, func(options *Options) (eventTarget, bool) {
+		return getDownload(file, options.URL)
+	})
+}
+
+func (tracker *Tracker) event(r *http.Request, clientID uint64, eventOptions EventOptions, options Options, getTarget func(*Options) (eventTarget, bool)) bool {
 	if tracker.stopped.Load() {
 		return false
 	}
@@ -146,12 +199,27 @@ func (tracker *Tracker) Event(r *http.Request, clientID uint64, eventOptions Eve
 	if eventOptions.Name != "" {
 		userAgent, ipAddress, ignoreReason := tracker.ignore(r)
 		options.validate(r)
+		var target eventTarget
+
+		if getTarget != nil {
+			var ok bool
+			target, ok = getTarget(&options)
+
+			if !ok {
+				return false
+			}
+		}
 
 		if !options.Time.IsZero() {
 			now = options.Time
 		}
 
 		if ignoreReason == "" {
+			if mode := tracker.getPrivacyMode(r, clientID); mode != PrivacyModeTrack {
+				tracker.captureOptOut(now, clientID, r, options, eventOptions.Name, mode)
+				return mode == PrivacyModeAggregate
+			}
+
 			session, cancelSession, timeOnPage, _ := tracker.getSession(event, clientID, r, now, userAgent, ipAddress, options)
 			var saveRequest *model.Request
 
@@ -165,14 +233,21 @@ func (tracker *Tracker) Event(r *http.Request, clientID uint64, eventOptions Eve
 
 				if cancelSession == nil || cancelSession.PageViews < session.PageViews {
 					pv = tracker.pageViewFromSession(session, timeOnPage, tagKeys, tagValues)
+					pv.SearchTerm, pv.SearchCategory = tracker.getSiteSearch(clientID).get(options.URL)
+					pv.StatusCode = options.StatusCode
 				}
 
 				metaKeys, metaValues := eventOptions.getMetaData(tagKeys, tagValues)
+				e := tracker.eventFromSession(session, clientID, eventOptions.Duration, eventOptions.Name, metaKeys, metaValues)
+				e.Kind = target.kind
+				e.Target = target.target
+				e.TargetHost = target.host
+				e.TargetExtension = target.extension
 				tracker.data <- data{
 					session:       session,
 					cancelSession: cancelSession,
 					pageView:      pv,
-					event:         tracker.eventFromSession(session, clientID, eventOptions.Duration, eventOptions.Name, metaKeys, metaValues),
+					event:         e,
 					request:       saveRequest,
 				}
 				return true
@@ -195,7 +270,7 @@ func (tracker *Tracker) ExtendSession(r *http.Request, clientID uint64, options
 	now := time.Now().UTC()
 	userAgent, ipAddress, ignoreReason := tracker.ignore(r)
 
-	if ignoreReason == "" {
+	if ignoreReason == "" && tracker.getPrivacyMode(r, clientID) == PrivacyModeTrack {
 		options.validate(r)
 
 		if !options.Time.IsZero() {
@@ -216,6 +291,141 @@ func (tracker *Tracker) ExtendSession(r *http.Request, clientID uint64, options
 	return false
 }
 
+// Error tracks a JavaScript error for the current session of the visitor.
+// Errors don't modify the session and are dropped in case the visitor has no session yet.
+// Returns true if the error has been accepted and false otherwise.
+func (tracker *Tracker) Error(r *http.Request, clientID uint64, errorOptions ErrorOptions, options Options) bool {
+	if tracker.stopped.Load() {
+		return false
+	}
+
+	now := time.Now().UTC()
+	errorOptions.validate()
+
+	if errorOptions.Message == "" {
+		return false
+	}
+
+	userAgent, ipAddress, ignoreReason := tracker.ignore(r)
+
+	if ignoreReason != "" || tracker.getPrivacyMode(r, clientID) != PrivacyModeTrack {
+		return false
+	}
+
+	options.validate(r)
+
+	if !options.Time.IsZero() {
+		now = options.Time
+	}
+
+	session := tracker.findSession(clientID, r, now, userAgent, ipAddress, options)
+
+	if session == nil {
+		return false
+	}
+
+	tracker.data <- data{
+		clientError: &model.ClientError{
+			ClientID:       clientID,
+			VisitorID:      session.VisitorID,
+			SessionID:      session.SessionID,
+			Time:           now,
+			Hostname:       session.Hostname,
+			Path:           options.Path,
+			Fingerprint:    errorOptions.fingerprint(),
+			Message:        errorOptions.Message,
+			Source:         errorOptions.Source,
+			Line:           errorOptions.Line,
+			Column:         errorOptions.Column,
+			Stack:          errorOptions.Stack,
+			OS:             session.OS,
+			OSVersion:      session.OSVersion,
+			Browser:        session.Browser,
+			BrowserVersion: session.BrowserVersion,
+			DeviceType:     session.DeviceType,
+		},
+	}
+	return true
+}
+
+// Click tracks a click for heatmaps on the current page of the visitor.
+// Clicks don't modify the session and are dropped in case the visitor has no session yet.
+// Returns true if the click has been accepted and false otherwise.
+func (tracker *Tracker) Click(r *http.Request, clientID uint64, clickOptions ClickOptions, options Options) bool {
+	if tracker.stopped.Load() || !clickOptions.validate() {
+		return false
+	}
+
+	now := time.Now().UTC()
+	userAgent, ipAddress, ignoreReason := tracker.ignore(r)
+
+	if ignoreReason != "" || tracker.getPrivacyMode(r, clientID) != PrivacyModeTrack {
+		return false
+	}
+
+	options.validate(r)
+
+	if !options.Time.IsZero() {
+		now = options.Time
+	}
+
+	session := tracker.findSession(clientID, r, now, userAgent, ipAddress, options)
+
+	if session == nil {
+		return false
+	}
+
+	x, y := clickOptions.getPosition()
+	tracker.data <- data{
+		click: &model.Click{
+			ClientID:    clientID,
+			VisitorID:   session.VisitorID,
+			SessionID:   session.SessionID,
+			Time:        now,
+			Hostname:    session.Hostname,
+			Path:        options.Path,
+			X:           x,
+			Y:           y,
+			ScreenClass: tracker.getScreenClass(r, clickOptions.ViewportWidth),
+			Selector:    clickOptions.Selector,
+		},
+	}
+	return true
+}
+
+// SetSiteSearch sets the site search configuration for given client.
+// It takes precedence over Config.SiteSearch. Passing nil removes it.
+func (tracker *Tracker) SetSiteSearch(clientID uint64, search *SiteSearch) {
+	if search == nil {
+		tracker.siteSearch.Delete(clientID)
+		return
+	}
+
+	tracker.siteSearch.Store(clientID, search)
+}
+
+// SetPrivacyMode sets how requests sending a privacy signal are handled for given client.
+// It takes precedence over Config.PrivacyMode.
+func (tracker *Tracker) SetPrivacyMode(clientID uint64, mode PrivacyMode) {
+	tracker.privacyMode.Store(clientID, mode)
+}
+
+// RemovePrivacyMode removes the privacy mode for given client, so that Config.PrivacyMode is used.
+func (tracker *Tracker) RemovePrivacyMode(clientID uint64) {
+	tracker.privacyMode.Delete(clientID)
+}
+
+// SetGeoPrecision sets how precise the location is for given client.
+// It takes precedence over Config.GeoPrecision.
+func (tracker *Tracker) SetGeoPrecision(clientID uint64, precision GeoPrecision) {
+	tracker.geoPrecision.Store(clientID, precision)
+}
+
+// RemoveGeoPrecision removes the location precision for given client, so that Config.GeoPrecision is used.
+func (tracker *Tracker) RemoveGeoPrecision(clientID uint64) {
+	tracker.geoPrecision.Delete(clientID)
+}
+
 // Flush flushes all buffered data.
 func (tracker *Tracker) Flush() {
 	tracker.stopWorker()
@@ -227,77 +437,130 @@ func (tracker *Tracker) Flush() {
 func (tracker *Tracker) Stop() {
 	if !tracker.stopped.Load() {
 		tracker.stopped.Store(true)
+		tracker.stopPrerenderExpiry()
 		tracker.stopWorker()
 		tracker.flushData()
 	}
 }
 
+func (tracker *Tracker) getSiteSearch(clientID uint64) *SiteSearch {
+	if search, ok := tracker.siteSearch.Load(clientID); ok {
+		return search.(*SiteSearch)
+	}
+
+	return tracker.config.SiteSearch
+}
+
+// getPrivacyMode returns the privacy mode for given client, or PrivacyModeTrack if the request doesn't send a privacy signal.
+func (tracker *Tracker) getPrivacyMode(r *http.Request, clientID uint64) PrivacyMode {
+	if !hasPrivacySignal(r) {
+		return PrivacyModeTrack
+	}
+
+	if mode, ok := tracker.privacyMode.Load(clientID); ok {
+		return mode.(PrivacyMode)
+	}
+
+	return tracker.config.PrivacyMode
+}
+
+// getGeoPrecision returns the location precision for given client.
+func (tracker *Tracker) getGeoPrecision(clientID uint64) GeoPrecision {
+	if precision, ok := tracker.geoPrecision.Load(clientID); ok {
+		return precision.(GeoPrecision)
+	}
+
+	return tracker.config.GeoPrecision
+}
+
 func (tracker *Tracker) pageViewFromSession(session *model.Session, timeOnPage uint32, tagKeys, tagValues []string) *model.PageView {
 	return &model.PageView{
-		ClientID:        session.ClientID,
-		VisitorID:       session.VisitorID,
-		SessionID:       session.SessionID,
-		Time:            session.Time,
-		DurationSeconds: timeOnPage,
-		Hostname:        session.Hostname,
-		Path:            session.ExitPath,
-		Title:           session.ExitTitle,
-		Language:        session.Language,
-		CountryCode:     session.CountryCode,
-		Region:          session.Region,
-		City:            session.City,
-		Referrer:        session.Referrer,
-		ReferrerName:    session.ReferrerName,
-		ReferrerIcon:    session.ReferrerIcon,
-		OS:              session.OS,
-		OSVersion:       session.OSVersion,
-		Browser:         session.Browser,
-		BrowserVersion:  session.BrowserVersion,
-		Desktop:         session.Desktop,
-		Mobile:          session.Mobile,
-		ScreenClass:     session.ScreenClass,
-		UTMSource:       session.UTMSource,
-		UTMMedium:       session.UTMMedium,
-		UTMCampaign:     session.UTMCampaign,
-		UTMContent:      session.UTMContent,
-		UTMTerm:         session.UTMTerm,
-		TagKeys:         tagKeys,
-		TagValues:       tagValues,
+		ClientID:         session.ClientID,
+		VisitorID:        session.VisitorID,
+		SessionID:        session.SessionID,
+		Time:             session.Time,
+		DurationSeconds:  timeOnPage,
+		Hostname:         session.Hostname,
+		Path:             session.ExitPath,
+		Title:            session.ExitTitle,
+		Language:         session.Language,
+		Locale:           session.Locale,
+		Languages:        session.Languages,
+		CountryCode:      session.CountryCode,
+		Region:           session.Region,
+		City:             session.City,
+		Timezone:         session.Timezone,
+		TimezoneOffset:   session.TimezoneOffset,
+		Referrer:         session.Referrer,
+		ReferrerName:     session.ReferrerName,
+		ReferrerIcon:     session.ReferrerIcon,
+		ReferrerCategory: session.ReferrerCategory,
+		ReferrerKeyword:  session.ReferrerKeyword,
+		OS:               session.OS,
+		OSVersion:        session.OSVersion,
+		Browser:          session.Browser,
+		BrowserVersion:   session.BrowserVersion,
+		Webview:          session.Webview,
+		WebviewApp:       session.WebviewApp,
+		Desktop:          session.Desktop,
+		Mobile:           session.Mobile,
+		DeviceType:       session.DeviceType,
+		DeviceBrand:      session.DeviceBrand,
+		DeviceModel:      session.DeviceModel,
+		ScreenClass:      session.ScreenClass,
+		UTMSource:        session.UTMSource,
+		UTMMedium:        session.UTMMedium,
+		UTMCampaign:      session.UTMCampaign,
+		UTMContent:       session.UTMContent,
+		UTMTerm:          session.UTMTerm,
+		TagKeys:          tagKeys,
+		TagValues:        tagValues,
 	}
 }
 
 func (tracker *Tracker) eventFromSession(session *model.Session, clientID uint64, duration uint32, name string, metaKeys, metaValues []string) *model.Event {
 	return &model.Event{
-		ClientID:        clientID,
-		VisitorID:       session.VisitorID,
-		Time:            session.Time,
-		SessionID:       session.SessionID,
-		DurationSeconds: duration,
-		Name:            name,
-		MetaKeys:        metaKeys,
-		MetaValues:      metaValues,
-		Hostname:        session.Hostname,
-		Path:            session.ExitPath,
-		Title:           session.ExitTitle,
-		Language:        session.Language,
-		CountryCode:     session.CountryCode,
-		Region:          session.Region,
-		City:            session.City,
-		Referrer:        session.Referrer,
-		ReferrerName:    session.ReferrerName,
-		ReferrerIcon:    session.ReferrerIcon,
-		OS:              session.OS,
-		OSVersion:       session.OSVersion,
-		Browser:         session.Browser,
-		BrowserVersion:  session.BrowserVersion,
-		Desktop:         session.Desktop,
-		Mobile:          session.Mobile,
-		ScreenClass:     session.ScreenClass,
-		UTMSource:       session.UTMSource,
-		UTMMedium:       session.UTMMedium,
-		UTMCampaign:     session.UTMCampaign,
-		UTMContent:      session.UTMContent,
-		UTMTerm:         session.UTMTerm,
+		ClientID:         clientID,
+		VisitorID:        session.VisitorID,
+		Time:             session.Time,
+		SessionID:        session.SessionID,
+		DurationSeconds:  duration,
+		Name:             name,
+		MetaKeys:         metaKeys,
+		MetaValues:       metaValues,
+		Hostname:         session.Hostname,
+		Path:             session.ExitPath,
+		Title:            session.ExitTitle,
+		Language:         session.Language,
+		Locale:           session.Locale,
+		Languages:        session.Languages,
+		CountryCode:      session.CountryCode,
+		Region:           session.Region,
+		City:             session.City,
+		Timezone:         session.Timezone,
+		TimezoneOffset:   session.TimezoneOffset,
+		Referrer:         session.Referrer,
+		ReferrerName:     session.ReferrerName,
+		ReferrerIcon:     session.ReferrerIcon,
+		ReferrerCategory: session.ReferrerCategory,
+		ReferrerKeyword:  session.ReferrerKeyword,
+		OS:               session.OS,
+		OSVersion:        session.OSVersion,
+		Browser:          session.Browser,
+		BrowserVersion:   session.BrowserVersion,
+		Webview:          session.Webview,
+		WebviewApp:       session.WebviewApp,
+		Desktop:          session.Desktop,
+		Mobile:           session.Mobile,
+		DeviceType:       session.DeviceType,
+		DeviceBrand:      session.DeviceBrand,
+		DeviceModel:      session.DeviceModel,
+		ScreenClass:      session.ScreenClass,
+		UTMSource:        session.UTMSource,
+		UTMMedium:        session.UTMMedium,
+		UTMCampaign:      session.UTMCampaign,
+		UTMContent:       session.UTMContent,
+		UTMTerm:          session.UTMTerm,
 	}
 }
 
@@ -305,7 +568,7 @@ func (tracker *Tracker) requestFromSession(session *model.Session, clientID uint
 	logIP := ""
 
 	if tracker.config.LogIP {
-		logIP = ipAddress
+		logIP = tracker.truncateIP(ipAddress)
 	}
 
 	return &model.Request{
@@ -328,14 +591,15 @@ func (tracker *Tracker) captureRequest(now time.Time, clientID uint64, r *http.R
 	logIP := ""
 
 	if tracker.config.LogIP {
-		logIP = ipAddress
+		logIP = tracker.truncateIP(ipAddress)
 	}
 
 	query := r.URL.Query()
+	current, _ := tracker.keys()
 	tracker.data <- data{
 		request: &model.Request{
 			ClientID:    clientID,
-			VisitorID:   tracker.fingerprint(tracker.config.Salt, userAgent.UserAgent, ipAddress, now),
+			VisitorID:   tracker.fingerprint(r, userAgent.UserAgent, ipAddress, "", current, now),
 			Time:        now,
 			IP:          logIP,
 			UserAgent:   r.UserAgent(),
@@ -352,10 +616,48 @@ func (tracker *Tracker) captureRequest(now time.Time, clientID uint64, r *http.R
 	}
 }
 
+// captureOptOut stores a request that opted out of tracking without a fingerprint, IP, or User-Agent.
+// Only the client, time, and hostname are stored for PrivacyModeIgnore.
+// The hostname is taken from Options.URL if set, so that requests sent through an API or proxy are attributed to the site.
+func (tracker *Tracker) captureOptOut(now time.Time, clientID uint64, r *http.Request, options Options, event string, mode PrivacyMode) {
+	hostname := options.Hostname
+
+	if hostname == "" {
+		hostname = r.Host
+	}
+
+	request := &model.Request{
+		ClientID: clientID,
+		Time:     now,
+		Hostname: util.StripWWW(hostname),
+		OptOut:   true,
+	}
+
+	if mode == PrivacyModeAggregate {
+		query := r.URL.Query()
+		request.Path = options.Path
+		request.Event = event
+		request.Referrer = r.Referer()
+		request.UTMSource = strings.TrimSpace(query.Get("utm_source"))
+		request.UTMMedium = strings.TrimSpace(query.Get("utm_medium"))
+		request.UTMCampaign = strings.TrimSpace(query.Get("utm_campaign"))
+	}
+
+	tracker.data <- data{
+		request: request,
+	}
+}
+
 func (tracker *Tracker) ignore(r *http.Request) (ua.UserAgent, string, string) {
 	ipAddress := ip.Get(r, tracker.config.HeaderParser, tracker.config.AllowedProxySubnets)
 
-	// ignore browsers pre-fetching data
+	// ignore browsers pre-fetching data or prerendering pages using speculation rules
+	if reason := getSpeculativeLoad(r); reason != "" {
+		return ua.UserAgent{
+			UserAgent: r.UserAgent(),
+		}, ipAddress, reason
+	}
+
 	xPurpose := r.Header.Get("X-Purpose")
 	purpose := r.Header.Get("Purpose")
 
@@ -366,7 +668,7 @@ func (tracker *Tracker) ignore(r *http.Request) (ua.UserAgent, string, string) {
 		purpose == "preview" {
 		return ua.UserAgent{
 			UserAgent: r.UserAgent(),
-		}, ipAddress, "prefetch"
+		}, ipAddress, ignoreReasonPrefetch
 	}
 
 	// empty User-Agents are usually bots
@@ -412,7 +714,13 @@ func (tracker *Tracker) ignore(r *http.Request) (ua.UserAgent, string, string) {
 		}, ipAddress, "referrer"
 	}
 
-	userAgentResult := ua.Parse(r)
+	var userAgentResult ua.UserAgent
+
+	if tracker.config.UserAgentCache != nil {
+		userAgentResult = tracker.config.UserAgentCache.Parse(r)
+	} else {
+		userAgentResult = ua.Parse(r)
+	}
 
 	if tracker.ignoreBrowserVersion(userAgentResult.Browser, userAgentResult.BrowserVersion) {
 		return ua.UserAgent{
@@ -421,12 +729,10 @@ func (tracker *Tracker) ignore(r *http.Request) (ua.UserAgent, string, string) {
 	}
 
 	// filter for bot keywords
-	for _, botUserAgent := range ua.Blacklist {
-		if strings.Contains(userAgent, botUserAgent) {
-			return ua.UserAgent{
-				UserAgent: r.UserAgent(),
-			}, ipAddress, "ua-keyword"
-		}
+	if ua.IsBlacklisted(userAgent) {
+		return ua.UserAgent{
+			UserAgent: r.UserAgent(),
+		}, ipAddress, "ua-keyword"
 	}
 
 	if tracker.config.IPFilter != nil && tracker.config.IPFilter.Ignore(ipAddress) {
@@ -465,25 +771,30 @@ func (tracker *Tracker) browserVersionBefore(version string, min int) bool {
 }
 
 func (tracker *Tracker) getSession(t eventType, clientID uint64, r *http.Request, now time.Time, ua ua.UserAgent, ip string, options Options) (*model.Session, *model.Session, uint32, bool) {
-	fingerprint := tracker.fingerprint(tracker.config.Salt, ua.UserAgent, ip, now)
+	maxAge := now.Add(-sessionMaxAge)
+	fingerprints := tracker.fingerprints(r, ua.UserAgent, ip, options.DeviceID, now, maxAge)
+	fingerprint := fingerprints[0]
 	m := tracker.config.SessionCache.NewMutex(clientID, fingerprint)
 	m.Lock()
-	maxAge := now.Add(-sessionMaxAge)
 	session := tracker.config.SessionCache.Get(clientID, fingerprint, maxAge)
 
-	// if the maximum session age reaches yesterday, we also need to check for the previous day (different fingerprint)
-	if session == nil && maxAge.Day() != now.Day() {
+	// if the maximum session age reaches the previous rotation period (like yesterday) or the keys have been rotated,
+	// we also need to check for the previous fingerprints
+	for _, fingerprintPrevious := range fingerprints[1:] {
+		if session != nil {
+			break
+		}
+
 		m.Unlock()
-		fingerprintYesterday := tracker.fingerprint(tracker.config.Salt, ua.UserAgent, ip, maxAge)
-		m = tracker.config.SessionCache.NewMutex(clientID, fingerprintYesterday)
+		m = tracker.config.SessionCache.NewMutex(clientID, fingerprintPrevious)
 		m.Lock()
-		session = tracker.config.SessionCache.Get(clientID, fingerprintYesterday, maxAge)
+		session = tracker.config.SessionCache.Get(clientID, fingerprintPrevious, maxAge)
 
 		if session != nil {
 			if session.Start.Before(now.Add(-time.Hour * 24)) {
 				session = nil
 			} else {
-				fingerprint = fingerprintYesterday
+				fingerprint = fingerprintPrevious
 			}
 		}
 	}
@@ -510,22 +821,53 @@ func (tracker *Tracker) getSession(t eventType, clientID uint64, r *http.Request
 		cancelSession = &sessionCopy
 		cancelSession.Sign = -1
 		timeOnPage, bounced = tracker.updateSession(t, r, session, now, options.Path, options.Title)
+		session.PropertyKeys, session.PropertyValues = options.mergeProperties(session.PropertyKeys, session.PropertyValues)
+
+		// visitors might log in during the session
+		if session.UserID == 0 {
+			session.UserID = tracker.userID(tracker.config.Salt, options.UserID)
+		}
+
 		tracker.config.SessionCache.Put(clientID, fingerprint, session)
 	}
 
 	return session, cancelSession, timeOnPage, bounced
 }
 
+// findSession returns the current session for the visitor without modifying it, or nil if there is none.
+func (tracker *Tracker) findSession(clientID uint64, r *http.Request, now time.Time, ua ua.UserAgent, ip string, options Options) *model.Session {
+	maxAge := now.Add(-sessionMaxAge)
+	fingerprints := tracker.fingerprints(r, ua.UserAgent, ip, options.DeviceID, now, maxAge)
+	session := tracker.config.SessionCache.Get(clientID, fingerprints[0], maxAge)
+
+	for _, fingerprintPrevious := range fingerprints[1:] {
+		if session != nil {
+			break
+		}
+
+		session = tracker.config.SessionCache.Get(clientID, fingerprintPrevious, maxAge)
+
+		if session != nil && session.Start.Before(now.Add(-time.Hour*24)) {
+			session = nil
+		}
+	}
+
+	return session
+}
+
 func (tracker *Tracker) newSession(clientID uint64, r *http.Request, fingerprint uint64, now time.Time, ua ua.UserAgent, ip string, options Options) *model.Session {
 	ua.OS = util.ShortenString(ua.OS, 20)
 	ua.OSVersion = util.ShortenString(ua.OSVersion, 20)
 	ua.Browser = util.ShortenString(ua.Browser, 20)
 	ua.BrowserVersion = util.ShortenString(ua.BrowserVersion, 20)
+	ua.DeviceModel = util.ShortenString(ua.DeviceModel, 40)
 	lang := util.ShortenString(tracker.getLanguage(r), 10)
-	ref, referrerName, referrerIcon := referrer.Get(r, options.Referrer, options.Hostname)
-	ref = util.ShortenString(ref, 200)
-	referrerName = util.ShortenString(referrerName, 200)
-	referrerIcon = util.ShortenString(referrerIcon, 2000)
+	locale := tracker.getLocale(r)
+	ref := referrer.GetForClient(r, clientID, options.Referrer, options.Hostname)
+	ref.URL = util.ShortenString(ref.URL, 200)
+	ref.Name = util.ShortenString(ref.Name, 200)
+	ref.Icon = util.ShortenString(ref.Icon, 2000)
+	ref.Keyword = util.ShortenString(ref.Keyword, 200)
 	screenClass := tracker.getScreenClass(r, options.ScreenWidth)
 	query := r.URL.Query()
 	utmSource := strings.TrimSpace(query.Get("utm_source"))
@@ -534,45 +876,63 @@ func (tracker *Tracker) newSession(clientID uint64, r *http.Request, fingerprint
 	utmContent := strings.TrimSpace(query.Get("utm_content"))
 	utmTerm := strings.TrimSpace(query.Get("utm_term"))
 	countryCode, region, city := "", "", ""
+	geoIP := tracker.truncateIP(ip)
+	geoPrecision := tracker.getGeoPrecision(clientID)
 
 	if tracker.config.GeoDB != nil {
-		countryCode, region, city = tracker.config.GeoDB.GetLocation(ip)
+		countryCode, region, city = geoPrecision.apply(tracker.config.GeoDB.GetLocation(geoIP))
 	}
 
+	timezone, timezoneOffset := tracker.getTimezone(geoIP, now, options, geoPrecision)
+	propertyKeys, propertyValues := options.mergeProperties([]string{}, []string{})
 	return &model.Session{
-		Sign:           1,
-		Version:        1,
-		ClientID:       clientID,
-		VisitorID:      fingerprint,
-		SessionID:      util.RandUint32(),
-		Time:           now,
-		Start:          now,
-		Hostname:       r.Host,
-		EntryPath:      options.Path,
-		ExitPath:       options.Path,
-		PageViews:      1,
-		IsBounce:       true,
-		EntryTitle:     options.Title,
-		ExitTitle:      options.Title,
-		Language:       lang,
-		CountryCode:    countryCode,
-		Region:         region,
-		City:           city,
-		Referrer:       ref,
-		ReferrerName:   referrerName,
-		ReferrerIcon:   referrerIcon,
-		OS:             ua.OS,
-		OSVersion:      ua.OSVersion,
-		Browser:        ua.Browser,
-		BrowserVersion: ua.BrowserVersion,
-		Desktop:        ua.IsDesktop(),
-		Mobile:         ua.IsMobile(),
-		ScreenClass:    screenClass,
-		UTMSource:      utmSource,
-		UTMMedium:      utmMedium,
-		UTMCampaign:    utmCampaign,
-		UTMContent:     utmContent,
-		UTMTerm:        utmTerm,
+		Sign:             1,
+		Version:          1,
+		ClientID:         clientID,
+		VisitorID:        fingerprint,
+		SessionID:        util.RandUint32(),
+		Time:             now,
+		Start:            now,
+		Hostname:         r.Host,
+		EntryPath:        options.Path,
+		ExitPath:         options.Path,
+		PageViews:        1,
+		IsBounce:         true,
+		EntryTitle:       options.Title,
+		ExitTitle:        options.Title,
+		Language:         lang,
+		Locale:           locale,
+		Languages:        tracker.getPreferredLanguages(r),
+		CountryCode:      countryCode,
+		Region:           region,
+		City:             city,
+		Timezone:         timezone,
+		TimezoneOffset:   timezoneOffset,
+		Referrer:         ref.URL,
+		ReferrerName:     ref.Name,
+		ReferrerIcon:     ref.Icon,
+		ReferrerCategory: ref.Category,
+		ReferrerKeyword:  ref.Keyword,
+		OS:               ua.OS,
+		OSVersion:        ua.OSVersion,
+		Browser:          ua.Browser,
+		BrowserVersion:   ua.BrowserVersion,
+		Webview:          ua.Webview,
+		WebviewApp:       ua.WebviewApp,
+		Desktop:          ua.IsDesktop(),
+		Mobile:           ua.IsMobile(),
+		DeviceType:       ua.DeviceType,
+		DeviceBrand:      ua.DeviceBrand,
+		DeviceModel:      ua.DeviceModel,
+		ScreenClass:      screenClass,
+		UTMSource:        utmSource,
+		UTMMedium:        utmMedium,
+		UTMCampaign:      utmCampaign,
+		UTMContent:       utmContent,
+		UTMTerm:          utmTerm,
+		PropertyKeys:     propertyKeys,
+		PropertyValues:   propertyValues,
+		UserID:           tracker.userID(tracker.config.Salt, options.UserID),
 	}
 }
 
@@ -634,6 +994,140 @@ func (tracker *Tracker) getLanguage(r *http.Request) string {
 	return ""
 }
 
+func (tracker *Tracker) getLocale(r *http.Request) string {
+	lang := r.Header.Get("Accept-Language")
+
+	if lang != "" {
+		left, _, _ := strings.Cut(lang, ";")
+		left, _, _ = strings.Cut(left, ",")
+		subtags := strings.FieldsFunc(strings.TrimSpace(left), func(r rune) bool {
+			return r == '-' || r == '_'
+		})
+
+		if len(subtags) == 0 {
+			return ""
+		}
+
+		code := strings.ToLower(subtags[0])
+
+		if !iso6391.ValidCode(code) {
+			return ""
+		}
+
+		// skip the optional script (like zh-Hant-TW) and pick the region
+		for _, subtag := range subtags[1:] {
+			if localeRegion.MatchString(subtag) {
+				return code + "-" + strings.ToUpper(subtag)
+			}
+		}
+
+		return code
+	}
+
+	return ""
+}
+
+func (tracker *Tracker) getPreferredLanguages(r *http.Request) []string {
+	lang := r.Header.Get("Accept-Language")
+
+	if tracker.config.MaxLanguages == 0 || lang == "" {
+		return nil
+	}
+
+	type preference struct {
+		code string
+		q    float64
+	}
+
+	entries := strings.Split(lang, ",")
+	preferences := make([]preference, 0, len(entries))
+
+	for _, entry := range entries {
+		tag, params, _ := strings.Cut(entry, ";")
+		code, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		code = strings.ToLower(code)
+
+		if !iso6391.ValidCode(code) {
+			continue
+		}
+
+		q := 1.0
+		k, v, _ := strings.Cut(params, "=")
+
+		if strings.TrimSpace(k) == "q" {
+			var err error
+			q, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+
+			if err != nil {
+				continue
+			}
+		}
+
+		if q > 0 {
+			preferences = append(preferences, preference{code, q})
+		}
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].q > preferences[j].q
+	})
+	languages := make([]string, 0, tracker.config.MaxLanguages)
+
+	for _, p := range preferences {
+		if len(languages) == tracker.config.MaxLanguages {
+			break
+		}
+
+		if !slices.Contains(languages, p.code) {
+			languages = append(languages, p.code)
+		}
+	}
+
+	return languages
+}
+
+// getTimezone returns the time zone and offset for given IP and options.
+// The time zone is only looked up from the GeoDB for GeoPrecisionCity, as it would otherwise reveal a more precise location.
+func (tracker *Tracker) getTimezone(ip string, now time.Time, options Options, precision GeoPrecision) (string, int32) {
+	timezone := options.Timezone
+
+	if timezone == "" && precision == GeoPrecisionCity && tracker.config.GeoDB != nil {
+		if tzLocator, ok := tracker.config.GeoDB.(geodb.TimezoneLocator); ok {
+			timezone = tzLocator.GetTimezone(ip)
+		}
+	}
+
+	if timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			_, offset := now.In(location).Zone()
+			return timezone, int32(offset)
+		}
+	}
+
+	if options.TimezoneOffset == nil {
+		return "", 0
+	}
+
+	// the offset is inverted as returned by Date.getTimezoneOffset() in JavaScript, just like the Etc/GMT time zones
+	timezoneOffset := *options.TimezoneOffset
+	offset := -int32(timezoneOffset) * 60
+
+	if timezoneOffset == 0 {
+		return "UTC", 0
+	} else if timezoneOffset%60 == 0 {
+		return fmt.Sprintf("Etc/GMT%+d", timezoneOffset/60), offset
+	}
+
+	// there is no IANA time zone for offsets that aren't full hours, so a fixed offset like UTC+05:30 is stored instead
+	sign, minutes := "+", offset/60
+
+	if minutes < 0 {
+		sign, minutes = "-", -minutes
+	}
+
+	return fmt.Sprintf("UTC%s%02d:%02d", sign, minutes/60, minutes%60), offset
+}
+
 func (tracker *Tracker) getScreenClass(r *http.Request, width uint16) string {
 	if width == 0 {
 		width = tracker.getScreenWidthFromHeader(r, "Sec-CH-Width")
@@ -671,9 +1165,9 @@ func (tracker *Tracker) getScreenWidthFromHeader(r *http.Request, header string)
 }
 
 func (tracker *Tracker) referrerOrCampaignChanged(r *http.Request, session *model.Session, ref, hostname string) bool {
-	ref, refName, _ := referrer.Get(r, ref, hostname)
+	result := referrer.GetForClient(r, session.ClientID, ref, hostname)
 
-	if ref != "" && ref != session.Referrer || refName != "" && refName != session.ReferrerName {
+	if result.URL != "" && result.URL != session.Referrer || result.Name != "" && result.Name != session.ReferrerName {
 		return true
 	}
 
@@ -690,13 +1184,57 @@ func (tracker *Tracker) referrerOrCampaignChanged(r *http.Request, session *mode
 		(utmTerm != "" && utmTerm != session.UTMTerm)
 }
 
-func (tracker *Tracker) fingerprint(salt, ua, ip string, now time.Time) uint64 {
-	var sb strings.Builder
-	sb.WriteString(ua)
-	sb.WriteString(ip)
-	sb.WriteString(salt)
-	sb.WriteString(now.Format("20060102"))
-	return siphash.Hash(tracker.config.FingerprintKey0, tracker.config.FingerprintKey1, []byte(sb.String()))
+// fingerprints returns the fingerprints used to look up sessions, starting with the one used for new sessions.
+// The other fingerprints are calculated for the maximum session age and the previous keys, if they differ.
+func (tracker *Tracker) fingerprints(r *http.Request, ua, ip, deviceID string, now, maxAge time.Time) []uint64 {
+	current, previous := tracker.keys()
+	fingerprints := make([]uint64, 0, 4)
+
+	for _, k := range []*keys.Keys{current, previous} {
+		if k == nil && len(fingerprints) > 0 {
+			break
+		}
+
+		for _, t := range []time.Time{now, maxAge} {
+			if fingerprint := tracker.fingerprint(r, ua, ip, deviceID, k, t); !slices.Contains(fingerprints, fingerprint) {
+				fingerprints = append(fingerprints, fingerprint)
+			}
+		}
+	}
+
+	return fingerprints
+}
+
+func (tracker *Tracker) fingerprint(r *http.Request, ua, ip, deviceID string, k *keys.Keys, t time.Time) uint64 {
+	return tracker.config.Fingerprinter.Fingerprint(FingerprintData{
+		Request:   r,
+		UserAgent: ua,
+		IP:        ip,
+		DeviceID:  deviceID,
+		Keys:      k,
+		Time:      t,
+	})
+}
+
+func (tracker *Tracker) keys() (*keys.Keys, *keys.Keys) {
+	if tracker.config.KeyProvider == nil {
+		return nil, nil
+	}
+
+	return tracker.config.KeyProvider.Keys()
+}
+
+// userID returns the hashed user ID, or 0 if no ID has been set.
+// Unlike the fingerprint, it does not rotate daily.
+// It's only keyed by the salt, as the fingerprint keys are random by default and rotated by the Config.KeyProvider.
+func (tracker *Tracker) userID(salt, id string) uint64 {
+	if id == "" {
+		return 0
+	}
+
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(id))
+	return binary.BigEndian.Uint64(mac.Sum(nil))
 }
 
 func (tracker *Tracker) startWorker() {
@@ -722,6 +1260,8 @@ func (tracker *Tracker) flushData() {
 	pageViews := make([]model.PageView, 0, bufferSize)
 	events := make([]model.Event, 0, bufferSize)
 	requests := make([]model.Request, 0, bufferSize)
+	clientErrors := make([]model.ClientError, 0, bufferSize)
+	clicks := make([]model.Click, 0, bufferSize)
 
 	for {
 		stop := false
@@ -748,18 +1288,32 @@ func (tracker *Tracker) flushData() {
 				requests = append(requests, *data.request)
 			}
 
+			if data.clientError != nil {
+				clientErrors = append(clientErrors, *data.clientError)
+			}
+
+			if data.click != nil {
+				clicks = append(clicks, *data.click)
+			}
+
 			if len(sessions)+2 >= bufferSize*2 ||
 				len(pageViews)+1 >= bufferSize ||
 				len(events)+1 >= bufferSize ||
-				len(requests)+1 >= bufferSize {
+				len(requests)+1 >= bufferSize ||
+				len(clientErrors)+1 >= bufferSize ||
+				len(clicks)+1 >= bufferSize {
 				tracker.saveSessions(sessions)
 				tracker.savePageViews(pageViews)
 				tracker.saveEvents(events)
 				tracker.saveRequests(requests)
+				tracker.saveClientErrors(clientErrors)
+				tracker.saveClicks(clicks)
 				sessions = sessions[:0]
 				pageViews = pageViews[:0]
 				events = events[:0]
 				requests = requests[:0]
+				clientErrors = clientErrors[:0]
+				clicks = clicks[:0]
 			}
 		default:
 			stop = true
@@ -774,6 +1328,8 @@ func (tracker *Tracker) flushData() {
 	tracker.savePageViews(pageViews)
 	tracker.saveEvents(events)
 	tracker.saveRequests(requests)
+	tracker.saveClientErrors(clientErrors)
+	tracker.saveClicks(clicks)
 }
 
 func (tracker *Tracker) aggregateData(ctx context.Context) {
@@ -782,6 +1338,8 @@ func (tracker *Tracker) aggregateData(ctx context.Context) {
 	pageViews := make([]model.PageView, 0, bufferSize)
 	events := make([]model.Event, 0, bufferSize)
 	requests := make([]model.Request, 0, bufferSize)
+	clientErrors := make([]model.ClientError, 0, bufferSize)
+	clicks := make([]model.Click, 0, bufferSize)
 	timer := time.NewTimer(tracker.config.WorkerTimeout)
 	defer timer.Stop()
 
@@ -810,33 +1368,53 @@ func (tracker *Tracker) aggregateData(ctx context.Context) {
 				requests = append(requests, *data.request)
 			}
 
+			if data.clientError != nil {
+				clientErrors = append(clientErrors, *data.clientError)
+			}
+
+			if data.click != nil {
+				clicks = append(clicks, *data.click)
+			}
+
 			if len(sessions)+2 >= bufferSize*2 ||
 				len(pageViews)+1 >= bufferSize ||
 				len(events)+1 >= bufferSize ||
-				len(requests)+1 >= bufferSize {
+				len(requests)+1 >= bufferSize ||
+				len(clientErrors)+1 >= bufferSize ||
+				len(clicks)+1 >= bufferSize {
 				tracker.saveSessions(sessions)
 				tracker.savePageViews(pageViews)
 				tracker.saveEvents(events)
 				tracker.saveRequests(requests)
+				tracker.saveClientErrors(clientErrors)
+				tracker.saveClicks(clicks)
 				sessions = sessions[:0]
 				pageViews = pageViews[:0]
 				events = events[:0]
 				requests = requests[:0]
+				clientErrors = clientErrors[:0]
+				clicks = clicks[:0]
 			}
 		case <-timer.C:
 			tracker.saveSessions(sessions)
 			tracker.savePageViews(pageViews)
 			tracker.saveEvents(events)
 			tracker.saveRequests(requests)
+			tracker.saveClientErrors(clientErrors)
+			tracker.saveClicks(clicks)
 			sessions = sessions[:0]
 			pageViews = pageViews[:0]
 			events = events[:0]
 			requests = requests[:0]
+			clientErrors = clientErrors[:0]
+			clicks = clicks[:0]
 		case <-ctx.Done():
 			tracker.saveSessions(sessions)
 			tracker.savePageViews(pageViews)
 			tracker.saveEvents(events)
 			tracker.saveRequests(requests)
+			tracker.saveClientErrors(clientErrors)
+			tracker.saveClicks(clicks)
 			tracker.done <- true
 			return
 		}
@@ -910,3 +1488,37 @@ func (tracker *Tracker) saveRequests(requests []model.Request) {
 		}
 	}
 }
+
+func (tracker *Tracker) saveClientErrors(clientErrors []model.ClientError) {
+	if len(clientErrors) > 0 {
+		for retries := 5; retries > -1; retries-- {
+			if err := tracker.config.Store.SaveClientErrors(clientErrors); err != nil {
+				if retries > 0 {
+					tracker.config.Logger.Error("error saving client errors", "err", err, "retry", retries)
+					time.Sleep(time.Second * time.Duration(5-retries) * 10)
+				} else {
+					log.Panicf("error saving client errors: %s", err)
+				}
+			} else {
+				break
+			}
+		}
+	}
+}
+
+func (tracker *Tracker) saveClicks(clicks []model.Click) {
+	if len(clicks) > 0 {
+		for retries := 5; retries > -1; retries-- {
+			if err := tracker.config.Store.SaveClicks(clicks); err != nil {
+				if retries > 0 {
+					tracker.config.Logger.Error("error saving clicks", "err", err, "retry", retries)
+					time.Sleep(time.Second * time.Duration(5-retries) * 10)
+				} else {
+					log.Panicf("error saving clicks: %s", err)
+				}
+			} else {
+				break
+			}
+		}
+	}
+}
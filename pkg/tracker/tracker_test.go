@@ -3,6 +3,7 @@ package tracker
 import (
 	"context"
 	"fmt"
+	"github.com/dchest/siphash"
 	"github.com/go-redis/redis/v8"
 	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/analyzer"
@@ -10,6 +11,8 @@ import (
 	"github.com/pirsch-analytics/pirsch/v6/pkg/model"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/geodb"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ip"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/keys"
+	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/referrer"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/session"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/tracker/ua"
 	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
@@ -17,6 +20,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -115,9 +119,14 @@ func TestTracker_PageView(t *testing.T) {
 	assert.Equal(t, uint16(1), sessions[0].PageViews)
 	assert.True(t, sessions[0].IsBounce)
 	assert.Equal(t, "fr", sessions[0].Language)
+	assert.Equal(t, "fr-CH", sessions[0].Locale)
+	assert.Empty(t, sessions[0].Languages)
 	assert.Equal(t, "gb", sessions[0].CountryCode)
 	assert.Equal(t, "England", sessions[0].Region)
 	assert.Equal(t, "London", sessions[0].City)
+	assert.Equal(t, "Europe/London", sessions[0].Timezone)
+	assert.Equal(t, "Europe/London", pageViews[0].Timezone)
+	assert.Equal(t, sessions[0].TimezoneOffset, pageViews[0].TimezoneOffset)
 	assert.Equal(t, "https://google.com", sessions[0].Referrer)
 	assert.Equal(t, "Google", sessions[0].ReferrerName)
 	assert.Equal(t, pkg.OSLinux, sessions[0].OS)
@@ -126,6 +135,8 @@ func TestTracker_PageView(t *testing.T) {
 	assert.Equal(t, "128.0", sessions[0].BrowserVersion)
 	assert.True(t, sessions[0].Desktop)
 	assert.False(t, sessions[0].Mobile)
+	assert.Equal(t, pkg.DeviceTypeDesktop, sessions[0].DeviceType)
+	assert.Empty(t, sessions[0].DeviceModel)
 	assert.Equal(t, "Full HD", sessions[0].ScreenClass)
 	assert.Equal(t, "Source", sessions[0].UTMSource)
 	assert.Equal(t, "Medium", sessions[0].UTMMedium)
@@ -151,6 +162,7 @@ func TestTracker_PageView(t *testing.T) {
 	assert.Equal(t, "128.0", pageViews[0].BrowserVersion)
 	assert.True(t, pageViews[0].Desktop)
 	assert.False(t, pageViews[0].Mobile)
+	assert.Equal(t, pkg.DeviceTypeDesktop, pageViews[0].DeviceType)
 	assert.Equal(t, "Full HD", pageViews[0].ScreenClass)
 	assert.Equal(t, "Source", pageViews[0].UTMSource)
 	assert.Equal(t, "Medium", pageViews[0].UTMMedium)
@@ -241,6 +253,186 @@ func TestTracker_PageView(t *testing.T) {
 	assert.False(t, requests[0].Bot)
 }
 
+func TestTracker_PageViewWebview(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", "Mozilla/5.0 (Linux; Android 13; SM-A225F Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36 Instagram 312.1.0.34.111 Android (33/13; 300dpi; 720x1452; samsung; SM-A225F; a22; mt6769t; de_DE; 548323754)")
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	tracker.PageView(req, 0, Options{})
+	tracker.Flush()
+	sessions := client.GetSessions()
+	pageViews := client.GetPageViews()
+	assert.Len(t, sessions, 1)
+	assert.Len(t, pageViews, 1)
+	assert.Equal(t, pkg.BrowserChrome, sessions[0].Browser)
+	assert.True(t, sessions[0].Webview)
+	assert.Equal(t, "Instagram", sessions[0].WebviewApp)
+	assert.True(t, pageViews[0].Webview)
+	assert.Equal(t, "Instagram", pageViews[0].WebviewApp)
+}
+
+func TestTracker_PageViewDuckDuckGo(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", "Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile DuckDuckGo/5 Safari/537.36")
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, pkg.BrowserDuckDuckGo, sessions[0].Browser)
+	assert.Equal(t, "5", sessions[0].BrowserVersion)
+	requests := client.GetRequests()
+	assert.Len(t, requests, 1)
+	assert.False(t, requests[0].Bot)
+}
+
+func TestTracker_PageViewUserAgentCache(t *testing.T) {
+	client := db.NewClientMock()
+	cache := ua.NewCache(10)
+	tracker := NewTracker(Config{
+		Store:          client,
+		UserAgentCache: cache,
+	})
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/page/%d", i), nil)
+		req.Header.Add("User-Agent", userAgent)
+		req.RemoteAddr = "81.2.69.142"
+		tracker.PageView(req, 0, Options{})
+	}
+
+	tracker.Flush()
+	assert.Len(t, client.GetPageViews(), 3)
+	assert.Equal(t, pkg.BrowserFirefox, client.GetPageViews()[2].Browser)
+	stats := cache.Stats()
+	assert.Equal(t, 1, stats.Size)
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+}
+
+func TestTracker_PageViewReferrerMapping(t *testing.T) {
+	referrer.SetClientMappings(42, referrer.Mappings{
+		"partner.com": {Name: "Partner", Icon: "https://partner.com/icon.png"},
+	})
+	defer referrer.SetClientMappings(42, nil)
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+
+	for i, clientID := range []uint64{42, 43} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Add("User-Agent", userAgent)
+		req.Header.Set("Referer", "https://www.partner.com/")
+		req.RemoteAddr = fmt.Sprintf("81.2.69.%d", 142+i)
+		tracker.PageView(req, clientID, Options{})
+	}
+
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 2)
+
+	for _, session := range sessions {
+		if session.ClientID == 42 {
+			assert.Equal(t, "Partner", session.ReferrerName)
+			assert.Equal(t, "https://partner.com/icon.png", session.ReferrerIcon)
+		} else {
+			assert.Equal(t, "partner.com", session.ReferrerName)
+			assert.Empty(t, session.ReferrerIcon)
+		}
+	}
+}
+
+func TestTracker_PageViewReferrerCategory(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", userAgent)
+	req.Header.Set("Referer", "https://duckduckgo.com/?q=web+analytics&ia=web")
+	tracker.PageView(req, 0, Options{})
+	tracker.Flush()
+	sessions := client.GetSessions()
+	pageViews := client.GetPageViews()
+	assert.Len(t, sessions, 1)
+	assert.Len(t, pageViews, 1)
+	assert.Equal(t, "https://duckduckgo.com", sessions[0].Referrer)
+	assert.Equal(t, "DuckDuckGo", sessions[0].ReferrerName)
+	assert.Equal(t, pkg.ReferrerCategorySearch, sessions[0].ReferrerCategory)
+	assert.Equal(t, "web analytics", sessions[0].ReferrerKeyword)
+	assert.Equal(t, pkg.ReferrerCategorySearch, pageViews[0].ReferrerCategory)
+	assert.Equal(t, "web analytics", pageViews[0].ReferrerKeyword)
+}
+
+func TestTracker_PageViewSiteSearch(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+		SiteSearch: &SiteSearch{
+			Parameters:         []string{"q", "s"},
+			CategoryParameters: []string{"category"},
+		},
+	})
+	tracker.SetSiteSearch(2, &SiteSearch{Parameters: []string{"search"}})
+
+	for i, path := range []string{"/search?q=&s=Running++Shoes&category=Men", "/shop?category=Men", "/search?search=ignored"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Add("User-Agent", userAgent)
+		req.RemoteAddr = fmt.Sprintf("81.2.69.%d", i)
+		tracker.PageView(req, 1, Options{})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", userAgent)
+	tracker.PageView(req, 2, Options{URL: "https://example.com/?search=Pirsch&q=ignored"})
+	tracker.Flush()
+	pageViews := client.GetPageViews()
+	assert.Len(t, pageViews, 4)
+	slices.SortFunc(pageViews, func(a, b model.PageView) int {
+		if a.ClientID == b.ClientID {
+			return strings.Compare(a.Path, b.Path)
+		}
+
+		return int(a.ClientID) - int(b.ClientID)
+	})
+	assert.Equal(t, "/search", pageViews[0].Path)
+	assert.Equal(t, "running shoes", pageViews[0].SearchTerm)
+	assert.Equal(t, "Men", pageViews[0].SearchCategory)
+	assert.Empty(t, pageViews[1].SearchTerm)
+	assert.Empty(t, pageViews[1].SearchCategory)
+	assert.Empty(t, pageViews[2].SearchTerm)
+	assert.Equal(t, "pirsch", pageViews[3].SearchTerm)
+	tracker.SetSiteSearch(2, nil)
+	assert.Equal(t, tracker.config.SiteSearch, tracker.getSiteSearch(2))
+}
+
+func TestTracker_PageViewStatusCode(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", userAgent)
+	tracker.PageView(req, 0, Options{})
+	req = httptest.NewRequest(http.MethodGet, "/missing", nil)
+	req.Header.Add("User-Agent", userAgent)
+	tracker.PageView(req, 0, Options{StatusCode: http.StatusNotFound})
+	tracker.Flush()
+	pageViews := client.GetPageViews()
+	assert.Len(t, pageViews, 2)
+	slices.SortFunc(pageViews, func(a, b model.PageView) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	assert.Equal(t, uint16(http.StatusOK), pageViews[0].StatusCode)
+	assert.Equal(t, uint16(http.StatusNotFound), pageViews[1].StatusCode)
+}
+
 func TestTracker_PageViewBounce(t *testing.T) {
 	client := db.NewClientMock()
 	tracker := NewTracker(Config{
@@ -434,7 +626,7 @@ func TestTracker_PageViewFindSessionRedis(t *testing.T) {
 	pageViews := client.GetPageViews()
 	assert.Len(t, sessions, 1)
 	assert.Len(t, pageViews, 1)
-	cache.Put(123, tracker.fingerprint(tracker.config.Salt, userAgent, "81.2.69.142", time.Now().UTC()), &model.Session{
+	cache.Put(123, tracker.fingerprint(req, userAgent, "81.2.69.142", "", nil, time.Now().UTC()), &model.Session{
 		Time: time.Now().UTC().Add(time.Hour * -4),
 	})
 	tracker.PageView(req, 123, Options{})
@@ -598,6 +790,36 @@ func TestTracker_Event(t *testing.T) {
 	assert.False(t, requests[0].Bot)
 }
 
+func TestTracker_OutboundLinkAndDownload(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/blog", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.OutboundLink(req, 0, "https://partner.com/article?ref=example", Options{}))
+	assert.False(t, tracker.OutboundLink(req, 0, "https://example.com/about", Options{}))
+	assert.True(t, tracker.Download(req, 0, "/files/whitepaper.pdf", Options{}))
+	assert.False(t, tracker.Download(req, 0, "/files/", Options{}))
+	tracker.Flush()
+	events := client.GetEvents()
+	assert.Len(t, events, 2)
+	slices.SortFunc(events, func(a, b model.Event) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	assert.Equal(t, pkg.EventNameDownload, events[0].Name)
+	assert.Equal(t, pkg.EventKindDownload, events[0].Kind)
+	assert.Equal(t, "/files/whitepaper.pdf", events[0].Target)
+	assert.Equal(t, "example.com", events[0].TargetHost)
+	assert.Equal(t, "pdf", events[0].TargetExtension)
+	assert.Equal(t, "/blog", events[0].Path)
+	assert.Equal(t, pkg.EventNameOutboundLink, events[1].Name)
+	assert.Equal(t, pkg.EventKindOutboundLink, events[1].Kind)
+	assert.Equal(t, "https://partner.com/article", events[1].Target)
+	assert.Equal(t, "partner.com", events[1].TargetHost)
+	assert.Empty(t, events[1].TargetExtension)
+}
+
 func TestTracker_EventDiscard(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/foo/bar?utm_source=Source&utm_campaign=Campaign&utm_medium=Medium&utm_content=Content&utm_term=Term", nil)
 	req.Header.Add("User-Agent", userAgent)
@@ -992,7 +1214,18 @@ func TestTracker_ignorePrefetch(t *testing.T) {
 	req.Header.Set("Purpose", "preview")
 	_, _, ignore = tracker.ignore(req)
 	assert.Equal(t, "prefetch", ignore)
+	req.Header.Set("Purpose", "prefetch")
+	req.Header.Set("Sec-Purpose", "prefetch;prerender")
+	_, _, ignore = tracker.ignore(req)
+	assert.Equal(t, "prerender", ignore)
 	req.Header.Del("Purpose")
+	req.Header.Set("Sec-Purpose", "prefetch; anonymous-client-ip")
+	_, _, ignore = tracker.ignore(req)
+	assert.Equal(t, "prefetch", ignore)
+	req.Header.Set("Sec-Purpose", "prefetch")
+	_, _, ignore = tracker.ignore(req)
+	assert.Equal(t, "prefetch", ignore)
+	req.Header.Del("Sec-Purpose")
 	_, _, ignore = tracker.ignore(req)
 	assert.Empty(t, ignore)
 }
@@ -1059,7 +1292,7 @@ func TestTracker_ignoreUserAgent(t *testing.T) {
 func TestTracker_ignoreBotUserAgent(t *testing.T) {
 	tracker := NewTracker(Config{})
 
-	for _, botUserAgent := range ua.Blacklist {
+	for _, botUserAgent := range ua.DefaultBlacklist() {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		req.Header.Set("User-Agent", botUserAgent)
 		_, _, ignore := tracker.ignore(req)
@@ -1183,6 +1416,97 @@ func TestTracker_getLanguage(t *testing.T) {
 	}
 }
 
+func TestTracker_getLocale(t *testing.T) {
+	input := []string{
+		"",
+		"  \t ",
+		"fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5",
+		"en-us, en",
+		"en_GB",
+		"es-419;q=0.9",
+		"zh-Hant-TW",
+		"de",
+		"invalid",
+		"xx-DE",
+	}
+	expected := []string{
+		"",
+		"",
+		"fr-CH",
+		"en-US",
+		"en-GB",
+		"es-419",
+		"zh-TW",
+		"de",
+		"",
+		"",
+	}
+	tracker := NewTracker(Config{})
+
+	for i, in := range input {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", in)
+		assert.Equal(t, expected[i], tracker.getLocale(req))
+	}
+}
+
+func TestTracker_getPreferredLanguages(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "de;q=0.7, fr-CH, invalid, en;q=0.8, fr;q=0.9, es;q=0, *;q=0.5, it;q=foo")
+	tracker := NewTracker(Config{})
+	assert.Nil(t, tracker.getPreferredLanguages(req))
+	tracker = NewTracker(Config{MaxLanguages: 3})
+	assert.Equal(t, []string{"fr", "en", "de"}, tracker.getPreferredLanguages(req))
+	tracker = NewTracker(Config{MaxLanguages: 10})
+	assert.Equal(t, []string{"fr", "en", "de"}, tracker.getPreferredLanguages(req))
+	req.Header.Del("Accept-Language")
+	assert.Nil(t, tracker.getPreferredLanguages(req))
+}
+
+func TestTracker_getTimezone(t *testing.T) {
+	geoDB, _ := geodb.NewGeoDB("", "", "")
+	assert.NoError(t, geoDB.UpdateFromFile("../../test/GeoIP2-City-Test.mmdb"))
+	tracker := NewTracker(Config{GeoDB: geoDB})
+	winter := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+	timezone, offset := tracker.getTimezone("81.2.69.142", winter, Options{}, GeoPrecisionCity)
+	assert.Equal(t, "Europe/London", timezone)
+	assert.Equal(t, int32(0), offset)
+	timezone, offset = tracker.getTimezone("81.2.69.142", summer, Options{}, GeoPrecisionCity)
+	assert.Equal(t, "Europe/London", timezone)
+	assert.Equal(t, int32(3600), offset)
+	timezone, offset = tracker.getTimezone("81.2.69.142", summer, Options{Timezone: "Europe/Berlin", TimezoneOffset: timezoneOffset(60)}, GeoPrecisionCity)
+	assert.Equal(t, "Europe/Berlin", timezone)
+	assert.Equal(t, int32(7200), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{TimezoneOffset: timezoneOffset(300)}, GeoPrecisionCity)
+	assert.Equal(t, "Etc/GMT+5", timezone)
+	assert.Equal(t, int32(-18000), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{TimezoneOffset: timezoneOffset(-330)}, GeoPrecisionCity)
+	assert.Equal(t, "UTC+05:30", timezone)
+	assert.Equal(t, int32(19800), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{TimezoneOffset: timezoneOffset(-345)}, GeoPrecisionCity)
+	assert.Equal(t, "UTC+05:45", timezone)
+	assert.Equal(t, int32(20700), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{TimezoneOffset: timezoneOffset(210)}, GeoPrecisionCity)
+	assert.Equal(t, "UTC-03:30", timezone)
+	assert.Equal(t, int32(-12600), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{TimezoneOffset: timezoneOffset(0)}, GeoPrecisionCity)
+	assert.Equal(t, "UTC", timezone)
+	assert.Equal(t, int32(0), offset)
+	timezone, offset = tracker.getTimezone("127.0.0.1", winter, Options{}, GeoPrecisionCity)
+	assert.Empty(t, timezone)
+	assert.Equal(t, int32(0), offset)
+	timezone, offset = tracker.getTimezone("81.2.69.142", summer, Options{}, GeoPrecisionRegion)
+	assert.Empty(t, timezone)
+	assert.Equal(t, int32(0), offset)
+	timezone, offset = tracker.getTimezone("81.2.69.142", summer, Options{TimezoneOffset: timezoneOffset(-60)}, GeoPrecisionCountry)
+	assert.Equal(t, "Etc/GMT-1", timezone)
+	assert.Equal(t, int32(3600), offset)
+	timezone, offset = tracker.getTimezone("81.2.69.142", summer, Options{Timezone: "Europe/Berlin"}, GeoPrecisionCountry)
+	assert.Equal(t, "Europe/Berlin", timezone)
+	assert.Equal(t, int32(7200), offset)
+}
+
 func TestTracker_getScreenClass(t *testing.T) {
 	req, _ := http.NewRequest(http.MethodGet, "/", nil)
 	req.Header.Set("Sec-CH-Width", "1920")
@@ -1226,3 +1550,459 @@ func TestTracker_referrerOrCampaignChanged(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, "/test?ref=Referrer", nil)
 	assert.True(t, tracker.referrerOrCampaignChanged(req, s, "", ""))
 }
+
+func TestTracker_Error(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/checkout", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.False(t, tracker.Error(req, 0, ErrorOptions{Message: "ReferenceError: x is not defined"}, Options{}))
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	assert.False(t, tracker.Error(req, 0, ErrorOptions{Message: " "}, Options{}))
+	assert.True(t, tracker.Error(req, 0, ErrorOptions{
+		Message: " ReferenceError: x is not defined ",
+		Source:  "https://example.com/app.js",
+		Line:    12,
+		Column:  5,
+		Stack:   "ReferenceError: x is not defined\n    at pay (https://example.com/app.js:12:5)",
+	}, Options{}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 1)
+	clientErrors := client.GetClientErrors()
+	assert.Len(t, clientErrors, 1)
+	assert.Equal(t, sessions[0].VisitorID, clientErrors[0].VisitorID)
+	assert.Equal(t, sessions[0].SessionID, clientErrors[0].SessionID)
+	assert.Equal(t, "/checkout", clientErrors[0].Path)
+	assert.Equal(t, "ReferenceError: x is not defined", clientErrors[0].Message)
+	assert.Equal(t, "https://example.com/app.js", clientErrors[0].Source)
+	assert.Equal(t, uint32(12), clientErrors[0].Line)
+	assert.Equal(t, uint32(5), clientErrors[0].Column)
+	assert.Len(t, clientErrors[0].Fingerprint, 16)
+	assert.Equal(t, sessions[0].Browser, clientErrors[0].Browser)
+	assert.Equal(t, sessions[0].OS, clientErrors[0].OS)
+}
+
+func TestTracker_Click(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store: client,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/pricing", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.False(t, tracker.Click(req, 0, ClickOptions{X: 0.5, Y: 0.5}, Options{}))
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	assert.False(t, tracker.Click(req, 0, ClickOptions{X: 2, Y: 0.5}, Options{}))
+	assert.True(t, tracker.Click(req, 0, ClickOptions{X: 0.25, Y: 0.75, ViewportWidth: 1280, Selector: "#signup"}, Options{}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 1)
+	clicks := client.GetClicks()
+	assert.Len(t, clicks, 1)
+	assert.Equal(t, sessions[0].VisitorID, clicks[0].VisitorID)
+	assert.Equal(t, sessions[0].SessionID, clicks[0].SessionID)
+	assert.Equal(t, "/pricing", clicks[0].Path)
+	assert.Equal(t, uint16(2500), clicks[0].X)
+	assert.Equal(t, uint16(7500), clicks[0].Y)
+	assert.Equal(t, "HD", clicks[0].ScreenClass)
+	assert.Equal(t, "#signup", clicks[0].Selector)
+}
+
+func TestTracker_SessionProperties(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:  client,
+		Worker: 1,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{Properties: map[string]string{"plan": "free", "locale": "en"}}))
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/pricing", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{Properties: map[string]string{"plan": "pro", "role": "admin"}}))
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/checkout", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 5)
+	assert.Equal(t, []string{"locale", "plan"}, sessions[0].PropertyKeys)
+	assert.Equal(t, []string{"en", "free"}, sessions[0].PropertyValues)
+	assert.Equal(t, int8(-1), sessions[1].Sign)
+	assert.Equal(t, []string{"locale", "plan"}, sessions[1].PropertyKeys)
+	assert.Equal(t, []string{"en", "free"}, sessions[1].PropertyValues)
+	assert.Equal(t, []string{"locale", "plan", "role"}, sessions[2].PropertyKeys)
+	assert.Equal(t, []string{"en", "pro", "admin"}, sessions[2].PropertyValues)
+	assert.Equal(t, []string{"locale", "plan", "role"}, sessions[4].PropertyKeys)
+	assert.Equal(t, []string{"en", "pro", "admin"}, sessions[4].PropertyValues)
+}
+
+func TestTracker_UserID(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:  client,
+		Worker: 1,
+		Salt:   "salt",
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/login", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{UserID: " user@example.com "}))
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/dashboard", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 5)
+	userID := tracker.userID("salt", "user@example.com")
+	assert.NotZero(t, userID)
+	assert.Zero(t, sessions[0].UserID)
+	assert.Zero(t, sessions[1].UserID)
+	assert.Equal(t, userID, sessions[2].UserID)
+	assert.Equal(t, userID, sessions[4].UserID)
+	assert.Equal(t, sessions[0].VisitorID, sessions[4].VisitorID)
+	assert.Zero(t, tracker.userID("salt", ""))
+	assert.NotEqual(t, userID, tracker.userID("other", "user@example.com"))
+	tracker = NewTracker(Config{Salt: "salt"})
+	assert.Equal(t, userID, tracker.userID("salt", "user@example.com"))
+}
+
+func TestTracker_Fingerprinter(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:  client,
+		Worker: 1,
+		Fingerprinter: &SipHashFingerprinter{
+			Salt:     "salt",
+			Rotation: FingerprintRotationHourly,
+		},
+	})
+	now := time.Now().UTC().Truncate(time.Hour).Add(time.Minute * 55)
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{Time: now}))
+	assert.True(t, tracker.PageView(req, 0, Options{Time: now.Add(time.Minute * 10), Path: "/pricing"}))
+	assert.True(t, tracker.PageView(req, 0, Options{Time: now, DeviceID: "device"}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 4)
+	visitorIDs := make(map[uint64]int)
+	sessionIDs := make(map[uint32]int)
+
+	for _, session := range sessions {
+		visitorIDs[session.VisitorID]++
+		sessionIDs[session.SessionID]++
+
+		if session.ExitPath == "/pricing" {
+			assert.Equal(t, int8(1), session.Sign)
+			assert.Equal(t, uint16(2), session.PageViews)
+		}
+	}
+
+	// the session continues in the next hour, but the device ID results in a new visitor
+	assert.Len(t, visitorIDs, 2)
+	assert.Len(t, sessionIDs, 2)
+}
+
+type rotatingKeys struct {
+	current, previous *keys.Keys
+}
+
+func (provider *rotatingKeys) Keys() (*keys.Keys, *keys.Keys) {
+	return provider.current, provider.previous
+}
+
+func TestTracker_KeyProvider(t *testing.T) {
+	client := db.NewClientMock()
+	provider := &rotatingKeys{current: &keys.Keys{Salt: "old", Key0: 1, Key1: 2}}
+	tracker := NewTracker(Config{
+		Store:       client,
+		Worker:      1,
+		KeyProvider: provider,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Add("User-Agent", userAgent)
+	assert.True(t, tracker.PageView(req, 0, Options{}))
+	provider.previous = provider.current
+	provider.current = &keys.Keys{Salt: "new", Key0: 3, Key1: 4}
+	assert.True(t, tracker.PageView(req, 0, Options{Path: "/pricing"}))
+	provider.previous = nil
+	assert.True(t, tracker.PageView(req, 0, Options{Path: "/checkout"}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 4)
+	sessionIDs := make(map[uint32]int)
+
+	for _, session := range sessions {
+		sessionIDs[session.SessionID]++
+
+		if session.ExitPath == "/pricing" {
+			assert.Equal(t, int8(1), session.Sign)
+			assert.Equal(t, uint16(2), session.PageViews)
+		} else if session.ExitPath == "/checkout" {
+			assert.Equal(t, uint16(1), session.PageViews)
+		}
+	}
+
+	// the session continues using the previous keys, but not after the grace period
+	assert.Len(t, sessionIDs, 2)
+}
+
+type timeFingerprinter struct{}
+
+func (fingerprinter timeFingerprinter) Fingerprint(data FingerprintData) uint64 {
+	return siphash.Hash(data.Keys.Key0, data.Keys.Key1, []byte(data.Keys.Salt+data.Time.String()))
+}
+
+func TestTracker_findSession(t *testing.T) {
+	provider := &rotatingKeys{
+		current:  &keys.Keys{Salt: "new", Key0: 3, Key1: 4},
+		previous: &keys.Keys{Salt: "old", Key0: 1, Key1: 2},
+	}
+	tracker := NewTracker(Config{
+		Store:         db.NewClientMock(),
+		KeyProvider:   provider,
+		Fingerprinter: timeFingerprinter{},
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Add("User-Agent", userAgent)
+	now := time.Now().UTC()
+	fingerprints := tracker.fingerprints(req, userAgent, "", "", now, now.Add(-sessionMaxAge))
+	assert.Len(t, fingerprints, 4)
+	tracker.config.SessionCache.Put(0, fingerprints[1], &model.Session{
+		SessionID: 1,
+		Time:      now.Add(-time.Minute),
+		Start:     now.Add(-time.Hour * 25),
+	})
+	assert.Nil(t, tracker.findSession(0, req, now, ua.UserAgent{UserAgent: userAgent}, "", Options{}))
+	tracker.config.SessionCache.Put(0, fingerprints[2], &model.Session{
+		SessionID: 2,
+		Time:      now.Add(-time.Minute),
+		Start:     now.Add(-time.Hour),
+	})
+	session := tracker.findSession(0, req, now, ua.UserAgent{UserAgent: userAgent}, "", Options{})
+	assert.NotNil(t, session)
+	assert.Equal(t, uint32(2), session.SessionID)
+}
+
+func TestTracker_PrivacyMode(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:       client,
+		Worker:      1,
+		PrivacyMode: PrivacyModeAggregate,
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/pricing?utm_source=newsletter", nil)
+	req.Header.Add("User-Agent", userAgent)
+	req.Header.Set("Sec-GPC", "1")
+	req.Header.Set("Referer", "https://google.com")
+	assert.True(t, tracker.PageView(req, 1, Options{}))
+	assert.True(t, tracker.Event(req, 1, EventOptions{Name: "signup"}, Options{}))
+	assert.False(t, tracker.ExtendSession(req, 1, Options{}))
+	assert.False(t, tracker.Click(req, 1, ClickOptions{X: 0.5, Y: 0.5}, Options{}))
+	tracker.SetPrivacyMode(2, PrivacyModeIgnore)
+	assert.False(t, tracker.PageView(req, 2, Options{}))
+	tracker.SetPrivacyMode(3, PrivacyModeTrack)
+	assert.True(t, tracker.PageView(req, 3, Options{}))
+	req.Header.Del("Sec-GPC")
+	req.Header.Set("DNT", "1")
+	tracker.RemovePrivacyMode(2)
+	assert.True(t, tracker.PageView(req, 2, Options{}))
+	req.Header.Del("DNT")
+	assert.True(t, tracker.PageView(req, 4, Options{}))
+	tracker.Flush()
+	assert.Len(t, client.GetSessions(), 2)
+	assert.Len(t, client.GetPageViews(), 2)
+	assert.Empty(t, client.GetEvents())
+	requests := client.GetRequests()
+	assert.Len(t, requests, 6)
+	optOut := make(map[uint64][]model.Request)
+
+	for _, request := range requests {
+		if request.OptOut {
+			optOut[request.ClientID] = append(optOut[request.ClientID], request)
+		}
+	}
+
+	assert.Len(t, optOut[1], 2)
+	assert.Zero(t, optOut[1][0].VisitorID)
+	assert.Empty(t, optOut[1][0].UserAgent)
+	assert.Empty(t, optOut[1][0].IP)
+	assert.False(t, optOut[1][0].Bot)
+	assert.Equal(t, "example.com", optOut[1][0].Hostname)
+	assert.Equal(t, "/pricing", optOut[1][0].Path)
+	assert.Equal(t, "https://google.com", optOut[1][0].Referrer)
+	assert.Equal(t, "newsletter", optOut[1][0].UTMSource)
+	assert.Equal(t, "signup", optOut[1][1].Event)
+	assert.Len(t, optOut[2], 2)
+	assert.Equal(t, "example.com", optOut[2][0].Hostname)
+	assert.Empty(t, optOut[2][0].Path)
+	assert.Empty(t, optOut[2][0].Referrer)
+	assert.Equal(t, "/pricing", optOut[2][1].Path)
+	assert.Empty(t, optOut[3])
+	assert.Empty(t, optOut[4])
+}
+
+func TestTracker_PrivacyModeHostname(t *testing.T) {
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:       client,
+		Worker:      1,
+		PrivacyMode: PrivacyModeAggregate,
+	})
+	req := httptest.NewRequest(http.MethodPost, "https://api.example.com/event", nil)
+	req.Header.Add("User-Agent", userAgent)
+	req.Header.Set("Sec-GPC", "1")
+	assert.True(t, tracker.PageView(req, 1, Options{URL: "https://www.example.org/pricing"}))
+	assert.True(t, tracker.Event(req, 1, EventOptions{Name: "signup"}, Options{URL: "https://example.org/signup"}))
+	assert.True(t, tracker.PageView(req, 1, Options{}))
+	tracker.Flush()
+	requests := client.GetRequests()
+	assert.Len(t, requests, 3)
+	assert.Equal(t, "example.org", requests[0].Hostname)
+	assert.Equal(t, "/pricing", requests[0].Path)
+	assert.Equal(t, "example.org", requests[1].Hostname)
+	assert.Equal(t, "/signup", requests[1].Path)
+	assert.Equal(t, "api.example.com", requests[2].Hostname)
+}
+
+func TestTracker_IPTruncationAndGeoPrecision(t *testing.T) {
+	locator, err := geodb.NewCIDR([]geodb.Location{
+		{Network: "81.2.69.0/24", CountryCode: "gb", Region: "England", City: "London"},
+		{Network: "81.2.69.142/32", CountryCode: "de", Region: "Berlin", City: "Berlin"},
+	})
+	assert.NoError(t, err)
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:        client,
+		Worker:       1,
+		GeoDB:        locator,
+		GeoPrecision: GeoPrecisionRegion,
+		LogIP:        true,
+		TruncateIP:   true,
+	})
+	tracker.SetGeoPrecision(2, GeoPrecisionCountry)
+	tracker.SetGeoPrecision(3, GeoPrecisionCity)
+	tracker.SetGeoPrecision(4, GeoPrecisionCountry)
+	tracker.RemoveGeoPrecision(4)
+
+	for clientID := uint64(1); clientID <= 4; clientID++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Add("User-Agent", userAgent)
+		req.RemoteAddr = "81.2.69.142"
+		assert.True(t, tracker.PageView(req, clientID, Options{}))
+	}
+
+	tracker.Flush()
+	sessions := client.GetSessions()
+	assert.Len(t, sessions, 4)
+	locations := make(map[uint64][3]string)
+
+	for _, session := range sessions {
+		locations[session.ClientID] = [3]string{session.CountryCode, session.Region, session.City}
+	}
+
+	assert.Equal(t, [3]string{"gb", "England", ""}, locations[1])
+	assert.Equal(t, [3]string{"gb", "", ""}, locations[2])
+	assert.Equal(t, [3]string{"gb", "England", "London"}, locations[3])
+	assert.Equal(t, [3]string{"gb", "England", ""}, locations[4])
+	requests := client.GetRequests()
+	assert.Len(t, requests, 4)
+
+	for _, request := range requests {
+		assert.Equal(t, "81.2.69.0", request.IP)
+	}
+}
+
+func TestTracker_Activate(t *testing.T) {
+	newRequest := func(purpose string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/pricing?utm_source=newsletter", nil)
+		req.Header.Add("User-Agent", userAgent)
+		req.Header.Set("Referer", "https://google.com")
+		req.RemoteAddr = "81.2.69.142"
+
+		if purpose != "" {
+			req.Header.Set("Sec-Purpose", purpose)
+		}
+
+		return req
+	}
+	client := db.NewClientMock()
+	tracker := NewTracker(Config{
+		Store:  client,
+		Worker: 1,
+	})
+	assert.False(t, tracker.PageView(newRequest("prefetch;prerender"), 1, Options{}))
+	assert.False(t, tracker.Activate(newRequest(""), 1, Options{Path: "/pricing"}))
+	tracker.Flush()
+	assert.Empty(t, client.GetSessions())
+	requests := client.GetRequests()
+	assert.Len(t, requests, 1)
+	assert.True(t, requests[0].Bot)
+	assert.Equal(t, "prerender", requests[0].BotReason)
+
+	client = db.NewClientMock()
+	tracker = NewTracker(Config{
+		Store:            client,
+		Worker:           1,
+		PrerenderTimeout: time.Minute,
+	})
+	prerendered := time.Now().UTC().Add(-time.Second * 10)
+	assert.True(t, tracker.PageView(newRequest("prefetch;prerender"), 1, Options{Time: prerendered}))
+	assert.True(t, tracker.PageView(newRequest("prefetch;prerender"), 2, Options{Time: prerendered.Add(-time.Minute * 2)}))
+	tracker.Flush()
+	assert.Empty(t, client.GetSessions())
+	assert.Empty(t, client.GetRequests())
+	assert.False(t, tracker.Activate(newRequest(""), 1, Options{Path: "/foo"}))
+	assert.True(t, tracker.Activate(newRequest(""), 1, Options{Path: "/pricing"}))
+	assert.False(t, tracker.Activate(newRequest(""), 1, Options{Path: "/pricing"}))
+	assert.False(t, tracker.Activate(newRequest(""), 2, Options{Path: "/pricing"}))
+	tracker.Flush()
+	sessions := client.GetSessions()
+	pageViews := client.GetPageViews()
+	assert.Len(t, sessions, 1)
+	assert.Len(t, pageViews, 1)
+	assert.Equal(t, uint64(1), sessions[0].ClientID)
+	assert.True(t, sessions[0].Time.After(prerendered))
+	assert.Equal(t, "/pricing", pageViews[0].Path)
+	assert.Equal(t, "https://google.com", pageViews[0].Referrer)
+	assert.Equal(t, "newsletter", pageViews[0].UTMSource)
+	assert.True(t, pageViews[0].Time.After(prerendered))
+}
+
+func TestTracker_prerenderCache(t *testing.T) {
+	cache := newPrerenderCache(2)
+	now := time.Now().UTC()
+	cache.put(&prerender{key: prerenderKey{clientID: 1, path: "/"}, expires: now.Add(-time.Second)})
+	cache.put(&prerender{key: prerenderKey{clientID: 2, path: "/"}, expires: now.Add(time.Minute)})
+	cache.put(&prerender{key: prerenderKey{clientID: 3, path: "/"}, expires: now.Add(time.Minute)})
+	assert.Equal(t, 2, cache.len())
+	_, found := cache.take(prerenderKey{clientID: 1, path: "/"})
+	assert.False(t, found)
+	cache.put(&prerender{key: prerenderKey{clientID: 2, path: "/"}, expires: now.Add(-time.Second)})
+	assert.Equal(t, 2, cache.len())
+	cache.expire(now)
+	assert.Equal(t, 1, cache.len())
+	p, found := cache.take(prerenderKey{clientID: 3, path: "/"})
+	assert.True(t, found)
+	assert.Equal(t, uint64(3), p.key.clientID)
+	assert.Zero(t, cache.len())
+
+	tracker := NewTracker(Config{
+		Store:            db.NewClientMock(),
+		PrerenderTimeout: time.Millisecond * 10,
+	})
+	defer tracker.Stop()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Add("User-Agent", userAgent)
+	req.Header.Set("Sec-Purpose", "prefetch;prerender")
+	assert.True(t, tracker.PageView(req, 1, Options{}))
+	assert.Equal(t, 1, tracker.prerenders.len())
+	assert.Eventually(t, func() bool {
+		return tracker.prerenders.len() == 0
+	}, time.Second, time.Millisecond*10)
+}
@@ -1,7 +1,7 @@
 package ua
 
-// Blacklist is a list of User-Agents to ignore.
-var Blacklist = []string{
+// defaultBlacklist is the list of User-Agents to ignore by default (see DefaultBlacklist).
+var defaultBlacklist = []string{
 	"!(()&&!|*|*|",
 	"${",
 	"(null)",
@@ -335,7 +335,6 @@ var Blacklist = []string{
 	"dsurf",
 	"dts agent",
 	"duckduckbot",
-	"duckduckgo",
 	"durston",
 	"dynamic-image",
 	"e46df615-2dbc-4311-8217-c4e61c4ed1e2",
@@ -0,0 +1,146 @@
+package ua
+
+import (
+	"container/list"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultCacheSize = 10_000
+)
+
+// cacheHeader is the list of client hint headers that influence the parsing result.
+var cacheHeader = []string{
+	"Sec-CH-UA",
+	"Sec-CH-UA-Mobile",
+	"Sec-CH-UA-Model",
+	"Sec-CH-UA-Platform",
+	"Sec-CH-UA-Platform-Version",
+}
+
+// CacheStats are the statistics for a Cache.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+// HitRate returns the share of Parse calls that were answered from the cache.
+func (stats CacheStats) HitRate() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+// Cache is a bounded least-recently-used cache for parsed User-Agents.
+// The key is the User-Agent together with the client hint headers. It is safe for concurrent use.
+type Cache struct {
+	maxSize int
+	entries map[string]*list.Element
+	lru     *list.List
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+	m       sync.Mutex
+}
+
+type cacheEntry struct {
+	key       string
+	rules     *compiledRules
+	userAgent UserAgent
+}
+
+// NewCache creates a new Cache for given maximum number of entries.
+func NewCache(maxSize int) *Cache {
+	if maxSize <= 0 {
+		maxSize = defaultCacheSize
+	}
+
+	return &Cache{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Parse returns the cached result for given request, or else parses and caches it.
+// Results parsed using different Rules (see SetRules) are not reused.
+func (cache *Cache) Parse(r *http.Request) UserAgent {
+	key := cache.getKey(r)
+	rules := activeRules.Load()
+	cache.m.Lock()
+	element, found := cache.entries[key]
+
+	if found && element.Value.(*cacheEntry).rules == rules {
+		cache.lru.MoveToFront(element)
+		userAgent := element.Value.(*cacheEntry).userAgent
+		cache.m.Unlock()
+		cache.hits.Add(1)
+		userAgent.Time = time.Now().UTC()
+		return userAgent
+	}
+
+	cache.m.Unlock()
+	cache.misses.Add(1)
+	userAgent := parseUserAgent(r, rules)
+	cache.put(key, rules, userAgent)
+	return userAgent
+}
+
+// Stats returns the hits, misses, and number of entries.
+func (cache *Cache) Stats() CacheStats {
+	cache.m.Lock()
+	size := cache.lru.Len()
+	cache.m.Unlock()
+	return CacheStats{
+		Hits:   cache.hits.Load(),
+		Misses: cache.misses.Load(),
+		Size:   size,
+	}
+}
+
+// Clear removes all entries and resets the statistics.
+func (cache *Cache) Clear() {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+	cache.entries = make(map[string]*list.Element)
+	cache.lru.Init()
+	cache.hits.Store(0)
+	cache.misses.Store(0)
+}
+
+func (cache *Cache) put(key string, rules *compiledRules, userAgent UserAgent) {
+	cache.m.Lock()
+	defer cache.m.Unlock()
+
+	if element, found := cache.entries[key]; found {
+		element.Value = &cacheEntry{key, rules, userAgent}
+		cache.lru.MoveToFront(element)
+		return
+	}
+
+	if cache.lru.Len() >= cache.maxSize {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*cacheEntry).key)
+	}
+
+	cache.entries[key] = cache.lru.PushFront(&cacheEntry{key, rules, userAgent})
+}
+
+func (cache *Cache) getKey(r *http.Request) string {
+	var key strings.Builder
+	key.WriteString(r.UserAgent())
+
+	for _, header := range cacheHeader {
+		key.WriteByte('\n')
+		key.WriteString(r.Header.Get(header))
+	}
+
+	return key.String()
+}
@@ -0,0 +1,143 @@
+package ua
+
+import (
+	"fmt"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestCache_Parse(t *testing.T) {
+	cache := NewCache(0)
+	assert.Equal(t, defaultCacheSize, cache.maxSize)
+	unique := make(map[string]struct{})
+
+	for _, ua := range userAgentsAll {
+		unique[ua.ua] = struct{}{}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("User-Agent", ua.ua)
+		expected := Parse(req)
+		first := cache.Parse(req)
+		second := cache.Parse(req)
+		first.Time, second.Time = expected.Time, expected.Time
+		assert.Equal(t, expected, first)
+		assert.Equal(t, expected, second)
+	}
+
+	stats := cache.Stats()
+	assert.Equal(t, len(unique), stats.Size)
+	assert.Equal(t, uint64(len(unique)), stats.Misses)
+	assert.Equal(t, uint64(len(userAgentsAll)*2-len(unique)), stats.Hits)
+	assert.InDelta(t, float64(len(userAgentsAll)*2-len(unique))/float64(len(userAgentsAll)*2), stats.HitRate(), 0.001)
+	cache.Clear()
+	stats = cache.Stats()
+	assert.Zero(t, stats.Size)
+	assert.Zero(t, stats.Hits)
+	assert.Zero(t, stats.Misses)
+	assert.Zero(t, stats.HitRate())
+}
+
+func TestCache_ParseClientHints(t *testing.T) {
+	cache := NewCache(10)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	userAgent := cache.Parse(req)
+	assert.Equal(t, pkg.OSWindows, userAgent.OS)
+	assert.Equal(t, "10", userAgent.OSVersion)
+	req.Header.Set("Sec-CH-UA-Platform", `"Windows"`)
+	req.Header.Set("Sec-CH-UA-Platform-Version", `"14.0.0"`)
+	userAgent = cache.Parse(req)
+	assert.Equal(t, pkg.OSWindows, userAgent.OS)
+	assert.Equal(t, "11", userAgent.OSVersion)
+	stats := cache.Stats()
+	assert.Equal(t, 2, stats.Size)
+	assert.Zero(t, stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+}
+
+func TestCache_ParseRules(t *testing.T) {
+	defer func() {
+		assert.NoError(t, SetRules(nil))
+	}()
+	cache := NewCache(10)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) NewBrowser/2.3.4 Chrome/120.0.0.0 Safari/537.36")
+	assert.Equal(t, pkg.BrowserChrome, cache.Parse(req).Browser)
+	assert.NoError(t, SetRules(&Rules{
+		Browser: []Rule{{Name: "New Browser", Tokens: []string{"NewBrowser/"}}},
+	}))
+	userAgent := cache.Parse(req)
+	assert.Equal(t, "New Browser", userAgent.Browser)
+	assert.Equal(t, "2.3", userAgent.BrowserVersion)
+	assert.Equal(t, "New Browser", cache.Parse(req).Browser)
+	stats := cache.Stats()
+	assert.Equal(t, 1, stats.Size)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+}
+
+func TestCache_Evict(t *testing.T) {
+	cache := NewCache(3)
+	requests := make([]*http.Request, 4)
+
+	for i := range requests {
+		requests[i] = httptest.NewRequest(http.MethodGet, "/", nil)
+		requests[i].Header.Set("User-Agent", fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/12%d.0.0.0 Safari/537.36", i))
+	}
+
+	cache.Parse(requests[0])
+	cache.Parse(requests[1])
+	cache.Parse(requests[2])
+	cache.Parse(requests[0]) // the second request is now the least recently used one
+	cache.Parse(requests[3])
+	assert.Equal(t, 3, cache.Stats().Size)
+	assert.Contains(t, cache.entries, cache.getKey(requests[0]))
+	assert.NotContains(t, cache.entries, cache.getKey(requests[1]))
+	assert.Contains(t, cache.entries, cache.getKey(requests[2]))
+	assert.Contains(t, cache.entries, cache.getKey(requests[3]))
+}
+
+func TestCache_Concurrency(t *testing.T) {
+	cache := NewCache(len(userAgentsAll) / 2)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for _, ua := range userAgentsAll {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.Header.Set("User-Agent", ua.ua)
+				assert.Equal(t, ua.browser, cache.Parse(req).Browser)
+			}
+		}()
+	}
+
+	wg.Wait()
+	stats := cache.Stats()
+	assert.Equal(t, uint64(len(userAgentsAll)*8), stats.Hits+stats.Misses)
+	assert.LessOrEqual(t, stats.Size, len(userAgentsAll)/2)
+}
+
+func BenchmarkCache_Parse(b *testing.B) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	cache := NewCache(0)
+
+	b.Run("Parse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Parse(req)
+		}
+	})
+
+	b.Run("Cache", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cache.Parse(req)
+		}
+	})
+}
@@ -1,6 +1,17 @@
 package ua
 
-import "unicode"
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg/util"
+	"slices"
+	"sync/atomic"
+	"unicode"
+)
+
+var blacklist atomic.Pointer[util.Matcher]
+
+func init() {
+	blacklist.Store(util.NewMatcher(defaultBlacklist))
+}
 
 // ContainsNonASCIICharacters returns true if the string only consists out of ASCII characters.
 func ContainsNonASCIICharacters(ua string) bool {
@@ -12,3 +23,20 @@ func ContainsNonASCIICharacters(ua string) bool {
 
 	return false
 }
+
+// IsBlacklisted returns true if the lowercase User-Agent contains any of the blacklisted keywords.
+func IsBlacklisted(ua string) bool {
+	return blacklist.Load().Contains(ua)
+}
+
+// SetBlacklist replaces the keywords used by IsBlacklisted, which is the DefaultBlacklist by default.
+// The keywords must be lowercase. Use util.NewMatcher to match against additional lists.
+func SetBlacklist(list []string) {
+	blacklist.Store(util.NewMatcher(list))
+}
+
+// DefaultBlacklist returns a copy of the User-Agent keywords ignored by default.
+// To extend it, append to the copy and pass it to SetBlacklist.
+func DefaultBlacklist() []string {
+	return slices.Clone(defaultBlacklist)
+}
@@ -2,6 +2,7 @@ package ua
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -20,3 +21,58 @@ func TestContainsNonASCIICharacters(t *testing.T) {
 		assert.False(t, ContainsNonASCIICharacters(in.ua))
 	}
 }
+
+func TestIsBlacklisted(t *testing.T) {
+	for _, in := range defaultBlacklist {
+		assert.True(t, IsBlacklisted("mozilla/5.0 "+in+" (compatible)"))
+	}
+
+	for _, in := range userAgentsAll {
+		userAgent := strings.ToLower(in.ua)
+		found := false
+
+		for _, keyword := range defaultBlacklist {
+			if strings.Contains(userAgent, keyword) {
+				found = true
+				break
+			}
+		}
+
+		assert.Equal(t, found, IsBlacklisted(userAgent), in.ua)
+	}
+
+	assert.False(t, IsBlacklisted("mozilla/5.0 (linux; android 14) applewebkit/537.36 (khtml, like gecko) version/4.0 chrome/120.0.6099.144 mobile duckduckgo/5 safari/537.36"))
+
+	defer SetBlacklist(defaultBlacklist)
+	SetBlacklist([]string{"custom"})
+	assert.True(t, IsBlacklisted("mozilla/5.0 custom"))
+	assert.False(t, IsBlacklisted("mozilla/5.0 (compatible; googlebot/2.1)"))
+	list := DefaultBlacklist()
+	list[0] = "changed"
+	assert.NotEqual(t, "changed", defaultBlacklist[0])
+	SetBlacklist(append(list, "custom"))
+	assert.True(t, IsBlacklisted("mozilla/5.0 custom"))
+	assert.True(t, IsBlacklisted("mozilla/5.0 (compatible; googlebot/2.1)"))
+}
+
+func BenchmarkIsBlacklisted(b *testing.B) {
+	userAgent := strings.ToLower(userAgentsAll[len(userAgentsAll)/2].ua)
+
+	b.Run("Loop", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, keyword := range defaultBlacklist {
+				if strings.Contains(userAgent, keyword) {
+					b.Fatal("unexpected match")
+				}
+			}
+		}
+	})
+
+	b.Run("Matcher", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if IsBlacklisted(userAgent) {
+				b.Fatal("unexpected match")
+			}
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package ua
+
+import (
+	"github.com/emvi/null"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// getDevice returns the device type, brand, and model for given request and the parsed operating system.
+// The model is read from the Sec-CH-UA-Model client hint if present, or else extracted from the User-Agent.
+func getDevice(r *http.Request, os string, mobile null.Bool) (string, string, string) {
+	ua := strings.Trim(r.UserAgent(), ` '"`)
+	system := parseSystem(ua, strings.IndexRune(ua, uaSystemLeftDelimiter), strings.IndexRune(ua, uaSystemRightDelimiter))
+	model := strings.TrimSpace(strings.Trim(r.Header.Get("Sec-CH-UA-Model"), `"'`))
+
+	if model == "" {
+		model = getDeviceModel(system, os)
+	}
+
+	deviceType := getDeviceType(strings.ToLower(ua), model, os, mobile)
+	return deviceType, getDeviceBrand(ua, model, os), model
+}
+
+func getDeviceType(ua, model, os string, mobile null.Bool) string {
+	if ua == "" {
+		return ""
+	}
+
+	if containsAny(ua, deviceBotKeywords) {
+		return pkg.DeviceTypeBot
+	} else if containsAny(ua, deviceConsoleKeywords) {
+		return pkg.DeviceTypeConsole
+	} else if containsAny(ua, deviceTVKeywords) || deviceTVModel.MatchString(model) {
+		return pkg.DeviceTypeTV
+	} else if containsAny(ua, deviceWearableKeywords) {
+		return pkg.DeviceTypeWearable
+	} else if containsAny(ua, deviceTabletKeywords) {
+		return pkg.DeviceTypeTablet
+	}
+
+	switch os {
+	case pkg.OSAndroid:
+		// Android tablets don't send "Mobile" in the User-Agent or client hints
+		if mobile.Valid && !mobile.Bool || !mobile.Valid && !strings.Contains(ua, "mobile") {
+			return pkg.DeviceTypeTablet
+		}
+
+		return pkg.DeviceTypePhone
+	case pkg.OSiOS, pkg.OSWindowsMobile:
+		return pkg.DeviceTypePhone
+	case pkg.OSWindows, pkg.OSMac, pkg.OSLinux, pkg.OSChrome:
+		if mobile.Valid && mobile.Bool {
+			return pkg.DeviceTypePhone
+		}
+
+		return pkg.DeviceTypeDesktop
+	}
+
+	if mobile.Valid && mobile.Bool || strings.Contains(ua, "mobile") {
+		return pkg.DeviceTypePhone
+	}
+
+	return ""
+}
+
+func getDeviceModel(system []string, os string) string {
+	for i, sys := range system {
+		if sys == "iPhone" || sys == "iPad" || sys == "iPod" || sys == "iPod touch" {
+			return sys
+		} else if strings.HasPrefix(sys, "Xbox") ||
+			strings.HasPrefix(sys, "PlayStation") ||
+			strings.HasPrefix(sys, "Nintendo") {
+			// the first entry is the generic "Xbox", the second one the actual model if present
+			if sys == "Xbox" && i+1 < len(system) && strings.HasPrefix(system[i+1], "Xbox") {
+				continue
+			}
+
+			return stripFirmwareVersion(sys)
+		}
+	}
+
+	if os != pkg.OSAndroid {
+		return ""
+	}
+
+	// the model follows the Android version, like "Linux; Android 13; SM-S918B Build/TP1A.220624.014"
+	for i, sys := range system {
+		if !strings.HasPrefix(sys, "Android") {
+			continue
+		}
+
+		for _, entry := range system[i+1:] {
+			model, _, _ := strings.Cut(entry, " Build/")
+			model = strings.TrimSpace(model)
+
+			if model != "" && !slices.Contains(deviceIgnoreModel, model) && !isLocale(model) {
+				return model
+			}
+		}
+
+		break
+	}
+
+	return ""
+}
+
+func getDeviceBrand(ua, model, os string) string {
+	lowerModel := strings.ToLower(model)
+
+	if lowerModel != "" {
+		for _, brand := range deviceBrandPrefix {
+			if strings.HasPrefix(lowerModel, brand.prefix) {
+				return brand.brand
+			}
+		}
+
+		for _, brand := range deviceBrandPattern {
+			if brand.pattern.MatchString(model) {
+				return brand.brand
+			}
+		}
+	}
+
+	if os == pkg.OSMac || os == pkg.OSiOS {
+		return "Apple"
+	}
+
+	lowerUA := strings.ToLower(ua)
+
+	if strings.Contains(lowerUA, "samsung") || strings.Contains(lowerUA, "tizen") {
+		return "Samsung"
+	} else if strings.Contains(lowerUA, "web0s") || strings.Contains(lowerUA, "netcast") {
+		return "LG"
+	}
+
+	return ""
+}
+
+// stripFirmwareVersion removes trailing version numbers from console models, like "PlayStation 5 3.20".
+func stripFirmwareVersion(model string) string {
+	fields := strings.Fields(model)
+
+	for len(fields) > 1 && strings.Contains(fields[len(fields)-1], ".") && unicode.IsDigit(rune(fields[len(fields)-1][0])) {
+		fields = fields[:len(fields)-1]
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// isLocale returns true for system strings like "en-us" or "de_DE" that some Android browsers send.
+func isLocale(str string) bool {
+	return len(str) == 5 && (str[2] == '-' || str[2] == '_')
+}
+
+func containsAny(str string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(str, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package ua
+
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestParseDevice(t *testing.T) {
+	input := []string{
+		"",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (iPad; CPU OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (Linux; Android 13; SM-S918B Build/TP1A.220624.014) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		"Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		"Mozilla/5.0 (Linux; Android 13; SM-X710) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		"Mozilla/5.0 (Linux; Android 9; AFTMM Build/PS7233) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		"Mozilla/5.0 (SMART-TV; Linux; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/4.0 Chrome/76.0.3809.146 TV Safari/537.36",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edge/44.18363.8131",
+		"Mozilla/5.0 (PlayStation 5 3.20) AppleWebKit/605.1.15 (KHTML, like Gecko)",
+		"Mozilla/5.0 (Linux; Android 11; Wear OS; SM-R860) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+	}
+	expected := [][]string{
+		{"", "", ""},
+		{pkg.DeviceTypeDesktop, "", ""},
+		{pkg.DeviceTypeDesktop, "Apple", ""},
+		{pkg.DeviceTypePhone, "Apple", "iPhone"},
+		{pkg.DeviceTypeTablet, "Apple", "iPad"},
+		{pkg.DeviceTypePhone, "Samsung", "SM-S918B"},
+		{pkg.DeviceTypePhone, "", ""},
+		{pkg.DeviceTypePhone, "Google", "Pixel 7"},
+		{pkg.DeviceTypeTablet, "Samsung", "SM-X710"},
+		{pkg.DeviceTypeTV, "Amazon", "AFTMM"},
+		{pkg.DeviceTypeTV, "Samsung", ""},
+		{pkg.DeviceTypeConsole, "Microsoft", "Xbox One"},
+		{pkg.DeviceTypeConsole, "Sony", "PlayStation 5"},
+		{pkg.DeviceTypeWearable, "Samsung", "SM-R860"},
+		{pkg.DeviceTypeBot, "", ""},
+	}
+
+	for i, in := range input {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("User-Agent", in)
+		ua := Parse(req)
+		assert.Equal(t, expected[i][0], ua.DeviceType, in)
+		assert.Equal(t, expected[i][1], ua.DeviceBrand, in)
+		assert.Equal(t, expected[i][2], ua.DeviceModel, in)
+	}
+}
+
+func TestParseDeviceModel(t *testing.T) {
+	for _, device := range userAgentsDevice {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("User-Agent", device.ua)
+		ua := Parse(req)
+		assert.Equal(t, device.deviceType, ua.DeviceType, device.ua)
+		assert.Equal(t, device.deviceBrand, ua.DeviceBrand, device.ua)
+		assert.Equal(t, device.deviceModel, ua.DeviceModel, device.ua)
+	}
+}
+
+func TestParseDeviceClientHints(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36")
+	req.Header.Set("Sec-CH-UA-Mobile", "?1")
+	req.Header.Set("Sec-CH-UA-Model", `"Pixel 8 Pro"`)
+	ua := Parse(req)
+	assert.Equal(t, pkg.DeviceTypePhone, ua.DeviceType)
+	assert.Equal(t, "Google", ua.DeviceBrand)
+	assert.Equal(t, "Pixel 8 Pro", ua.DeviceModel)
+	req.Header.Set("Sec-CH-UA-Mobile", "?0")
+	req.Header.Set("Sec-CH-UA-Model", `"SM-X710"`)
+	ua = Parse(req)
+	assert.Equal(t, pkg.DeviceTypeTablet, ua.DeviceType)
+	assert.Equal(t, "Samsung", ua.DeviceBrand)
+	assert.Equal(t, "SM-X710", ua.DeviceModel)
+	req.Header.Set("Sec-CH-UA-Model", `""`)
+	ua = Parse(req)
+	assert.Empty(t, ua.DeviceModel)
+}
+
+func TestGetDeviceBrand(t *testing.T) {
+	input := []string{
+		"Mi 9T",
+		"MI MAX 3",
+		"Mini",
+		"XT2041-3",
+		"XTREME",
+		"KFMUWI",
+		"KFTT",
+		"KFC",
+		"Kfoo",
+	}
+	expected := []string{
+		"Xiaomi",
+		"Xiaomi",
+		"",
+		"Motorola",
+		"",
+		"Amazon",
+		"Amazon",
+		"",
+		"",
+	}
+
+	for i, in := range input {
+		assert.Equal(t, expected[i], getDeviceBrand("", in, pkg.OSAndroid), in)
+	}
+}
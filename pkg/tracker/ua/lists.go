@@ -1,6 +1,9 @@
 package ua
 
-import "github.com/pirsch-analytics/pirsch/v6/pkg"
+import (
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"regexp"
+)
 
 var (
 	// filterProductPrefix is a list of product prefixes to ignore, as they provide no value in identifying a browser.
@@ -14,6 +17,29 @@ var (
 		"QtWebEngine/",
 	}
 
+	// webviewApps maps (case-sensitive) User-Agent tokens to the apps hosting in-app browsers.
+	webviewApps = []struct {
+		token string
+		app   string
+	}{
+		{"FBAN/", "Facebook"},
+		{"FBAV/", "Facebook"},
+		{"FB_IAB/", "Facebook"},
+		{"Instagram ", "Instagram"},
+		{"musical_ly", "TikTok"},
+		{"trill_", "TikTok"},
+		{"BytedanceWebview/", "TikTok"},
+		{"Snapchat", "Snapchat"},
+		{"LinkedInApp", "LinkedIn"},
+		{"Pinterest/", "Pinterest"},
+		{"Twitter for iPhone", "Twitter"},
+		{"TwitterAndroid", "Twitter"},
+		{"MicroMessenger/", "WeChat"},
+		{"WhatsApp", "WhatsApp"},
+		{" Line/", "LINE"},
+		{"GSA/", "Google"},
+	}
+
 	// windowsVersions maps a Windows user agent versions to the product versions.
 	// https://en.wikipedia.org/wiki/List_of_Microsoft_Windows_versions
 	windowsVersions = map[string]string{
@@ -119,6 +145,130 @@ var (
 		"619.1":  "18",
 	}
 
+	// deviceBotKeywords are (lowercase) keywords identifying bots.
+	deviceBotKeywords = []string{
+		"bot/",
+		"bot;",
+		"bot)",
+		"bot-",
+		"crawler",
+		"spider",
+		"headlesschrome",
+	}
+
+	// deviceConsoleKeywords are (lowercase) keywords identifying gaming consoles.
+	deviceConsoleKeywords = []string{
+		"playstation",
+		"xbox",
+		"nintendo",
+	}
+
+	// deviceTVKeywords are (lowercase) keywords identifying smart TVs and streaming devices.
+	deviceTVKeywords = []string{
+		"smart-tv",
+		"smarttv",
+		"googletv",
+		"google tv",
+		"android tv",
+		"appletv",
+		"apple tv",
+		"tvos",
+		"hbbtv",
+		"web0s",
+		"netcast",
+		"roku",
+		"crkey",
+		"bravia",
+	}
+
+	// deviceTVModel matches the models of Amazon Fire TV devices, like "AFTMM" or "AFTKA".
+	deviceTVModel = regexp.MustCompile(`^AFT[A-Z0-9]+$`)
+
+	// deviceWearableKeywords are (lowercase) keywords identifying wearables.
+	deviceWearableKeywords = []string{
+		"watch os",
+		"watchos",
+		"wear os",
+		"wearos",
+		"smartwatch",
+	}
+
+	// deviceTabletKeywords are (lowercase) keywords identifying tablets.
+	deviceTabletKeywords = []string{
+		"ipad",
+		"tablet",
+		"kindle",
+		"silk/",
+		"playbook",
+	}
+
+	// deviceIgnoreModel is a list of system strings that are no device models.
+	deviceIgnoreModel = []string{
+		"Linux",
+		"U",
+		"K",
+		"wv",
+		"Mobile",
+		"Tablet",
+		"arm",
+		"arm64",
+		"Wear OS",
+		"x86_64",
+	}
+
+	// deviceBrandPrefix maps (lowercase) device model prefixes to brands.
+	deviceBrandPrefix = []struct {
+		prefix string
+		brand  string
+	}{
+		{"iphone", "Apple"},
+		{"ipad", "Apple"},
+		{"ipod", "Apple"},
+		{"macintosh", "Apple"},
+		{"sm-", "Samsung"},
+		{"gt-", "Samsung"},
+		{"galaxy", "Samsung"},
+		{"samsung", "Samsung"},
+		{"pixel", "Google"},
+		{"nexus", "Google"},
+		{"redmi", "Xiaomi"},
+		{"poco", "Xiaomi"},
+		{"xiaomi", "Xiaomi"},
+		{"oneplus", "OnePlus"},
+		{"oppo", "OPPO"},
+		{"rmx", "realme"},
+		{"realme", "realme"},
+		{"vivo", "vivo"},
+		{"moto", "Motorola"},
+		{"nokia", "Nokia"},
+		{"huawei", "Huawei"},
+		{"honor", "Honor"},
+		{"lm-", "LG"},
+		{"lg-", "LG"},
+		{"lenovo", "Lenovo"},
+		{"asus", "ASUS"},
+		{"xq-", "Sony"},
+		{"sony", "Sony"},
+		{"playstation", "Sony"},
+		{"xbox", "Microsoft"},
+		{"nintendo", "Nintendo"},
+		{"infinix", "Infinix"},
+		{"tecno", "Tecno"},
+		{"kindle", "Amazon"},
+	}
+
+	// deviceBrandPattern maps device models to brands for short model prefixes that are too ambiguous to match on their own.
+	deviceBrandPattern = []struct {
+		pattern *regexp.Regexp
+		brand   string
+	}{
+		{regexp.MustCompile(`^(?i)mi (\d|a\d|note|max|mix|pad)`), "Xiaomi"},
+		{regexp.MustCompile(`^XT\d{4}`), "Motorola"},
+		{regexp.MustCompile(`^KF[A-Z]{2,}$`), "Amazon"},
+		{regexp.MustCompile(`^CPH\d{4}$`), "OPPO"},
+		{deviceTVModel, "Amazon"},
+	}
+
 	// osMapping groups operating system names.
 	osMapping = map[string]string{
 		"Android":     pkg.OSAndroid,
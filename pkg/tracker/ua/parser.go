@@ -4,6 +4,7 @@ import (
 	"github.com/emvi/null"
 	"github.com/pirsch-analytics/pirsch/v6/pkg"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 	"unicode"
@@ -20,26 +21,34 @@ const (
 
 // Parse parses the User-Agent header for given request and returns the extracted information.
 // This supports major browsers and operating systems.
+// Rules set using SetRules are checked before the built-in detection.
 func Parse(r *http.Request) UserAgent {
+	return parseUserAgent(r, activeRules.Load())
+}
+
+func parseUserAgent(r *http.Request, rules *compiledRules) UserAgent {
 	system, products, systemFromCH, productFromCH := parse(r)
 	userAgent := UserAgent{
 		Time:      time.Now().UTC(),
 		UserAgent: r.UserAgent(),
 	}
+	var found bool
 
 	if systemFromCH {
 		userAgent.OS, userAgent.OSVersion = mapOS(system)
-	} else {
+	} else if userAgent.OS, userAgent.OSVersion, found = rules.matchOS(userAgent.UserAgent, system); !found {
 		userAgent.OS, userAgent.OSVersion = getOS(system)
 	}
 
 	if productFromCH {
 		userAgent.Browser, userAgent.BrowserVersion = products[0], products[1]
-	} else {
+	} else if userAgent.Browser, userAgent.BrowserVersion, found = rules.matchBrowser(userAgent.UserAgent, products); !found {
 		userAgent.Browser, userAgent.BrowserVersion = getBrowser(products, system, userAgent.OS)
 	}
 
+	userAgent.Webview, userAgent.WebviewApp = getWebview(r.UserAgent(), userAgent.OS)
 	userAgent.Mobile = getMobile(r)
+	userAgent.DeviceType, userAgent.DeviceBrand, userAgent.DeviceModel = getDevice(r, userAgent.OS, userAgent.Mobile)
 	return userAgent
 }
 
@@ -137,6 +146,18 @@ func getBrowser(products []string, system []string, os string) (string, string)
 			return pkg.BrowserFirefox, getProductVersion(product, 1)
 		} else if strings.HasPrefix(product, "Opera/") || strings.HasPrefix(product, "OPR/") {
 			return pkg.BrowserOpera, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "SamsungBrowser/") {
+			return pkg.BrowserSamsung, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "Brave/") {
+			return pkg.BrowserBrave, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "Vivaldi/") {
+			return pkg.BrowserVivaldi, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "YaBrowser/") || strings.HasPrefix(product, "YaSearchBrowser/") {
+			return pkg.BrowserYandex, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "DuckDuckGo/") || strings.HasPrefix(product, "Ddg/") {
+			return pkg.BrowserDuckDuckGo, getProductVersion(product, 1)
+		} else if strings.HasPrefix(product, "UCBrowser/") {
+			return pkg.BrowserUC, getProductVersion(product, 1)
 		}
 	}
 
@@ -153,6 +174,29 @@ func getBrowser(products []string, system []string, os string) (string, string)
 	return browser, version
 }
 
+// getWebview returns true and the name of the host app (if known) for in-app browsers and webviews.
+func getWebview(ua, os string) (bool, string) {
+	for _, app := range webviewApps {
+		if strings.Contains(ua, app.token) {
+			return true, app.app
+		}
+	}
+
+	// Android WebViews add "wv" to the system information, like "Linux; Android 13; SM-A225F Build/TP1A.220624.014; wv"
+	system := parseSystem(ua, strings.IndexRune(ua, uaSystemLeftDelimiter), strings.IndexRune(ua, uaSystemRightDelimiter))
+
+	if slices.Contains(system, "wv") {
+		return true, ""
+	}
+
+	// WKWebView on iOS sends a WebKit User-Agent without the Safari/ product
+	if os == pkg.OSiOS && strings.Contains(ua, "AppleWebKit/") && !strings.Contains(ua, "Safari/") {
+		return true, ""
+	}
+
+	return false, ""
+}
+
 func getMobile(r *http.Request) null.Bool {
 	mobile := r.Header.Get("Sec-CH-UA-Mobile")
 
@@ -433,6 +477,16 @@ func parseProductsFromCH(header string) []string {
 				return []string{pkg.BrowserOpera, parseProductVersion(version)}
 			} else if strings.Contains(product, "Arc") {
 				return []string{pkg.BrowserArc, parseProductVersion(version)}
+			} else if strings.Contains(product, "Samsung Internet") {
+				return []string{pkg.BrowserSamsung, parseProductVersion(version)}
+			} else if strings.Contains(product, "Brave") {
+				return []string{pkg.BrowserBrave, parseProductVersion(version)}
+			} else if strings.Contains(product, "Vivaldi") {
+				return []string{pkg.BrowserVivaldi, parseProductVersion(version)}
+			} else if strings.Contains(product, "YaBrowser") {
+				return []string{pkg.BrowserYandex, parseProductVersion(version)}
+			} else if strings.Contains(product, "DuckDuckGo") {
+				return []string{pkg.BrowserDuckDuckGo, parseProductVersion(version)}
 			} else if !strings.Contains(product, "Not") && !strings.Contains(product, "Brand") && !strings.Contains(product, "Chromium") {
 				genericProduct = strings.Trim(product, `"' `)
 				genericVersion = parseProductVersion(version)
@@ -36,6 +36,14 @@ func TestGetBrowser(t *testing.T) {
 	}
 }
 
+func TestGetWebview(t *testing.T) {
+	for _, ua := range userAgentsAll {
+		webview, app := getWebview(ua.ua, ua.os)
+		assert.Equal(t, ua.webview, webview, ua.ua)
+		assert.Equal(t, ua.webviewApp, app, ua.ua)
+	}
+}
+
 func TestGetBrowserChromeSafari(t *testing.T) {
 	req, _ := http.NewRequest(http.MethodGet, "/", nil)
 	req.Header.Set("User-Agent", "AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36")
@@ -192,6 +200,18 @@ func TestParseClientHints(t *testing.T) {
 	assert.Equal(t, "1.11", ua.BrowserVersion)
 	assert.Equal(t, pkg.OSMac, ua.OS)
 	assert.Equal(t, "13.5", ua.OSVersion)
+	req.Header.Set("Sec-CH-UA", `"Not_A Brand";v="8", "Chromium";v="120", "Brave";v="120"`)
+	ua = Parse(req)
+	assert.Equal(t, pkg.BrowserBrave, ua.Browser)
+	assert.Equal(t, "120", ua.BrowserVersion)
+	req.Header.Set("Sec-CH-UA", `"Chromium";v="118", "YaBrowser";v="23.11", "Not=A?Brand";v="99", "Yowser";v="2.5"`)
+	ua = Parse(req)
+	assert.Equal(t, pkg.BrowserYandex, ua.Browser)
+	assert.Equal(t, "23.11", ua.BrowserVersion)
+	req.Header.Set("Sec-CH-UA", `"Not_A Brand";v="8", "Chromium";v="120", "Samsung Internet";v="23.0"`)
+	ua = Parse(req)
+	assert.Equal(t, pkg.BrowserSamsung, ua.Browser)
+	assert.Equal(t, "23.0", ua.BrowserVersion)
 }
 
 func TestParse(t *testing.T) {
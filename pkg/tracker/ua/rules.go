@@ -0,0 +1,280 @@
+package ua
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	defaultRuleVersionParts = 2
+)
+
+var activeRules atomic.Pointer[compiledRules]
+
+// Rules are additional detection rules for operating systems and browsers.
+// They are checked in order before the built-in detection, so they can be used to add new browsers and versions
+// or to override the built-in results, without releasing a new version of the library.
+type Rules struct {
+	// Version is the version of the rule set, like "2024-01-31".
+	Version string `json:"version"`
+
+	// OS are the rules to detect the operating system.
+	// Tokens are matched against the system part of the User-Agent, like "Windows NT 10.0" in "(Windows NT 10.0; Win64; x64)".
+	OS []Rule `json:"os"`
+
+	// Browser are the rules to detect the browser.
+	// Tokens are matched against the product part of the User-Agent, like "Firefox/121.0".
+	Browser []Rule `json:"browser"`
+}
+
+// Rule is a single detection rule.
+// Either Tokens or Regex must be set.
+type Rule struct {
+	// Name is the resulting OS or browser name, like pkg.BrowserChrome.
+	Name string `json:"name"`
+
+	// Tokens is a list of prefixes. The version follows the prefix, like "Vivaldi/" in "Vivaldi/6.5.3206.48".
+	Tokens []string `json:"tokens,omitempty"`
+
+	// Regex is a regular expression matched against the full User-Agent.
+	// The first capture group is used as the version, if present.
+	Regex string `json:"regex,omitempty"`
+
+	// VersionParts is the number of version parts to keep (2 by default, like "6.5" for "6.5.3206.48").
+	VersionParts int `json:"version_parts,omitempty"`
+
+	// Versions maps the detected version to the product version, like "10.0" to "10" for Windows.
+	// Versions not found in the map are used as detected.
+	Versions map[string]string `json:"versions,omitempty"`
+}
+
+// RuleValidationError is returned by Rules.Validate for User-Agents from the test corpus that are detected differently.
+type RuleValidationError struct {
+	UserAgent string
+	Field     string
+	Expected  string
+	Got       string
+}
+
+// Error implements the error interface.
+func (err RuleValidationError) Error() string {
+	return fmt.Sprintf("ua: %s mismatch for %q: expected %q, got %q", err.Field, err.UserAgent, err.Expected, err.Got)
+}
+
+type compiledRules struct {
+	version string
+	os      []compiledRule
+	browser []compiledRule
+}
+
+type compiledRule struct {
+	Rule
+	regex *regexp.Regexp
+}
+
+// LoadRules reads the rules from given JSON file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseRules(data)
+}
+
+// ParseRules parses and compiles the rules from JSON.
+func ParseRules(data []byte) (*Rules, error) {
+	rules := new(Rules)
+
+	if err := json.Unmarshal(data, rules); err != nil {
+		return nil, err
+	}
+
+	if _, err := rules.compile(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// SetRules replaces the rules used by Parse. This is safe to call while requests are being parsed.
+// Passing nil restores the built-in detection.
+func SetRules(rules *Rules) error {
+	if rules == nil {
+		activeRules.Store(nil)
+		return nil
+	}
+
+	compiled, err := rules.compile()
+
+	if err != nil {
+		return err
+	}
+
+	activeRules.Store(compiled)
+	return nil
+}
+
+// RulesVersion returns the version of the rules in use, or an empty string for the built-in detection.
+func RulesVersion() string {
+	if rules := activeRules.Load(); rules != nil {
+		return rules.version
+	}
+
+	return ""
+}
+
+// Validate runs the rules against the test corpus of the built-in detection and returns all mismatches.
+// Use this to make sure a rule set does not break the detection of known User-Agents before calling SetRules.
+func (rules *Rules) Validate() error {
+	compiled, err := rules.compile()
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, ua := range userAgentsAll {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("User-Agent", ua.ua)
+		result := parseUserAgent(req, compiled)
+		errs = appendRuleValidationError(errs, ua.ua, "browser", ua.browser, result.Browser)
+		errs = appendRuleValidationError(errs, ua.ua, "browser version", ua.browserVersion, result.BrowserVersion)
+		errs = appendRuleValidationError(errs, ua.ua, "os", ua.os, result.OS)
+		errs = appendRuleValidationError(errs, ua.ua, "os version", ua.osVersion, result.OSVersion)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (rules *Rules) compile() (*compiledRules, error) {
+	osRules, err := compileRules(rules.OS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	browserRules, err := compileRules(rules.Browser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiledRules{
+		version: rules.Version,
+		os:      osRules,
+		browser: browserRules,
+	}, nil
+}
+
+func compileRules(rules []Rule) ([]compiledRule, error) {
+	compiled := make([]compiledRule, 0, len(rules))
+
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("ua: rule %d has no name", i)
+		}
+
+		if len(rule.Tokens) == 0 && rule.Regex == "" {
+			return nil, fmt.Errorf("ua: rule %d (%s) has neither tokens nor a regex", i, rule.Name)
+		}
+
+		if rule.VersionParts <= 0 {
+			rule.VersionParts = defaultRuleVersionParts
+		}
+
+		var regex *regexp.Regexp
+
+		if rule.Regex != "" {
+			var err error
+			regex, err = regexp.Compile(rule.Regex)
+
+			if err != nil {
+				return nil, fmt.Errorf("ua: rule %d (%s) has an invalid regex: %w", i, rule.Name, err)
+			}
+		}
+
+		compiled = append(compiled, compiledRule{rule, regex})
+	}
+
+	return compiled, nil
+}
+
+func (rules *compiledRules) matchOS(ua string, system []string) (string, string, bool) {
+	if rules == nil {
+		return "", "", false
+	}
+
+	return matchRules(rules.os, ua, system)
+}
+
+func (rules *compiledRules) matchBrowser(ua string, products []string) (string, string, bool) {
+	if rules == nil {
+		return "", "", false
+	}
+
+	return matchRules(rules.browser, ua, products)
+}
+
+func matchRules(rules []compiledRule, ua string, parts []string) (string, string, bool) {
+	for _, rule := range rules {
+		if version, ok := rule.match(ua, parts); ok {
+			return rule.Name, version, true
+		}
+	}
+
+	return "", "", false
+}
+
+func (rule *compiledRule) match(ua string, parts []string) (string, bool) {
+	if rule.regex != nil {
+		if match := rule.regex.FindStringSubmatch(ua); match != nil {
+			if len(match) > 1 {
+				return rule.version(match[1]), true
+			}
+
+			return "", true
+		}
+	}
+
+	for _, part := range parts {
+		for _, token := range rule.Tokens {
+			if strings.HasPrefix(part, token) {
+				return rule.version(part[len(token):]), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (rule *compiledRule) version(version string) string {
+	version = getOSVersion(strings.ReplaceAll(version, "_", "."), rule.VersionParts-1)
+
+	if v, found := rule.Versions[version]; found {
+		return v
+	}
+
+	return version
+}
+
+func appendRuleValidationError(errs []error, ua, field, expected, got string) []error {
+	if expected != got {
+		errs = append(errs, RuleValidationError{
+			UserAgent: ua,
+			Field:     field,
+			Expected:  expected,
+			Got:       got,
+		})
+	}
+
+	return errs
+}
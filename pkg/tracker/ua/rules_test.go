@@ -0,0 +1,96 @@
+package ua
+
+import (
+	"errors"
+	"github.com/pirsch-analytics/pirsch/v6/pkg"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`{
+		"version": "1",
+		"os": [{"name": "Windows", "regex": "Windows NT ([\\d.]+)", "versions": {"10.0": "10"}}],
+		"browser": [{"name": "Floorp", "tokens": ["Floorp/"], "version_parts": 3}]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", rules.Version)
+	assert.Len(t, rules.OS, 1)
+	assert.Len(t, rules.Browser, 1)
+	_, err = ParseRules([]byte(`{"browser": [{"tokens": ["Floorp/"]}]}`))
+	assert.ErrorContains(t, err, "no name")
+	_, err = ParseRules([]byte(`{"browser": [{"name": "Floorp"}]}`))
+	assert.ErrorContains(t, err, "neither tokens nor a regex")
+	_, err = ParseRules([]byte(`{"os": [{"name": "Windows", "regex": "("}]}`))
+	assert.ErrorContains(t, err, "invalid regex")
+	_, err = ParseRules([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestLoadRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"version": "2", "browser": [{"name": "Floorp", "tokens": ["Floorp/"]}]}`), 0644))
+	rules, err := LoadRules(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", rules.Version)
+	_, err = LoadRules(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestSetRules(t *testing.T) {
+	defer func() {
+		assert.NoError(t, SetRules(nil))
+	}()
+	uaString := "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Firefox/115.0 Floorp/11.8.2"
+	req, _ := http.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", uaString)
+	ua := Parse(req)
+	assert.Equal(t, pkg.BrowserFirefox, ua.Browser)
+	assert.Equal(t, "115.0", ua.BrowserVersion)
+	assert.Empty(t, RulesVersion())
+	assert.NoError(t, SetRules(&Rules{
+		Version: "1",
+		OS: []Rule{
+			{Name: pkg.OSWindows, Regex: `Windows NT ([\d.]+)`, Versions: map[string]string{"10.0": "10"}},
+		},
+		Browser: []Rule{
+			{Name: "Floorp", Tokens: []string{"Floorp/"}, VersionParts: 3},
+		},
+	}))
+	assert.Equal(t, "1", RulesVersion())
+	ua = Parse(req)
+	assert.Equal(t, "Floorp", ua.Browser)
+	assert.Equal(t, "11.8.2", ua.BrowserVersion)
+	assert.Equal(t, pkg.OSWindows, ua.OS)
+	assert.Equal(t, "10", ua.OSVersion)
+	assert.Error(t, SetRules(&Rules{Browser: []Rule{{Name: "Floorp"}}}))
+	assert.Equal(t, "1", RulesVersion())
+	assert.NoError(t, SetRules(nil))
+	assert.Empty(t, RulesVersion())
+	ua = Parse(req)
+	assert.Equal(t, pkg.BrowserFirefox, ua.Browser)
+}
+
+func TestRules_Validate(t *testing.T) {
+	assert.NoError(t, new(Rules).Validate())
+	assert.NoError(t, (&Rules{
+		Browser: []Rule{
+			{Name: "Floorp", Tokens: []string{"Floorp/"}},
+		},
+	}).Validate())
+	err := (&Rules{
+		OS: []Rule{
+			{Name: pkg.OSWindows, Regex: `Windows NT ([\d.]+)`},
+		},
+	}).Validate()
+	assert.Error(t, err)
+	var validationErr RuleValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "os version", validationErr.Field)
+	assert.Equal(t, "10", validationErr.Expected)
+	assert.Equal(t, "10.0", validationErr.Got)
+	assert.Error(t, (&Rules{OS: []Rule{{Name: pkg.OSWindows, Regex: "("}}}).Validate())
+}
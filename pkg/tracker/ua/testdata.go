@@ -10,6 +10,15 @@ type testUserAgent struct {
 	browserVersion string
 	os             string
 	osVersion      string
+	webview        bool
+	webviewApp     string
+}
+
+type testDeviceUserAgent struct {
+	ua          string
+	deviceType  string
+	deviceBrand string
+	deviceModel string
 }
 
 // https://www.useragents.me/
@@ -391,6 +400,7 @@ var userAgentsChrome = []testUserAgent{
 		browserVersion: "43.0",
 		os:             pkg.OSAndroid,
 		osVersion:      "5.1",
+		webview:        true,
 	},
 	{
 		ua:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/109.0.0.0 Safari/537.36",
@@ -602,13 +612,209 @@ var userAgentsArc = []testUserAgent{
 	},
 }
 
+var userAgentsSamsung = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 13; SAMSUNG SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+		browser:        pkg.BrowserSamsung,
+		browserVersion: "23.0",
+		os:             pkg.OSAndroid,
+		osVersion:      "13",
+	},
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 12; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/21.0 Chrome/110.0.5481.154 Safari/537.36",
+		browser:        pkg.BrowserSamsung,
+		browserVersion: "21.0",
+		os:             pkg.OSAndroid,
+		osVersion:      "12",
+	},
+}
+
+var userAgentsBrave = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36 Brave/120",
+		browser:        pkg.BrowserBrave,
+		browserVersion: "120",
+		os:             pkg.OSAndroid,
+		osVersion:      "14",
+	},
+}
+
+var userAgentsVivaldi = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Vivaldi/6.5.3206.48",
+		browser:        pkg.BrowserVivaldi,
+		browserVersion: "6.5",
+		os:             pkg.OSWindows,
+		osVersion:      "10",
+	},
+	{
+		ua:             "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36 Vivaldi/6.4.3160.41",
+		browser:        pkg.BrowserVivaldi,
+		browserVersion: "6.4",
+		os:             pkg.OSLinux,
+		osVersion:      "",
+	},
+}
+
+var userAgentsYandex = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 YaBrowser/23.11.0.0 Safari/537.36",
+		browser:        pkg.BrowserYandex,
+		browserVersion: "23.11",
+		os:             pkg.OSWindows,
+		osVersion:      "10",
+	},
+	{
+		ua:             "Mozilla/5.0 (Linux; arm_64; Android 13; SM-A536B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.5993.117 YaBrowser/23.11.4.117.00 SA/3 Mobile Safari/537.36",
+		browser:        pkg.BrowserYandex,
+		browserVersion: "23.11",
+		os:             pkg.OSAndroid,
+		osVersion:      "13",
+	},
+}
+
+var userAgentsDuckDuckGo = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 14) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile DuckDuckGo/5 Safari/537.36",
+		browser:        pkg.BrowserDuckDuckGo,
+		browserVersion: "5",
+		os:             pkg.OSAndroid,
+		osVersion:      "14",
+	},
+	{
+		ua:             "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1 Ddg/17.2",
+		browser:        pkg.BrowserDuckDuckGo,
+		browserVersion: "17.2",
+		os:             pkg.OSiOS,
+		osVersion:      "17.2",
+	},
+}
+
+var userAgentsUC = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (Linux; U; Android 11; en-US; RMX2193 Build/RP1A.201005.001) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/100.0.4896.58 UCBrowser/13.4.0.1306 Mobile Safari/537.36",
+		browser:        pkg.BrowserUC,
+		browserVersion: "13.4",
+		os:             pkg.OSAndroid,
+		osVersion:      "11",
+	},
+}
+
+var userAgentsWebview = []testUserAgent{
+	{
+		ua:             "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/21B101 Instagram 312.0.1.19.124 (iPhone14,2; iOS 17_1_2; de_FR; de; scale=3.00; 1170x2532; 548339486)",
+		browser:        "",
+		browserVersion: "",
+		os:             pkg.OSiOS,
+		osVersion:      "17.1",
+		webview:        true,
+		webviewApp:     "Instagram",
+	},
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 13; SM-A225F Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36 Instagram 312.1.0.34.111 Android (33/13; 300dpi; 720x1452; samsung; SM-A225F; a22; mt6769t; de_DE; 548323754)",
+		browser:        pkg.BrowserChrome,
+		browserVersion: "120.0",
+		os:             pkg.OSAndroid,
+		osVersion:      "13",
+		webview:        true,
+		webviewApp:     "Instagram",
+	},
+	{
+		ua:             "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/FBIOS;FBAV/442.0.0.42.112;FBBV/540311834;FBDV/iPhone15,2;FBMD/iPhone;FBSN/iOS;FBSV/17.1;FBSS/3;FBID/phone;FBLC/en_US;FBOP/5]",
+		browser:        "",
+		browserVersion: "",
+		os:             pkg.OSiOS,
+		osVersion:      "17.1",
+		webview:        true,
+		webviewApp:     "Facebook",
+	},
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 12; SM-G973F Build/SP1A.210812.016; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36 musical_ly_2023208030 JsSdk/1.0 NetType/WIFI Channel/googleplay AppName/musical_ly app_version/32.8.3 ByteLocale/de-DE ByteFullLocale/de-DE Region/DE AppId/1233 Spark/1.4.8.3-bugfix AppVersion/32.8.3 BytedanceWebview/d8a21c6",
+		browser:        pkg.BrowserChrome,
+		browserVersion: "120.0",
+		os:             pkg.OSAndroid,
+		osVersion:      "12",
+		webview:        true,
+		webviewApp:     "TikTok",
+	},
+	{
+		ua:             "Mozilla/5.0 (Linux; Android 10; K; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36",
+		browser:        pkg.BrowserChrome,
+		browserVersion: "120.0",
+		os:             pkg.OSAndroid,
+		osVersion:      "10",
+		webview:        true,
+	},
+	{
+		ua:             "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148",
+		browser:        "",
+		browserVersion: "",
+		os:             pkg.OSiOS,
+		osVersion:      "17.2",
+		webview:        true,
+	},
+}
+
+// userAgentsDevice contains devices identified by their model, and devices whose model or User-Agent only happens to contain a brand or TV model prefix.
+var userAgentsDevice = []testDeviceUserAgent{
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 9; AFTKA Build/PS7633) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypeTV,
+		deviceBrand: "Amazon",
+		deviceModel: "AFTKA",
+	},
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 13; CPH2487 Build/TP1A.220905.001) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypePhone,
+		deviceBrand: "OPPO",
+		deviceModel: "CPH2487",
+	},
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 11; Crafts Pro Build/RP1A.200720.011) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypePhone,
+		deviceBrand: "",
+		deviceModel: "Crafts Pro",
+	},
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 10; Aftershock X1 Build/QP1A.190711.020) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypePhone,
+		deviceBrand: "",
+		deviceModel: "Aftershock X1",
+	},
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 12; CPhone 5 Build/SP1A.210812.016) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypePhone,
+		deviceBrand: "",
+		deviceModel: "CPhone 5",
+	},
+	{
+		ua:          "Mozilla/5.0 (Linux; Android 13; AFTKA-Pro Build/TP1A.220905.001) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+		deviceType:  pkg.DeviceTypePhone,
+		deviceBrand: "",
+		deviceModel: "AFTKA-Pro",
+	},
+	{
+		ua:          "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) DraftsApp/2.1 Chrome/120.0.0.0 Safari/537.36",
+		deviceType:  pkg.DeviceTypeDesktop,
+		deviceBrand: "",
+		deviceModel: "",
+	},
+}
+
 var userAgentsAll = mergeUserAgentLists(userAgentsEdge,
 	userAgentsOpera,
 	userAgentsFirefox,
 	userAgentsChrome,
 	userAgentsSafari,
 	userAgentsIE,
-	userAgentsArc)
+	userAgentsArc,
+	userAgentsSamsung,
+	userAgentsBrave,
+	userAgentsVivaldi,
+	userAgentsYandex,
+	userAgentsDuckDuckGo,
+	userAgentsUC,
+	userAgentsWebview)
 
 func mergeUserAgentLists(ua ...[]testUserAgent) []testUserAgent {
 	list := make([]testUserAgent, 0)
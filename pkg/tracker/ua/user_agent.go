@@ -27,6 +27,22 @@ type UserAgent struct {
 	// OSVersion is the operating system version number.
 	OSVersion string
 
+	// DeviceType is the type of device, like pkg.DeviceTypePhone or pkg.DeviceTypeTV.
+	DeviceType string
+
+	// DeviceBrand is the device manufacturer, like "Samsung" or "Apple".
+	DeviceBrand string
+
+	// DeviceModel is the device model, like "SM-S918B" or "Pixel 7".
+	DeviceModel string
+
+	// Webview indicates an in-app browser or webview embedded into another app.
+	Webview bool
+
+	// WebviewApp is the name of the app hosting the webview, like "Instagram" or "TikTok".
+	// It's empty if the app is unknown.
+	WebviewApp string
+
 	// Mobile indicated whether this is a mobile device from client hint headers.
 	// It'll be set to null if the header is not present or empty.
 	Mobile null.Bool
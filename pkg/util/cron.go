@@ -38,3 +38,25 @@ func getTimeToMidnightUTC() time.Duration {
 	midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
 	return midnight.Sub(now)
 }
+
+// RunEvery calls given function periodically in given interval,
+// unless it is cancelled by calling the cancel function.
+func RunEvery(f func(), interval time.Duration) context.CancelFunc {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				f()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancelFunc
+}
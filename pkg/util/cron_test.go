@@ -1,6 +1,9 @@
 package util
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestRunAtMidnight(t *testing.T) {
 	cancel := RunAtMidnight(func() {
@@ -8,3 +11,15 @@ func TestRunAtMidnight(t *testing.T) {
 	})
 	cancel()
 }
+
+func TestRunEvery(t *testing.T) {
+	called := make(chan struct{}, 1)
+	cancel := RunEvery(func() {
+		select {
+		case called <- struct{}{}:
+		default:
+		}
+	}, time.Millisecond*10)
+	<-called
+	cancel()
+}
@@ -0,0 +1,132 @@
+package util
+
+// Matcher checks whether a string contains any of a list of patterns.
+// It compiles the patterns into an Aho-Corasick automaton, so the input is scanned once,
+// independent of the number of patterns. Matching is case-sensitive and empty patterns are ignored.
+// A Matcher is immutable and safe for concurrent use.
+type Matcher struct {
+	patterns []string
+	alphabet [256]int32
+	symbols  int32
+	next     []int32
+	output   []int32
+}
+
+// NewMatcher creates a new Matcher for given patterns.
+func NewMatcher(patterns []string) *Matcher {
+	m := &Matcher{
+		patterns: make([]string, 0, len(patterns)),
+		symbols:  1, // 0 is used for all bytes that are not part of any pattern
+	}
+
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+
+		m.patterns = append(m.patterns, pattern)
+
+		for i := 0; i < len(pattern); i++ {
+			if m.alphabet[pattern[i]] == 0 {
+				m.alphabet[pattern[i]] = m.symbols
+				m.symbols++
+			}
+		}
+	}
+
+	m.build()
+	return m
+}
+
+// Contains returns true if str contains any of the patterns.
+func (m *Matcher) Contains(str string) bool {
+	_, found := m.Find(str)
+	return found
+}
+
+// Find returns the first pattern found in str.
+func (m *Matcher) Find(str string) (string, bool) {
+	state := int32(0)
+
+	for i := 0; i < len(str); i++ {
+		state = m.next[state*m.symbols+m.alphabet[str[i]]]
+
+		if m.output[state] > -1 {
+			return m.patterns[m.output[state]], true
+		}
+	}
+
+	return "", false
+}
+
+// Patterns returns the patterns matched by the Matcher.
+func (m *Matcher) Patterns() []string {
+	return m.patterns
+}
+
+func (m *Matcher) build() {
+	m.addState()
+
+	// build the trie, -1 marks a missing transition
+	for i, pattern := range m.patterns {
+		state := int32(0)
+
+		for j := 0; j < len(pattern); j++ {
+			symbol := m.alphabet[pattern[j]]
+
+			if m.next[state*m.symbols+symbol] == -1 {
+				m.next[state*m.symbols+symbol] = m.addState()
+			}
+
+			state = m.next[state*m.symbols+symbol]
+		}
+
+		if m.output[state] == -1 {
+			m.output[state] = int32(i)
+		}
+	}
+
+	// turn the trie into a deterministic automaton by following the failure links breadth-first
+	fail := make([]int32, len(m.output))
+	queue := make([]int32, 0, len(m.output))
+
+	for symbol := int32(0); symbol < m.symbols; symbol++ {
+		if child := m.next[symbol]; child == -1 {
+			m.next[symbol] = 0
+		} else {
+			queue = append(queue, child)
+		}
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		if m.output[state] == -1 {
+			m.output[state] = m.output[fail[state]]
+		}
+
+		for symbol := int32(0); symbol < m.symbols; symbol++ {
+			i := state*m.symbols + symbol
+			fallback := m.next[fail[state]*m.symbols+symbol]
+
+			if m.next[i] == -1 {
+				m.next[i] = fallback
+			} else {
+				fail[m.next[i]] = fallback
+				queue = append(queue, m.next[i])
+			}
+		}
+	}
+}
+
+func (m *Matcher) addState() int32 {
+	state := int32(len(m.output))
+	m.output = append(m.output, -1)
+
+	for i := int32(0); i < m.symbols; i++ {
+		m.next = append(m.next, -1)
+	}
+
+	return state
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "his", "hers", "", "bot"})
+	assert.Equal(t, []string{"he", "she", "his", "hers", "bot"}, m.Patterns())
+	assert.False(t, m.Contains(""))
+	assert.False(t, m.Contains("h"))
+	assert.False(t, m.Contains("Mozilla/5.0"))
+	assert.True(t, m.Contains("ushers"))
+	assert.True(t, m.Contains("this"))
+	assert.True(t, m.Contains("googlebot/2.1"))
+	assert.False(t, m.Contains("Googlebo"))
+	pattern, found := m.Find("ushers")
+	assert.True(t, found)
+	assert.Equal(t, "she", pattern)
+	pattern, found = m.Find("abbot")
+	assert.True(t, found)
+	assert.Equal(t, "bot", pattern)
+	_, found = m.Find("nothing to see")
+	assert.False(t, found)
+	assert.False(t, NewMatcher(nil).Contains("anything"))
+}
+
+func TestMatcherRandom(t *testing.T) {
+	// compare against strings.Contains using a small alphabet to get lots of overlapping patterns
+	patterns := make([]string, 20)
+
+	for i := range patterns {
+		patterns[i] = randomString(2 + rand.Intn(4))
+	}
+
+	m := NewMatcher(patterns)
+
+	for i := 0; i < 1000; i++ {
+		str := randomString(rand.Intn(20))
+		expected := false
+
+		for _, pattern := range patterns {
+			if strings.Contains(str, pattern) {
+				expected = true
+				break
+			}
+		}
+
+		assert.Equal(t, expected, m.Contains(str), str)
+	}
+}
+
+func randomString(n int) string {
+	var sb strings.Builder
+
+	for i := 0; i < n; i++ {
+		sb.WriteByte("abcd"[rand.Intn(4)])
+	}
+
+	return sb.String()
+}
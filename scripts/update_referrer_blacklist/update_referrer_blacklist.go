@@ -50,8 +50,8 @@ func main() {
 	var out strings.Builder
 	out.WriteString(`package referrer
 
-// Blacklist is a list of referrer keywords to ignore.
-var Blacklist = []string{
+// defaultBlacklist is the list of referrer keywords to ignore by default (see DefaultBlacklist).
+var defaultBlacklist = []string{
 `)
 
 	for _, entry := range keywords {
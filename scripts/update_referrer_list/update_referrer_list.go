@@ -18,7 +18,8 @@ const (
 )
 
 type domain struct {
-	Domains []string `json:"domains"`
+	Domains    []string `json:"domains"`
+	Parameters []string `json:"parameters"`
 }
 
 type list map[string]map[string]domain
@@ -29,10 +30,11 @@ func main() {
 	fromSnowplow := loadList(snowplowList)
 	mapping := loadList(mappingList)
 	groups := make(map[string]string)
-	addGroups(groups, fromSnowplow)
-	addGroups(groups, mapping)
-	keys := getKeys(groups)
-	writeList(groups, keys)
+	categories := make(map[string]string)
+	parameters := make(map[string][]string)
+	addGroups(groups, categories, parameters, fromSnowplow)
+	addGroups(groups, categories, parameters, mapping)
+	writeList(groups, categories, parameters)
 	formatCode()
 	log.Println("Done!")
 }
@@ -72,7 +74,7 @@ func loadList(file string) list {
 	return l
 }
 
-func addGroups(groups map[string]string, l list) {
+func addGroups(groups, categories map[string]string, parameters map[string][]string, l list) {
 	for key := range l {
 		for name, domains := range l[key] {
 			for _, domain := range domains.Domains {
@@ -83,15 +85,28 @@ func addGroups(groups map[string]string, l list) {
 				}
 
 				groups[domain] = name
+
+				if key == "unknown" {
+					delete(categories, domain)
+				} else {
+					categories[domain] = key
+				}
+
+				// keep the parameters from the previous list for search engines without parameters
+				if len(domains.Parameters) > 0 {
+					parameters[domain] = domains.Parameters
+				} else if key != "search" {
+					delete(parameters, domain)
+				}
 			}
 		}
 	}
 }
 
-func getKeys(groups map[string]string) []string {
-	keys := make([]string, 0, len(groups))
+func getKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
 
-	for k := range groups {
+	for k := range m {
 		keys = append(keys, k)
 	}
 
@@ -99,7 +114,7 @@ func getKeys(groups map[string]string) []string {
 	return keys
 }
 
-func writeList(groups map[string]string, keys []string) {
+func writeList(groups, categories map[string]string, parameters map[string][]string) {
 	log.Println("Writing list")
 	var out strings.Builder
 	out.WriteString(`package referrer
@@ -108,12 +123,32 @@ var (
 	groups = map[string]string{
 `)
 
-	for _, key := range keys {
+	for _, key := range getKeys(groups) {
 		out.WriteString(fmt.Sprintf(`"%s": "%s",`, key, groups[key]))
 		out.WriteRune('\n')
 	}
 
 	out.WriteString(`}
+
+	categories = map[string]string{
+`)
+
+	for _, key := range getKeys(categories) {
+		out.WriteString(fmt.Sprintf(`"%s": "%s",`, key, categories[key]))
+		out.WriteRune('\n')
+	}
+
+	out.WriteString(`}
+
+	searchParameters = map[string][]string{
+`)
+
+	for _, key := range getKeys(parameters) {
+		out.WriteString(fmt.Sprintf(`"%s": {"%s"},`, key, strings.Join(parameters[key], `", "`)))
+		out.WriteRune('\n')
+	}
+
+	out.WriteString(`}
 )`)
 
 	if err := os.WriteFile("pkg/tracker/referrer/list.go", []byte(out.String()), 0644); err != nil {
@@ -7,7 +7,9 @@ import (
 
 func TestMergeGroups(t *testing.T) {
 	groups := make(map[string]string)
-	addGroups(groups, list{
+	categories := make(map[string]string)
+	parameters := make(map[string][]string)
+	addGroups(groups, categories, parameters, list{
 		"unknown": {
 			"Tripadvisor": {
 				Domains: []string{
@@ -17,8 +19,17 @@ func TestMergeGroups(t *testing.T) {
 				},
 			},
 		},
+		"search": {
+			"Google": {
+				Domains: []string{
+					"google.com",
+					"google.de",
+				},
+				Parameters: []string{"q"},
+			},
+		},
 	})
-	addGroups(groups, list{
+	addGroups(groups, categories, parameters, list{
 		"search": {
 			"Google": {
 				Domains: []string{
@@ -35,9 +46,15 @@ func TestMergeGroups(t *testing.T) {
 			},
 		},
 	})
-	assert.Len(t, groups, 4)
+	assert.Len(t, groups, 5)
 	assert.Equal(t, groups["tripadvisor.com"], "Tripadvisor")
 	assert.Equal(t, groups["tripadvisor.fr"], "Tripadvisor")
 	assert.Equal(t, groups["tripadvisor.be"], "Tripadvisor")
 	assert.Equal(t, groups["google.com"], "Google")
+	assert.Len(t, categories, 2)
+	assert.Equal(t, "search", categories["google.com"])
+	assert.Equal(t, "search", categories["google.de"])
+	assert.Len(t, parameters, 2)
+	assert.Equal(t, []string{"q"}, parameters["google.com"])
+	assert.Equal(t, []string{"q"}, parameters["google.de"])
 }
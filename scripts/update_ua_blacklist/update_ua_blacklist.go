@@ -50,8 +50,8 @@ func main() {
 	var out strings.Builder
 	out.WriteString(`package ua
 
-// Blacklist is a list of User-Agents to ignore.
-var Blacklist = []string{
+// defaultBlacklist is the list of User-Agents to ignore by default (see DefaultBlacklist).
+var defaultBlacklist = []string{
 `)
 
 	for _, entry := range ua {